@@ -19,6 +19,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"math/rand"
 	"os"
 	"time"
@@ -46,6 +47,10 @@ func main() {
 
 func exitOnError(err error) {
 	if err != nil {
+		var exitErr *cmd.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.Code)
+		}
 		os.Exit(1)
 	}
 }
@@ -5,6 +5,8 @@ go 1.16
 require (
 	github.com/Masterminds/semver v1.5.0
 	github.com/container-tools/snap v0.0.8
+	github.com/cucumber/common/gherkin/go/v22 v22.0.0
+	github.com/cucumber/common/messages/go/v17 v17.1.1
 	github.com/gertd/go-pluralize v0.1.7
 	github.com/go-logr/logr v0.4.0
 	github.com/google/uuid v1.2.0
@@ -24,6 +26,7 @@ require (
 	github.com/spf13/viper v1.7.0
 	github.com/stoewer/go-strcase v1.2.0
 	github.com/stretchr/testify v1.7.0
+	go.uber.org/zap v1.17.0
 	gopkg.in/yaml.v2 v2.4.0
 	gotest.tools/v3 v3.0.3
 	k8s.io/api v0.20.7
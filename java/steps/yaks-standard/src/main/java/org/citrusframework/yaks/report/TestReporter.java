@@ -84,6 +84,8 @@ public class TestReporter extends CitrusReporter {
             return;
         }
 
+        countStep(event.getResult().getStatus());
+
         Optional<TestResult> testDetail = testResults.getTests().stream()
                 .filter(detail -> detail.getId().equals(event.getTestCase().getId()))
                 .findFirst();
@@ -159,6 +161,33 @@ public class TestReporter extends CitrusReporter {
         }
     }
 
+    /**
+     * Count step result in test summary.
+     * @param status
+     */
+    private void countStep(Status status) {
+        StepSummary steps = testResults.getSummary().getSteps();
+        switch (status) {
+            case PASSED:
+                steps.passed++;
+                break;
+            case FAILED:
+            case AMBIGUOUS:
+                steps.failed++;
+                break;
+            case SKIPPED:
+                steps.skipped++;
+                break;
+            case PENDING:
+                steps.pending++;
+                break;
+            case UNDEFINED:
+                steps.undefined++;
+                break;
+            default:
+        }
+    }
+
     public static Path getTerminationLog() {
         return Paths.get(System.getProperty(TERMINATION_LOG_PROPERTY,
                 System.getenv(TERMINATION_LOG_ENV) != null ? System.getenv(TERMINATION_LOG_ENV) : TERMINATION_LOG_DEFAULT));
@@ -31,6 +31,8 @@ public class TestSummary {
     int pending = 0;
     int undefined = 0;
 
+    final StepSummary steps = new StepSummary();
+
     public int getPassed() {
         return passed;
     }
@@ -55,6 +57,10 @@ public class TestSummary {
         return undefined;
     }
 
+    public StepSummary getSteps() {
+        return steps;
+    }
+
     @JsonProperty
     public int getTotal() {
         return passed + failed + skipped + pending + undefined;
@@ -43,13 +43,15 @@ type TestSpec struct {
 	// Important: Run "operator-sdk generate k8s" to regenerate code after modifying this file
 	// Add custom validation using kubebuilder tags: https://book-v1.book.kubebuilder.io/beyond_basics/generating_crd.html
 
-	Source    SourceSpec     `json:"source,omitempty"`
-	Resources []ResourceSpec `json:"resources,omitempty"`
-	Settings  SettingsSpec   `json:"config,omitempty"`
-	Selenium  SeleniumSpec   `json:"selenium,omitempty"`
-	KubeDock  KubeDockSpec   `json:"kubedock,omitempty"`
-	Env       []string       `json:"env,omitempty"`
-	Secret    string         `json:"secret,omitempty"`
+	Source       SourceSpec        `json:"source,omitempty"`
+	Resources    []ResourceSpec    `json:"resources,omitempty"`
+	ResourceRefs []ResourceRefSpec `json:"resourceRefs,omitempty"`
+	Settings     SettingsSpec      `json:"config,omitempty"`
+	Selenium     SeleniumSpec      `json:"selenium,omitempty"`
+	KubeDock     KubeDockSpec      `json:"kubedock,omitempty"`
+	Maven        MavenSpec         `json:"maven,omitempty"`
+	Env          []string          `json:"env,omitempty"`
+	Secret       string            `json:"secret,omitempty"`
 }
 
 // SourceSpec
@@ -63,22 +65,56 @@ type SourceSpec struct {
 type ResourceSpec struct {
 	Name    string `json:"name,omitempty"`
 	Content string `json:"content,omitempty"`
+	// Encoding of the content, binary content is base64 encoded
+	Encoding string `json:"encoding,omitempty"`
 }
 
+// ResourceEncodingBase64 marks base64 encoded binary resource content
+const ResourceEncodingBase64 = "base64"
+
+// ResourceRefSpec references a ConfigMap or Secret whose entries are mounted as test resources. All entries are mounted when no key is given
+type ResourceRefSpec struct {
+	Kind string `json:"kind,omitempty"`
+	Name string `json:"name,omitempty"`
+	Key  string `json:"key,omitempty"`
+}
+
+const (
+	// ResourceRefKindConfigMap references a ConfigMap
+	ResourceRefKindConfigMap = "ConfigMap"
+	// ResourceRefKindSecret references a Secret
+	ResourceRefKindSecret = "Secret"
+)
+
 // SettingsSpec
 type SettingsSpec struct {
 	Name    string `json:"name,omitempty"`
 	Content string `json:"content,omitempty"`
 }
 
+// MavenSpec
+type MavenSpec struct {
+	Servers []MavenServerSpec `json:"servers,omitempty"`
+}
+
+// MavenServerSpec references the credentials of a Maven repository. The secret holds the username and password entries.
+type MavenServerSpec struct {
+	ID     string `json:"id,omitempty"`
+	Secret string `json:"secret,omitempty"`
+}
+
 // SeleniumSpec
 type SeleniumSpec struct {
 	Image string `json:"image,omitempty"`
+	// ReadyTimeout delays the test start until Selenium accepts sessions, e.g. "2m"
+	ReadyTimeout string `json:"readyTimeout,omitempty"`
 }
 
 // KubeDockSpec
 type KubeDockSpec struct {
 	Image string `json:"image,omitempty"`
+	// Args are added to the arguments of the kubedock server
+	Args []string `json:"args,omitempty"`
 }
 
 // TestStatus defines the observed state of Test
@@ -127,20 +163,38 @@ type TestList struct {
 }
 
 type TestResults struct {
-	Summary TestSummary `json:"summary,omitempty"`
-	Suites  []TestSuite `json:"suites,omitempty"`
+	RunID     string       `json:"runId,omitempty"`
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	Hostname  string       `json:"hostname,omitempty"`
+	Summary   TestSummary  `json:"summary,omitempty"`
+	Suites    []TestSuite  `json:"suites,omitempty"`
 }
 
 type TestSuite struct {
-	Name    string       `json:"suiteName,omitempty"`
-	Summary TestSummary  `json:"summary,omitempty"`
-	Tests   []TestResult `json:"tests,omitempty"`
-	Errors  []string     `json:"errors,omitempty"`
+	Name      string       `json:"suiteName,omitempty"`
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	Summary   TestSummary  `json:"summary,omitempty"`
+	Tests     []TestResult `json:"tests,omitempty"`
+	Errors    []string     `json:"errors,omitempty"`
+	SystemOut string       `json:"systemOut,omitempty"`
+	// SkipReason tells why the test has not been run
+	SkipReason string `json:"skipReason,omitempty"`
 }
 
 type TestSummary struct {
+	Total     int         `json:"total,omitempty"`
+	Errors    int         `json:"errors,omitempty"`
+	Passed    int         `json:"passed,omitempty"`
+	Failed    int         `json:"failed,omitempty"`
+	Skipped   int         `json:"skipped,omitempty"`
+	Pending   int         `json:"pending,omitempty"`
+	Undefined int         `json:"undefined,omitempty"`
+	Steps     StepSummary `json:"steps,omitempty"`
+}
+
+// StepSummary holds the Cucumber step statistics of a test
+type StepSummary struct {
 	Total     int `json:"total,omitempty"`
-	Errors    int `json:"errors,omitempty"`
 	Passed    int `json:"passed,omitempty"`
 	Failed    int `json:"failed,omitempty"`
 	Skipped   int `json:"skipped,omitempty"`
@@ -162,6 +216,7 @@ const (
 	TestLabel              = "yaks.citrusframework.org/test"
 	TestIdLabel            = "yaks.citrusframework.org/test-id"
 	TestConfigurationLabel = "yaks.citrusframework.org/test.configuration"
+	TestRunIdLabel         = "yaks.citrusframework.org/run-id"
 
 	// InstanceKind
 	InstanceKind string = "Instance"
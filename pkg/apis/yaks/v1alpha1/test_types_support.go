@@ -0,0 +1,47 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// AddResults adds the summary and the test results of given suite to the suite
+func (in *TestSuite) AddResults(suite TestSuite) {
+	in.Name = suite.Name
+	if suite.SystemOut != "" {
+		in.SystemOut = suite.SystemOut
+	}
+
+	in.Summary.Add(&suite.Summary)
+	in.Tests = append(in.Tests, suite.Tests...)
+}
+
+// Add adds the counts of given summary to the summary
+func (in *TestSummary) Add(summary *TestSummary) {
+	in.Errors += summary.Errors
+	in.Passed += summary.Passed
+	in.Failed += summary.Failed
+	in.Skipped += summary.Skipped
+	in.Undefined += summary.Undefined
+	in.Pending += summary.Pending
+	in.Total += summary.Total
+
+	in.Steps.Total += summary.Steps.Total
+	in.Steps.Passed += summary.Steps.Passed
+	in.Steps.Failed += summary.Steps.Failed
+	in.Steps.Skipped += summary.Steps.Skipped
+	in.Steps.Pending += summary.Steps.Pending
+	in.Steps.Undefined += summary.Steps.Undefined
+}
@@ -101,6 +101,11 @@ func (in *InstanceStatus) DeepCopy() *InstanceStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeDockSpec) DeepCopyInto(out *KubeDockSpec) {
 	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeDockSpec.
@@ -113,6 +118,41 @@ func (in *KubeDockSpec) DeepCopy() *KubeDockSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MavenServerSpec) DeepCopyInto(out *MavenServerSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MavenServerSpec.
+func (in *MavenServerSpec) DeepCopy() *MavenServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MavenServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MavenSpec) DeepCopyInto(out *MavenSpec) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]MavenServerSpec, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MavenSpec.
+func (in *MavenSpec) DeepCopy() *MavenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MavenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatorSpec) DeepCopyInto(out *OperatorSpec) {
 	*out = *in
@@ -128,6 +168,21 @@ func (in *OperatorSpec) DeepCopy() *OperatorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRefSpec) DeepCopyInto(out *ResourceRefSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRefSpec.
+func (in *ResourceRefSpec) DeepCopy() *ResourceRefSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRefSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceSpec) DeepCopyInto(out *ResourceSpec) {
 	*out = *in
@@ -188,6 +243,21 @@ func (in *SourceSpec) DeepCopy() *SourceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepSummary) DeepCopyInto(out *StepSummary) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepSummary.
+func (in *StepSummary) DeepCopy() *StepSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(StepSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Test) DeepCopyInto(out *Test) {
 	*out = *in
@@ -265,6 +335,10 @@ func (in *TestResult) DeepCopy() *TestResult {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TestResults) DeepCopyInto(out *TestResults) {
 	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
 	out.Summary = in.Summary
 	if in.Suites != nil {
 		in, out := &in.Suites, &out.Suites
@@ -294,9 +368,15 @@ func (in *TestSpec) DeepCopyInto(out *TestSpec) {
 		*out = make([]ResourceSpec, len(*in))
 		copy(*out, *in)
 	}
+	if in.ResourceRefs != nil {
+		in, out := &in.ResourceRefs, &out.ResourceRefs
+		*out = make([]ResourceRefSpec, len(*in))
+		copy(*out, *in)
+	}
 	out.Settings = in.Settings
 	out.Selenium = in.Selenium
-	out.KubeDock = in.KubeDock
+	in.KubeDock.DeepCopyInto(&out.KubeDock)
+	in.Maven.DeepCopyInto(&out.Maven)
 	if in.Env != nil {
 		in, out := &in.Env, &out.Env
 		*out = make([]string, len(*in))
@@ -333,6 +413,10 @@ func (in *TestStatus) DeepCopy() *TestStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TestSuite) DeepCopyInto(out *TestSuite) {
 	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
 	out.Summary = in.Summary
 	if in.Tests != nil {
 		in, out := &in.Tests, &out.Tests
@@ -359,6 +443,7 @@ func (in *TestSuite) DeepCopy() *TestSuite {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TestSummary) DeepCopyInto(out *TestSummary) {
 	*out = *in
+	out.Steps = in.Steps
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestSummary.
@@ -21,6 +21,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	user "github.com/mitchellh/go-homedir"
 	"github.com/pkg/errors"
@@ -58,6 +59,22 @@ type Client interface {
 	GetCurrentNamespace(kubeConfig string) (string, error)
 }
 
+// ConfigCustomizer adjusts the rest configuration before the client is created
+type ConfigCustomizer func(cfg *rest.Config)
+
+// RateLimit sets the queries per second and the burst of queries the client may send to the API server, zero values
+// keep the defaults
+func RateLimit(qps float32, burst int) ConfigCustomizer {
+	return func(cfg *rest.Config) {
+		if qps > 0 {
+			cfg.QPS = qps
+		}
+		if burst > 0 {
+			cfg.Burst = burst
+		}
+	}
+}
+
 // Injectable identifies objects that can receive a Client and the rest config
 type Injectable interface {
 	InjectClient(Client)
@@ -72,8 +89,10 @@ type Provider struct {
 type defaultClient struct {
 	controller.Client
 	kubernetes.Interface
-	scheme *runtime.Scheme
-	config *rest.Config
+	scheme      *runtime.Scheme
+	config      *rest.Config
+	kubeContext string
+	inCluster   bool
 }
 
 func (c *defaultClient) GetScheme() *runtime.Scheme {
@@ -85,19 +104,59 @@ func (c *defaultClient) GetConfig() *rest.Config {
 }
 
 func (c *defaultClient) GetCurrentNamespace(kubeConfig string) (string, error) {
-	return GetCurrentNamespace(kubeConfig)
+	if c.inCluster {
+		return getNamespaceFromKubernetesContainer()
+	}
+	return GetCurrentNamespaceForContext(kubeConfig, c.kubeContext)
 }
 
-// NewOutOfClusterClient creates a new k8s client that can be used from outside the cluster
-func NewOutOfClusterClient(kubeconfig string) (Client, error) {
-	initialize(kubeconfig)
+// NewOutOfClusterClient creates a new k8s client that can be used from outside the cluster.
+// The given kubeconfig context overrides the current context, when empty the current context is used.
+func NewOutOfClusterClient(kubeconfig string, kubeContext string, customizers ...ConfigCustomizer) (Client, error) {
+	cfg, err := GetOutOfClusterConfig(kubeconfig, kubeContext)
+	if err != nil {
+		return nil, err
+	}
+	for _, customizer := range customizers {
+		customizer(cfg)
+	}
+
 	// using fast discovery from outside the cluster
-	return NewClient(true)
+	c, err := newClient(cfg, true)
+	if err != nil {
+		return nil, err
+	}
+	c.kubeContext = kubeContext
+	return c, nil
 }
 
-func GetOutOfClusterConfig(kubeconfig string) (*rest.Config, error) {
+// NewInClusterClient creates a new k8s client using the service account of the pod the process is running in.
+// The current namespace is the namespace of the pod.
+func NewInClusterClient(customizers ...ConfigCustomizer) (Client, error) {
+	cfg, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load in-cluster configuration")
+	}
+	for _, customizer := range customizers {
+		customizer(cfg)
+	}
+
+	c, err := newClient(cfg, true)
+	if err != nil {
+		return nil, err
+	}
+	c.inCluster = true
+	return c, nil
+}
+
+// IsInCluster checks if the process runs in a pod without a kubeconfig so the in-cluster configuration should be used
+func IsInCluster() (bool, error) {
+	return shouldUseContainerMode()
+}
+
+func GetOutOfClusterConfig(kubeconfig string, kubeContext string) (*rest.Config, error) {
 	initialize(kubeconfig)
-	return config.GetConfig()
+	return config.GetConfigWithContext(kubeContext)
 }
 
 // NewClient creates a new k8s client that can be used from outside or in the cluster
@@ -108,6 +167,15 @@ func NewClient(fastDiscovery bool) (Client, error) {
 		return nil, err
 	}
 
+	c, err := newClient(cfg, fastDiscovery)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func newClient(cfg *rest.Config, fastDiscovery bool) (*defaultClient, error) {
+	var err error
 	scheme := clientscheme.Scheme
 
 	// Setup Scheme for all resources
@@ -203,6 +271,11 @@ func getDefaultKubeConfigFile() (string, error) {
 
 // GetCurrentNamespace --
 func GetCurrentNamespace(kubeconfig string) (string, error) {
+	return GetCurrentNamespaceForContext(kubeconfig, "")
+}
+
+// GetCurrentNamespaceForContext resolves the namespace of given kubeconfig context, uses the current context when empty
+func GetCurrentNamespaceForContext(kubeconfig string, kubeContext string) (string, error) {
 	if kubeconfig == "" {
 		kubeContainer, err := shouldUseContainerMode()
 		if err != nil {
@@ -239,7 +312,7 @@ func GetCurrentNamespace(kubeconfig string) (string, error) {
 
 	clientcmdconfig := decoded.(*clientcmdapi.Config)
 
-	cc := clientcmd.NewDefaultClientConfig(*clientcmdconfig, &clientcmd.ConfigOverrides{})
+	cc := clientcmd.NewDefaultClientConfig(*clientcmdconfig, &clientcmd.ConfigOverrides{CurrentContext: kubeContext})
 	ns, _, err := cc.Namespace()
 	return ns, err
 }
@@ -277,5 +350,5 @@ func getNamespaceFromKubernetesContainer() (string, error) {
 	if nsba, err = ioutil.ReadFile(inContainerNamespaceFile); err != nil {
 		return "", err
 	}
-	return string(nsba), nil
+	return strings.TrimSpace(string(nsba)), nil
 }
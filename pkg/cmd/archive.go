@@ -0,0 +1,230 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var archiveSuffixes = []string{".tar.gz", ".tgz", ".tar", ".zip"}
+
+// isArchive checks if given test source is a local test suite archive
+func isArchive(source string) bool {
+	if isRemoteFile(source) {
+		return false
+	}
+
+	for _, suffix := range archiveSuffixes {
+		if strings.HasSuffix(strings.ToLower(source), suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// extractArchive extracts the given test suite archive to a temporary directory and returns the directory
+// holding the YAKS configuration file. The returned cleanup function removes the temporary directory.
+func extractArchive(source string) (string, func(), error) {
+	dir, err := ioutil.TempDir("", "yaks-suite-")
+	if err != nil {
+		return "", nil, err
+	}
+
+	cleanup := func() {
+		_ = os.RemoveAll(dir)
+	}
+
+	if strings.HasSuffix(strings.ToLower(source), ".zip") {
+		err = extractZip(source, dir)
+	} else {
+		err = extractTar(source, dir)
+	}
+
+	if err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to extract test archive '%s': %v", source, err)
+	}
+
+	suiteDir, err := findSuiteDir(dir)
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+
+	return suiteDir, cleanup, nil
+}
+
+func extractTar(source string, dir string) error {
+	file, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if lower := strings.ToLower(source); strings.HasSuffix(lower, ".gz") || strings.HasSuffix(lower, ".tgz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		reader = gz
+	}
+
+	tr := tar.NewReader(reader)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		target, err := archiveTarget(dir, header.Name)
+		if err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := writeArchiveFile(target, tr); err != nil {
+				return err
+			}
+		default:
+			// links and special files are not supported in test archives
+		}
+	}
+}
+
+func extractZip(source string, dir string) error {
+	zr, err := zip.OpenReader(source)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+
+	for _, f := range zr.File {
+		target, err := archiveTarget(dir, f.Name)
+		if err != nil {
+			return err
+		}
+
+		if f.FileInfo().IsDir() {
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !f.Mode().IsRegular() {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return err
+		}
+
+		err = writeArchiveFile(target, rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// archiveTarget resolves the archive entry name in the target directory and rejects entries escaping the directory
+func archiveTarget(dir string, name string) (string, error) {
+	target := filepath.Join(dir, name)
+	if target != filepath.Clean(dir) && !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+		return "", fmt.Errorf("illegal file path in archive: %s", name)
+	}
+
+	return target, nil
+}
+
+func writeArchiveFile(target string, reader io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	/* #nosec */
+	out, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	/* #nosec */
+	_, err = io.Copy(out, reader)
+	return err
+}
+
+// findSuiteDir locates the top most directory holding the YAKS configuration file, defaults to the single
+// top level directory or the extraction directory itself
+func findSuiteDir(dir string) (string, error) {
+	suiteDir := ""
+	depth := -1
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() && info.Name() == ConfigFile {
+			d := strings.Count(p, string(os.PathSeparator))
+			if depth < 0 || d < depth {
+				suiteDir = filepath.Dir(p)
+				depth = d
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if suiteDir != "" {
+		return suiteDir, nil
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	if len(files) == 1 && files[0].IsDir() {
+		return filepath.Join(dir, files[0].Name()), nil
+	}
+
+	return dir, nil
+}
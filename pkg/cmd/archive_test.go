@@ -0,0 +1,77 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func writeTestArchive(t *testing.T, entries map[string]string) string {
+	file, err := ioutil.TempFile("", "yaks-suite-*.tar.gz")
+	assert.NilError(t, err)
+	defer file.Close()
+
+	gz := gzip.NewWriter(file)
+	tw := tar.NewWriter(gz)
+	for name, content := range entries {
+		assert.NilError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err = tw.Write([]byte(content))
+		assert.NilError(t, err)
+	}
+	assert.NilError(t, tw.Close())
+	assert.NilError(t, gz.Close())
+
+	return file.Name()
+}
+
+func TestExtractArchive(t *testing.T) {
+	archive := writeTestArchive(t, map[string]string{
+		"suite/yaks-config.yaml":  "config:\n  recursive: true\n",
+		"suite/hello.feature":     "Feature: Hello\n",
+		"suite/sub/other.feature": "Feature: Other\n",
+	})
+	defer os.Remove(archive)
+
+	assert.Assert(t, isArchive(archive))
+	dir, cleanup, err := extractArchive(archive)
+	assert.NilError(t, err)
+	assert.Equal(t, path.Base(dir), "suite")
+	assert.Assert(t, isDir(dir))
+	_, err = os.Stat(path.Join(dir, "sub", "other.feature"))
+	assert.NilError(t, err)
+
+	cleanup()
+	assert.Assert(t, !isDir(dir))
+}
+
+func TestExtractArchivePathTraversal(t *testing.T) {
+	archive := writeTestArchive(t, map[string]string{
+		"../evil.feature": "Feature: Evil\n",
+	})
+	defer os.Remove(archive)
+
+	_, _, err := extractArchive(archive)
+	assert.ErrorContains(t, err, "illegal file path")
+}
@@ -18,8 +18,6 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 )
 
@@ -80,11 +78,8 @@ func newCmdCompletionBash(root *cobra.Command) *cobra.Command {
 		Use:   "bash",
 		Short: "Generates bash completion scripts",
 		Long:  bashCompletionCmdLongDescription,
-		Run: func(_ *cobra.Command, _ []string) {
-			err := root.GenBashCompletion(root.OutOrStdout())
-			if err != nil {
-				fmt.Print(err.Error())
-			}
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return root.GenBashCompletion(root.OutOrStdout())
 		},
 		Annotations: map[string]string{
 			offlineCommandLabel: "true",
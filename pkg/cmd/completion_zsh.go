@@ -18,8 +18,6 @@ limitations under the License.
 package cmd
 
 import (
-	"fmt"
-
 	"github.com/spf13/cobra"
 )
 
@@ -48,11 +46,8 @@ func newCmdCompletionZsh(root *cobra.Command) *cobra.Command {
 		Use:   "zsh",
 		Short: "Generates zsh completion scripts",
 		Long:  zshCompletionCmdLongDescription,
-		Run: func(_ *cobra.Command, _ []string) {
-			err := root.GenZshCompletion(root.OutOrStdout())
-			if err != nil {
-				fmt.Print(err.Error())
-			}
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return root.GenZshCompletion(root.OutOrStdout())
 		},
 		Annotations: map[string]string{
 			offlineCommandLabel: "true",
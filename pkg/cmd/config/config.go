@@ -25,40 +25,59 @@ import (
 )
 
 const (
-	DefaultTimeout = "30m"
+	DefaultTimeout     = "30m"
+	DefaultWaitTimeout = "5m"
+	// DefaultNamespaceTimeout is the time to wait for a temporary namespace to become ready
+	DefaultNamespaceTimeout = "2m"
+
+	DefaultNamespacePrefix  = "yaks-"
+	DefaultFeatureExtension = ".feature"
+	// DefaultKubeDockImage runs the kubedock server when TestContainers are enabled
+	DefaultKubeDockImage = "joyrex2001/kubedock:0.7.0"
 )
 
 type RunConfig struct {
-	BaseDir string       `yaml:"baseDir"`
-	Config  Config       `yaml:"config"`
-	Pre     []StepConfig `yaml:"pre"`
-	Post    []StepConfig `yaml:"post"`
+	BaseDir    string       `yaml:"baseDir"`
+	Config     Config       `yaml:"config"`
+	Pre        []StepConfig `yaml:"pre"`
+	Post       []StepConfig `yaml:"post"`
+	BeforeEach []StepConfig `yaml:"beforeEach"`
+	AfterEach  []StepConfig `yaml:"afterEach"`
 }
 
 type Config struct {
-	Recursive bool            `yaml:"recursive"`
-	Timeout   string          `yaml:"timeout"`
-	Namespace NamespaceConfig `yaml:"namespace"`
-	Operator  OperatorConfig  `yaml:"operator"`
-	Runtime   RuntimeConfig   `yaml:"runtime"`
+	Recursive bool                         `yaml:"recursive"`
+	Timeout   string                       `yaml:"timeout"`
+	Namespace NamespaceConfig              `yaml:"namespace"`
+	Operator  OperatorConfig               `yaml:"operator"`
+	Runtime   RuntimeConfig                `yaml:"runtime"`
+	Labels    map[string]map[string]string `yaml:"labels"`
+	Client    ClientConfig                 `yaml:"client"`
 }
 
 type StepConfig struct {
-	Run     string `yaml:"run"`
-	Script  string `yaml:"script"`
-	Name    string `yaml:"name"`
-	Timeout string `yaml:"timeout"`
-	If      string `yaml:"if"`
+	Run      string `yaml:"run"`
+	Script   string `yaml:"script"`
+	Name     string `yaml:"name"`
+	Timeout  string `yaml:"timeout"`
+	If       string `yaml:"if"`
+	Shell    string `yaml:"shell"`
+	Template bool   `yaml:"template"`
 }
 
 type RuntimeConfig struct {
-	Cucumber       CucumberConfig       `yaml:"cucumber"`
-	Selenium       SeleniumConfig       `yaml:"selenium"`
-	TestContainers TestContainersConfig `yaml:"testcontainers"`
-	Resources      []string             `yaml:"resources"`
-	Settings       SettingsConfig       `yaml:"settings"`
-	Env            []EnvConfig          `yaml:"env"`
-	Secret         string               `yaml:"secret"`
+	Cucumber          CucumberConfig       `yaml:"cucumber"`
+	Selenium          SeleniumConfig       `yaml:"selenium"`
+	TestContainers    TestContainersConfig `yaml:"testcontainers"`
+	Resources         []string             `yaml:"resources"`
+	Settings          SettingsConfig       `yaml:"settings"`
+	Env               []EnvConfig          `yaml:"env"`
+	Secret            string               `yaml:"secret"`
+	DependsOn         []ResourceRefConfig  `yaml:"dependsOn"`
+	Manifests         []string             `yaml:"manifests"`
+	FeatureExtensions []string             `yaml:"featureExtensions"`
+	ShellPath         string               `yaml:"shellPath"`
+	MavenServers      []MavenServerConfig  `yaml:"mavenServers"`
 }
 
 type CucumberConfig struct {
@@ -68,11 +87,20 @@ type CucumberConfig struct {
 }
 
 type SeleniumConfig struct {
-	Image string `yaml:"image"`
+	Image        string `yaml:"image"`
+	ReadyTimeout string `yaml:"readyTimeout"`
 }
 
 type TestContainersConfig struct {
-	Enabled bool `yaml:"enabled"`
+	Enabled bool     `yaml:"enabled"`
+	Image   string   `yaml:"image"`
+	Args    []string `yaml:"args"`
+}
+
+type ResourceRefConfig struct {
+	Kind    string `yaml:"kind"`
+	Name    string `yaml:"name"`
+	Timeout string `yaml:"timeout"`
 }
 
 type EnvConfig struct {
@@ -81,6 +109,8 @@ type EnvConfig struct {
 }
 
 type SettingsConfig struct {
+	// Format of the settings passed to the runtime. One of: yaml|json, defaults to yaml
+	Format       string             `yaml:"format,omitempty"`
 	Repositories []RepositoryConfig `yaml:"repositories"`
 	Dependencies []DependencyConfig `yaml:"dependencies"`
 	Loggers      []LoggerConfig     `yaml:"loggers"`
@@ -95,6 +125,12 @@ type RepositoryConfig struct {
 	Snapshots PolicyConfig `yaml:"snapshots,omitempty"`
 }
 
+// MavenServerConfig references a secret holding the username and password of a Maven repository server
+type MavenServerConfig struct {
+	Id     string `yaml:"id"`
+	Secret string `yaml:"secret"`
+}
+
 type PolicyConfig struct {
 	Enabled      string `yaml:"enabled,omitempty"`
 	UpdatePolicy string `yaml:"updatePolicy,omitempty"`
@@ -113,22 +149,40 @@ type LoggerConfig struct {
 
 type NamespaceConfig struct {
 	Name       string `yaml:"name"`
+	Prefix     string `yaml:"prefix"`
 	Temporary  bool   `yaml:"temporary"`
 	AutoRemove bool   `yaml:"autoRemove"`
+	Timeout    string `yaml:"timeout"`
+}
+
+// ClientConfig holds the rate limits of the Kubernetes client, zero values keep the client defaults
+type ClientConfig struct {
+	QPS   float32 `yaml:"qps"`
+	Burst int     `yaml:"burst"`
 }
 
 type OperatorConfig struct {
-	Namespace string   `yaml:"namespace"`
-	Roles     []string `yaml:"roles"`
+	Namespace       string   `yaml:"namespace"`
+	Roles           []string `yaml:"roles"`
+	Image           string   `yaml:"image"`
+	ImagePullPolicy string   `yaml:"imagePullPolicy"`
+	Resources       []string `yaml:"resources"`
+	Global          bool     `yaml:"global"`
+	WatchNamespaces []string `yaml:"watchNamespaces"`
 }
 
 func NewWithDefaults() *RunConfig {
 	ns := NamespaceConfig{
+		Prefix:     DefaultNamespacePrefix,
 		AutoRemove: true,
 		Temporary:  false,
 	}
 
-	var config = Config{Recursive: true, Namespace: ns, Timeout: DefaultTimeout}
+	runtime := RuntimeConfig{
+		FeatureExtensions: []string{DefaultFeatureExtension},
+	}
+
+	var config = Config{Recursive: true, Namespace: ns, Timeout: DefaultTimeout, Runtime: runtime}
 	return &RunConfig{Config: config, BaseDir: ""}
 }
 
@@ -0,0 +1,88 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Schema returns the JSON Schema of the yaks-config.yaml. The schema is derived from the RunConfig types and their YAML
+// field names, non empty defaults of NewWithDefaults are added as default values.
+func Schema() ([]byte, error) {
+	schema := typeSchema(reflect.TypeOf(RunConfig{}), reflect.ValueOf(*NewWithDefaults()))
+	schema["$schema"] = "http://json-schema.org/draft-07/schema#"
+	schema["title"] = "YAKS run configuration"
+
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+func typeSchema(t reflect.Type, defaults reflect.Value) map[string]interface{} {
+	schema := make(map[string]interface{})
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return typeSchema(t.Elem(), reflect.Value{})
+	case reflect.Struct:
+		properties := make(map[string]interface{}, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+			if field.PkgPath != "" || name == "-" {
+				continue
+			}
+			if name == "" {
+				name = strings.ToLower(field.Name)
+			}
+
+			var fieldDefaults reflect.Value
+			if defaults.IsValid() {
+				fieldDefaults = defaults.Field(i)
+			}
+			properties[name] = typeSchema(field.Type, fieldDefaults)
+		}
+
+		schema["type"] = "object"
+		schema["properties"] = properties
+		schema["additionalProperties"] = false
+		// nested sections have no defaults of their own, the defaults are set on the fields
+		return schema
+	case reflect.Slice, reflect.Array:
+		schema["type"] = "array"
+		schema["items"] = typeSchema(t.Elem(), reflect.Value{})
+	case reflect.Map:
+		schema["type"] = "object"
+		schema["additionalProperties"] = typeSchema(t.Elem(), reflect.Value{})
+	case reflect.String:
+		schema["type"] = "string"
+	case reflect.Bool:
+		schema["type"] = "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		schema["type"] = "integer"
+	case reflect.Float32, reflect.Float64:
+		schema["type"] = "number"
+	}
+
+	if defaults.IsValid() && !defaults.IsZero() {
+		schema["default"] = defaults.Interface()
+	}
+
+	return schema
+}
@@ -0,0 +1,72 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+	"gotest.tools/v3/assert"
+)
+
+func TestSchema(t *testing.T) {
+	data, err := Schema()
+	assert.NilError(t, err)
+
+	var schema map[string]interface{}
+	assert.NilError(t, json.Unmarshal(data, &schema))
+
+	property := func(schema map[string]interface{}, path ...string) map[string]interface{} {
+		for _, name := range path {
+			properties, ok := schema["properties"].(map[string]interface{})
+			assert.Assert(t, ok, "missing properties for '%s'", name)
+			schema, ok = properties[name].(map[string]interface{})
+			assert.Assert(t, ok, "missing property '%s'", name)
+		}
+		return schema
+	}
+
+	tags := property(schema, "config", "runtime", "cucumber", "tags")
+	assert.Equal(t, tags["type"], "array")
+	assert.DeepEqual(t, tags["items"], map[string]interface{}{"type": "string"})
+	assert.Equal(t, property(schema, "config", "namespace", "prefix")["default"], DefaultNamespacePrefix)
+	assert.Equal(t, property(schema, "config", "recursive")["type"], "boolean")
+	assert.Equal(t, property(schema, "config", "labels")["type"], "object")
+
+	// every field of the config must be part of the schema
+	raw, err := yaml.Marshal(NewWithDefaults())
+	assert.NilError(t, err)
+	var defaults map[string]interface{}
+	assert.NilError(t, yaml.Unmarshal(raw, &defaults))
+
+	var verify func(schema map[string]interface{}, values map[interface{}]interface{})
+	verify = func(schema map[string]interface{}, values map[interface{}]interface{}) {
+		for key, value := range values {
+			field := property(schema, key.(string))
+			if nested, ok := value.(map[interface{}]interface{}); ok && field["properties"] != nil {
+				verify(field, nested)
+			}
+		}
+	}
+	for key, value := range defaults {
+		if nested, ok := value.(map[interface{}]interface{}); ok {
+			verify(property(schema, key), nested)
+		}
+	}
+}
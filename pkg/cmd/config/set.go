@@ -0,0 +1,94 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Set overrides the field of the run configuration at given dotted path, e.g. "runtime.cucumber.tags". Paths are relative
+// to the config section and use the YAML field names. Lists are given as comma separated values.
+func Set(runConfig *RunConfig, path string, value string) error {
+	value = strings.TrimSpace(value)
+	field := reflect.ValueOf(&runConfig.Config).Elem()
+
+	for _, name := range strings.Split(strings.TrimPrefix(path, "config."), ".") {
+		if field.Kind() != reflect.Struct {
+			return fmt.Errorf("invalid config path '%s': '%s' is not a section", path, name)
+		}
+
+		next, ok := fieldByYamlName(field, name)
+		if !ok {
+			return fmt.Errorf("invalid config path '%s': unknown field '%s'", path, name)
+		}
+		field = next
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value '%s' for config path '%s': %v", value, path, err)
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid value '%s' for config path '%s': %v", value, path, err)
+		}
+		field.SetInt(i)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid value '%s' for config path '%s': %v", value, path, err)
+		}
+		field.SetFloat(f)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("invalid config path '%s': only lists of strings can be set", path)
+		}
+
+		items := make([]string, 0)
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("invalid config path '%s': unsupported field type %s", path, field.Type())
+	}
+
+	return nil
+}
+
+func fieldByYamlName(v reflect.Value, name string) (reflect.Value, bool) {
+	for i := 0; i < v.NumField(); i++ {
+		tag := strings.Split(v.Type().Field(i).Tag.Get("yaml"), ",")[0]
+		if tag == name {
+			return v.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
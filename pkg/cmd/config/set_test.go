@@ -0,0 +1,53 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSet(t *testing.T) {
+	runConfig := NewWithDefaults()
+	runConfig.Config.Recursive = true
+
+	assert.NilError(t, Set(runConfig, "runtime.cucumber.tags", "@smoke, not @wip"))
+	assert.DeepEqual(t, runConfig.Config.Runtime.Cucumber.Tags, []string{"@smoke", "not @wip"})
+
+	assert.NilError(t, Set(runConfig, "config.namespace.temporary", "true"))
+	assert.Equal(t, runConfig.Config.Namespace.Temporary, true)
+
+	assert.NilError(t, Set(runConfig, "recursive", "false"))
+	assert.Equal(t, runConfig.Config.Recursive, false)
+
+	assert.NilError(t, Set(runConfig, "runtime.selenium.image", " selenium/standalone-firefox "))
+	assert.Equal(t, runConfig.Config.Runtime.Selenium.Image, "selenium/standalone-firefox")
+
+	assert.NilError(t, Set(runConfig, "client.qps", "42.5"))
+	assert.Equal(t, runConfig.Config.Client.QPS, float32(42.5))
+}
+
+func TestSetInvalid(t *testing.T) {
+	runConfig := NewWithDefaults()
+
+	assert.Error(t, Set(runConfig, "runtime.cucumber.unknown", "foo"), "invalid config path 'runtime.cucumber.unknown': unknown field 'unknown'")
+	assert.Error(t, Set(runConfig, "runtime.cucumber.tags.first", "foo"), "invalid config path 'runtime.cucumber.tags.first': 'first' is not a section")
+	assert.ErrorContains(t, Set(runConfig, "namespace.temporary", "maybe"), "invalid value 'maybe' for config path 'namespace.temporary'")
+	assert.Error(t, Set(runConfig, "runtime.env", "FOO"), "invalid config path 'runtime.env': only lists of strings can be set")
+}
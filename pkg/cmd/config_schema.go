@@ -0,0 +1,60 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/citrusframework/yaks/pkg/cmd/config"
+	"github.com/spf13/cobra"
+)
+
+func newCmdConfig() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "config",
+		Short: "Work with the yaks-config.yaml run configuration",
+		Annotations: map[string]string{
+			offlineCommandLabel: "true",
+		},
+	}
+
+	cmd.AddCommand(newCmdConfigSchema())
+
+	return &cmd
+}
+
+func newCmdConfigSchema() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of the yaks-config.yaml",
+		Long:  `Print the JSON Schema of the yaks-config.yaml. Point your editor to the schema in order to get validation and completion of the run configuration.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			schema, err := config.Schema()
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(schema))
+			return err
+		},
+		Annotations: map[string]string{
+			offlineCommandLabel: "true",
+		},
+	}
+}
@@ -21,10 +21,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strconv"
 
 	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
+	"github.com/citrusframework/yaks/pkg/client"
+	"github.com/citrusframework/yaks/pkg/install"
 	"github.com/citrusframework/yaks/pkg/util/kubernetes"
+	"github.com/citrusframework/yaks/pkg/util/log"
 	"github.com/spf13/cobra"
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,22 +49,21 @@ func newCmdDelete(rootCmdOptions *RootCmdOptions) (*cobra.Command, *deleteCmdOpt
 			if err := options.validateArgs(command, args); err != nil {
 				return err
 			}
-			if err := options.run(args); err != nil {
-				fmt.Println(err.Error())
-			}
 
-			return nil
+			return options.run(command.OutOrStdout(), args)
 		},
 	}
 
-	cmd.Flags().BoolP("all", "a", false, "Delete all tests")
+	cmd.Flags().BoolP("all", "a", false, "Delete all tests created by YAKS runs")
+	cmd.Flags().String("temp-namespace", "", "Delete given temporary test namespace created by a YAKS run")
 
 	return &cmd, &options
 }
 
 type deleteCmdOptions struct {
 	*RootCmdOptions
-	DeleteAll bool `mapstructure:"all"`
+	DeleteAll     bool   `mapstructure:"all"`
+	TempNamespace string `mapstructure:"temp-namespace"`
 }
 
 func (o *deleteCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
@@ -68,14 +71,14 @@ func (o *deleteCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
 		return errors.New("invalid combination: both all flag and named tests are set")
 	}
 
-	if !o.DeleteAll && len(args) == 0 {
-		return errors.New("invalid combination: neither all flag nor named tests are set")
+	if !o.DeleteAll && len(args) == 0 && o.TempNamespace == "" {
+		return errors.New("invalid combination: neither all flag nor named tests nor temporary namespace are set")
 	}
 
 	return nil
 }
 
-func (o *deleteCmdOptions) run(args []string) error {
+func (o *deleteCmdOptions) run(out io.Writer, args []string) error {
 	c, err := o.GetCmdClient()
 	if err != nil {
 		return err
@@ -87,12 +90,18 @@ func (o *deleteCmdOptions) run(args []string) error {
 		for _, arg := range args {
 			name := kubernetes.SanitizeName(arg)
 
-			if err := deleteTest(o.Context, c, namespace, name); err != nil {
+			if err := deleteTest(o.Context, c, out, namespace, name); err != nil {
 				return err
 			}
 		}
 	} else if o.DeleteAll {
-		if err := deleteAllTests(o.Context, c, namespace, o.Verbose); err != nil {
+		if err := deleteAllTests(o.Context, c, out, namespace, o.Verbose); err != nil {
+			return err
+		}
+	}
+
+	if o.TempNamespace != "" {
+		if err := deleteOwnedNamespace(o.Context, c, out, o.TempNamespace); err != nil {
 			return err
 		}
 	}
@@ -100,7 +109,7 @@ func (o *deleteCmdOptions) run(args []string) error {
 	return nil
 }
 
-func deleteTest(ctx context.Context, c k8sclient.Client, namespace string, name string) error {
+func deleteTest(ctx context.Context, c k8sclient.Client, out io.Writer, namespace string, name string) error {
 	test := v1alpha1.Test{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: v1alpha1.SchemeGroupVersion.String(),
@@ -114,18 +123,18 @@ func deleteTest(ctx context.Context, c k8sclient.Client, namespace string, name
 	err := c.Delete(ctx, &test)
 	if err != nil {
 		if k8errors.IsNotFound(err) {
-			fmt.Println("Test " + name + " not found. Skipped.")
+			fmt.Fprintln(out, "Test "+name+" not found. Skipped.")
 		} else {
 			return err
 		}
 	} else {
-		fmt.Println("Test " + name + " deleted")
+		fmt.Fprintln(out, "Test "+name+" deleted")
 	}
 
 	return nil
 }
 
-func deleteAllTests(ctx context.Context, c k8sclient.Client, namespace string, verbose bool) error {
+func deleteAllTests(ctx context.Context, c k8sclient.Client, out io.Writer, namespace string, verbose bool) error {
 	testList := v1alpha1.TestList{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: v1alpha1.SchemeGroupVersion.String(),
@@ -134,7 +143,8 @@ func deleteAllTests(ctx context.Context, c k8sclient.Client, namespace string, v
 	}
 
 	//Looks like Operator SDK doesn't support deletion of all objects with one command
-	err := c.List(ctx, &testList, k8sclient.InNamespace(namespace))
+	//only tests created by YAKS runs are taken into account
+	err := c.List(ctx, &testList, k8sclient.InNamespace(namespace), k8sclient.HasLabels{v1alpha1.TestRunIdLabel})
 	if err != nil {
 		return err
 	}
@@ -146,14 +156,39 @@ func deleteAllTests(ctx context.Context, c k8sclient.Client, namespace string, v
 		}
 
 		if verbose {
-			fmt.Println("Test " + test.Name + " deleted")
+			fmt.Fprintln(out, "Test "+test.Name+" deleted")
 		}
 	}
 	if len(testList.Items) == 0 {
-		fmt.Printf("No tests found in namespace '%s'\n", namespace)
+		fmt.Fprintf(out, "No tests found in namespace '%s'\n", namespace)
 	} else {
-		fmt.Println(strconv.Itoa(len(testList.Items)) + " test(s) deleted")
+		fmt.Fprintln(out, strconv.Itoa(len(testList.Items))+" test(s) deleted")
+	}
+
+	return nil
+}
+
+// deleteOwnedNamespace deletes the temporary namespace with given name. Namespaces that have not been created by a YAKS run are left untouched.
+func deleteOwnedNamespace(ctx context.Context, c client.Client, writer io.Writer, name string) error {
+	ns, err := c.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if k8errors.IsNotFound(err) {
+			fmt.Fprintln(writer, "Namespace "+name+" not found. Skipped.")
+			return nil
+		}
+		return err
+	}
+
+	if _, ok := ns.Labels[v1alpha1.TestRunIdLabel]; !ok {
+		return fmt.Errorf("namespace %s has not been created by YAKS, refusing to delete it", name)
+	}
+
+	out := newOutput(writer, false, log.Log)
+	watchNamespaces, err := install.WatchedNamespaces(ctx, c, name)
+	if err != nil {
+		out.Errorf("WARN: Failed to look up namespaces watched by the operator in namespace %s: %v", name, err)
 	}
 
+	deleteTempNamespace(ns, watchNamespaces, c, ctx, out)
 	return nil
 }
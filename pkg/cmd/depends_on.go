@@ -0,0 +1,114 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/citrusframework/yaks/pkg/client"
+	"github.com/citrusframework/yaks/pkg/cmd/config"
+	"github.com/citrusframework/yaks/pkg/util/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// waitForDependencies waits for all resources the tests depend on to be ready
+func (o *runCmdOptions) waitForDependencies(c client.Client, runConfig *config.RunConfig) error {
+	namespace := runConfig.Config.Namespace.Name
+
+	for _, dependency := range runConfig.Config.Runtime.DependsOn {
+		timeout := dependency.Timeout
+		if timeout == "" {
+			timeout = config.DefaultWaitTimeout
+		}
+
+		waitTimeout, err := time.ParseDuration(timeout)
+		if err != nil {
+			return fmt.Errorf("invalid timeout for %s '%s': %v", dependency.Kind, dependency.Name, err)
+		}
+
+		var obj ctrl.Object
+		var condition kubernetes.ResourceCheckFunction
+		objectMeta := metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      dependency.Name,
+		}
+
+		switch strings.ToLower(dependency.Kind) {
+		case "deployment":
+			obj = &appsv1.Deployment{ObjectMeta: objectMeta}
+			condition = isDeploymentAvailable
+		case "pod":
+			obj = &corev1.Pod{ObjectMeta: objectMeta}
+			condition = isPodReady
+		case "service":
+			// a service is ready as soon as its endpoints provide an address
+			obj = &corev1.Endpoints{ObjectMeta: objectMeta}
+			condition = hasEndpointAddresses
+		default:
+			return fmt.Errorf("unsupported dependency kind '%s', should be one of: Deployment|Pod|Service", dependency.Kind)
+		}
+
+		o.out.Printf("Waiting for %s '%s' to be ready", dependency.Kind, dependency.Name)
+		if err := kubernetes.WaitCondition(o.Context, c, obj, condition, waitTimeout); err != nil {
+			return fmt.Errorf("failed to wait for %s '%s' to be ready: %v", dependency.Kind, dependency.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func isDeploymentAvailable(obj interface{}) (bool, error) {
+	if deployment, ok := obj.(*appsv1.Deployment); ok {
+		for _, condition := range deployment.Status.Conditions {
+			if condition.Type == appsv1.DeploymentAvailable {
+				return condition.Status == corev1.ConditionTrue, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func isPodReady(obj interface{}) (bool, error) {
+	if pod, ok := obj.(*corev1.Pod); ok {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodReady {
+				return condition.Status == corev1.ConditionTrue, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func hasEndpointAddresses(obj interface{}) (bool, error) {
+	if endpoints, ok := obj.(*corev1.Endpoints); ok {
+		for _, subset := range endpoints.Subsets {
+			if len(subset.Addresses) > 0 {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,140 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
+	"github.com/citrusframework/yaks/pkg/client"
+	"github.com/citrusframework/yaks/pkg/cmd/config"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// serverDryRun submits the manifests and tests of given test source with server-side dry run, so admission webhooks and
+// policies of the cluster validate the resources without persisting them
+func (o *runCmdOptions) serverDryRun(source string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	runConfig, err := o.getRunConfig(source)
+	if err != nil {
+		return err
+	}
+
+	if runConfig.Config.Namespace.Temporary || runConfig.Config.Namespace.Name == "" {
+		// temporary namespaces do not exist yet, validate against the current namespace instead
+		runConfig.Config.Namespace.Name = o.Namespace
+		o.out.Printf("Server dry run uses namespace '%s' instead of a temporary namespace", o.Namespace)
+	}
+
+	if err := o.verifyTestCRD(c); err != nil {
+		return err
+	}
+
+	rejected, err := o.dryRunManifests(c, runConfig)
+	if err != nil {
+		o.out.Errorf("Server dry run failed to load manifests - %v", err)
+		rejected++
+	}
+
+	var tests []*v1alpha1.Test
+	if isDir(source) {
+		if tests, err = o.newTestGroup(source, nil); err != nil {
+			return err
+		}
+	} else {
+		test, err := o.newTest(source, runConfig)
+		if err != nil {
+			return err
+		}
+		tests = append(tests, test)
+	}
+
+	for _, test := range tests {
+		test.Namespace = runConfig.Config.Namespace.Name
+		stampTest(test)
+
+		if !o.SkipValidation && test.Spec.Source.Language == v1alpha1.LanguageGherkin {
+			if err := validateGherkin(test.Spec.Source.Content); err != nil {
+				o.out.Errorf("Invalid feature file of test '%s' - %v", test.Name, err)
+				rejected++
+				continue
+			}
+		}
+
+		if err := dryRunApply(o.Context, c, test); err != nil {
+			o.out.Errorf("Server dry run rejected test '%s' - %v", test.Name, err)
+			rejected++
+			continue
+		}
+		o.out.Printf("Server dry run accepted test '%s'", test.Name)
+	}
+
+	if rejected > 0 {
+		return fmt.Errorf("server dry run rejected %d resource(s)", rejected)
+	}
+
+	return nil
+}
+
+// dryRunManifests validates all resources of the configured manifest files with server-side dry run. Every rejected
+// resource is reported, the number of rejected resources is returned.
+func (o *runCmdOptions) dryRunManifests(c client.Client, runConfig *config.RunConfig) (int, error) {
+	resources, err := o.loadManifests(c, runConfig)
+	if err != nil {
+		return 0, err
+	}
+
+	rejected := 0
+	for _, resource := range resources {
+		obj, kind := resource.obj, resource.obj.GetObjectKind().GroupVersionKind().Kind
+		if err := dryRunApply(o.Context, c, obj); err != nil {
+			o.out.Errorf("Server dry run rejected %s '%s' from manifest %s - %v", kind, obj.GetName(), resource.manifest, err)
+			rejected++
+			continue
+		}
+
+		o.out.Printf("Server dry run accepted %s '%s' from manifest %s", kind, obj.GetName(), resource.manifest)
+	}
+
+	return rejected, nil
+}
+
+// dryRunApply creates or updates given object with server-side dry run, nothing gets persisted
+func dryRunApply(ctx context.Context, c client.Client, obj ctrl.Object) error {
+	err := c.Create(ctx, obj, ctrl.DryRunAll)
+	if err == nil || !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	existing, ok := obj.DeepCopyObject().(ctrl.Object)
+	if !ok {
+		return err
+	}
+	if err := c.Get(ctx, ctrl.ObjectKeyFromObject(obj), existing); err != nil {
+		return err
+	}
+	obj.SetResourceVersion(existing.GetResourceVersion())
+
+	return c.Update(ctx, obj, ctrl.DryRunAll)
+}
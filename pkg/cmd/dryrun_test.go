@@ -0,0 +1,112 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/citrusframework/yaks/pkg/cmd/config"
+	"github.com/citrusframework/yaks/pkg/util/log"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// rejectingClient rejects the creation of all resources whose name starts with "invalid"
+type rejectingClient struct {
+	ctrl.Client
+	*kubefake.Clientset
+	scheme *runtime.Scheme
+}
+
+func (c *rejectingClient) Create(ctx context.Context, obj ctrl.Object, opts ...ctrl.CreateOption) error {
+	if strings.HasPrefix(obj.GetName(), "invalid") {
+		return errors.New("denied by admission webhook")
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *rejectingClient) GetScheme() *runtime.Scheme {
+	return c.scheme
+}
+
+func (c *rejectingClient) GetConfig() *rest.Config {
+	return nil
+}
+
+func (c *rejectingClient) GetCurrentNamespace(string) (string, error) {
+	return "default", nil
+}
+
+func TestDryRunManifestsReportsAllRejections(t *testing.T) {
+	dir := t.TempDir()
+	manifest := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: invalid-first
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: valid
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: invalid-second
+`
+	assert.NilError(t, ioutil.WriteFile(path.Join(dir, "manifest.yaml"), []byte(manifest), 0644))
+
+	scheme := runtime.NewScheme()
+	assert.NilError(t, clientgoscheme.AddToScheme(scheme))
+	clientset := kubefake.NewSimpleClientset()
+	clientset.Resources = []*metav1.APIResourceList{{GroupVersion: "image.openshift.io/v1"}}
+	c := &rejectingClient{
+		Client:    fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Clientset: clientset,
+		scheme:    scheme,
+	}
+
+	runConfig := config.NewWithDefaults()
+	runConfig.BaseDir = dir
+	runConfig.Config.Namespace.Name = "default"
+	runConfig.Config.Runtime.Manifests = []string{"manifest.yaml"}
+
+	var out, errOut bytes.Buffer
+	options := runCmdOptions{RootCmdOptions: &RootCmdOptions{Context: context.Background()}, out: newOutput(&out, false, log.Log)}
+	options.out.err = &errOut
+
+	rejected, err := options.dryRunManifests(c, runConfig)
+	assert.NilError(t, err)
+	assert.Equal(t, rejected, 2)
+	assert.Equal(t, out.String(), "Server dry run accepted ConfigMap 'valid' from manifest manifest.yaml\n")
+	assert.Equal(t, errOut.String(),
+		"Server dry run rejected ConfigMap 'invalid-first' from manifest manifest.yaml - denied by admission webhook\n"+
+			"Server dry run rejected ConfigMap 'invalid-second' from manifest manifest.yaml - denied by admission webhook\n")
+}
@@ -0,0 +1,32 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strings"
+
+	"github.com/cucumber/common/gherkin/go/v22"
+	messages "github.com/cucumber/common/messages/go/v17"
+)
+
+// validateGherkin parses the given feature source with the Cucumber Gherkin parser, so features are checked with the
+// same rules and languages as in the test runtime. The error lists all syntax errors with their line and column.
+func validateGherkin(source string) error {
+	_, err := gherkin.ParseGherkinDocument(strings.NewReader(source), (&messages.Incrementing{}).NewId)
+	return err
+}
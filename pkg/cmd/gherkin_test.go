@@ -0,0 +1,65 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestValidateGherkin(t *testing.T) {
+	valid := `# comment
+@smoke
+Feature: Orders
+  As a user I want to place orders
+
+  Background:
+    Given URL: http://order-service
+
+  Scenario Outline: Place order
+    Given HTTP request body
+    """
+    {"id": <id>}
+    """
+    When send POST /orders
+    Then receive HTTP 201 Created
+
+    Examples:
+      | id |
+      | 1  |
+`
+	assert.NilError(t, validateGherkin(valid))
+	assert.NilError(t, validateGherkin("# language: de\nFunktionalität: Bestellung\n  Szenario: Bestellen\n    Angenommen URL: http://order-service"))
+
+	err := validateGherkin("Scenario: No feature")
+	assert.ErrorContains(t, err, "(1:1): expected: #EOF, #Language, #TagLine, #FeatureLine, #Comment, #Empty, got 'Scenario: No feature'")
+
+	err = validateGherkin("Feature: Orders\n  Scenario: Place order\n    When send POST /orders\n    receive HTTP 201")
+	assert.ErrorContains(t, err, "(4:5): expected: #EOF, #TableRow, #DocStringSeparator, #StepLine")
+
+	err = validateGherkin("Feature: Orders\n  Scenario: Place order\n    Given table\n      | a | b |\n      | 1 |")
+	assert.ErrorContains(t, err, "(5:7): inconsistent cell count within the table")
+
+	err = validateGherkin("Feature: Orders\n  Scenario: Place order\n    Given body\n    \"\"\"\n    {}")
+	assert.ErrorContains(t, err, "unexpected end of file, expected: #DocStringSeparator")
+
+	err = validateGherkin("Scenario: No feature\nFeature: Orders\n  Scenario: Place order\n    When send POST /orders\n    receive HTTP 201")
+	assert.ErrorContains(t, err, "(1:1)")
+	assert.ErrorContains(t, err, "(5:5)")
+}
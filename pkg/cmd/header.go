@@ -0,0 +1,90 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+)
+
+// headerDirectivePrefix starts a comment in the feature file header that declares a runtime requirement of the feature,
+// e.g. "# yaks:dependency mvn:org.foo:foo-steps:1.0"
+const headerDirectivePrefix = "yaks:"
+
+// featureHeader holds the runtime requirements declared in the comment header of a feature file
+type featureHeader struct {
+	dependencies []string
+	repositories []string
+	glue         []string
+	loggers      []string
+}
+
+// parseFeatureHeader reads the directives from the comments in front of the Feature keyword. Each directive is validated
+// the same way as the corresponding command line option.
+func parseFeatureHeader(source string) (featureHeader, error) {
+	header := featureHeader{}
+
+	scanner := bufio.NewScanner(strings.NewReader(source))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(text, "Feature:") {
+			break
+		}
+
+		comment := strings.TrimSpace(strings.TrimPrefix(text, "#"))
+		if comment == text || !strings.HasPrefix(comment, headerDirectivePrefix) {
+			continue
+		}
+
+		fields := strings.Fields(strings.TrimPrefix(comment, headerDirectivePrefix))
+		if len(fields) != 2 {
+			return header, fmt.Errorf("invalid directive '%s' in line %d, must be of format '# yaks:<directive> <value>'", text, line)
+		}
+
+		directive, value := fields[0], fields[1]
+		var err error
+		switch directive {
+		case "dependency":
+			var dependencies []string
+			if dependencies, err = validateDependencies([]string{value}); err == nil {
+				header.dependencies = append(header.dependencies, dependencies...)
+			}
+		case "repository":
+			if _, err = validateRepositories([]string{value}); err == nil {
+				header.repositories = append(header.repositories, value)
+			}
+		case "glue":
+			if err = validateGlue([]string{value}); err == nil {
+				header.glue = append(header.glue, value)
+			}
+		case "logger":
+			if _, err = validateLoggers([]string{value}); err == nil {
+				header.loggers = append(header.loggers, value)
+			}
+		default:
+			err = fmt.Errorf("unknown directive '%s', should be one of: dependency|repository|glue|logger", directive)
+		}
+
+		if err != nil {
+			return header, fmt.Errorf("line %d: %v", line, err)
+		}
+	}
+
+	return header, scanner.Err()
+}
@@ -0,0 +1,123 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+)
+
+const IgnoreFile = ".yaksignore"
+
+// ignoreRule is a single gitignore style pattern read from an ignore file
+type ignoreRule struct {
+	// base is the directory holding the ignore file, patterns are relative to this directory
+	base     string
+	pattern  string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+// ignoreRules holds the rules of all ignore files from the group root down to the current directory
+type ignoreRules []ignoreRule
+
+// loadIgnoreRules adds the rules of the ignore file in given directory to the inherited rules
+func loadIgnoreRules(dir string, inherited ignoreRules) (ignoreRules, error) {
+	file, err := os.Open(path.Join(dir, IgnoreFile))
+	if os.IsNotExist(err) {
+		return inherited, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	rules := make(ignoreRules, len(inherited))
+	copy(rules, inherited)
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule := ignoreRule{base: path.Clean(dir)}
+		if strings.HasPrefix(line, "!") {
+			rule.negate = true
+			line = line[1:]
+		}
+
+		if strings.HasSuffix(line, "/") {
+			rule.dirOnly = true
+			line = strings.TrimSuffix(line, "/")
+		}
+
+		line = strings.TrimPrefix(line, "**/")
+		if strings.Contains(line, "/") {
+			rule.anchored = true
+			line = strings.TrimPrefix(line, "/")
+		}
+
+		if _, err := path.Match(line, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern '%s' in %s: %v", line, path.Join(dir, IgnoreFile), err)
+		}
+
+		rule.pattern = line
+		rules = append(rules, rule)
+	}
+
+	return rules, scanner.Err()
+}
+
+// isIgnored checks if given file or directory is excluded by the rules, the last matching rule wins
+func (rules ignoreRules) isIgnored(name string, isDir bool) bool {
+	ignored := false
+	for _, rule := range rules {
+		if rule.matches(name, isDir) {
+			ignored = !rule.negate
+		}
+	}
+
+	return ignored
+}
+
+func (rule ignoreRule) matches(name string, isDir bool) bool {
+	if rule.dirOnly && !isDir {
+		return false
+	}
+
+	rel := path.Clean(name)
+	if rule.base != "." {
+		if !strings.HasPrefix(rel, rule.base+"/") {
+			return false
+		}
+		rel = strings.TrimPrefix(rel, rule.base+"/")
+	}
+
+	if rule.anchored {
+		matched, _ := path.Match(rule.pattern, rel)
+		return matched
+	}
+
+	matched, _ := path.Match(rule.pattern, path.Base(name))
+	return matched
+}
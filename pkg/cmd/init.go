@@ -0,0 +1,298 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	InitTemplateHTTP      = "http"
+	InitTemplateMessaging = "messaging"
+	InitTemplateDB        = "db"
+)
+
+func newCmdInit(rootCmdOptions *RootCmdOptions) (*cobra.Command, *initCmdOptions) {
+	options := initCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "init [options] <name>",
+		Short:   "Scaffold a new test",
+		Long:    `Creates a feature file skeleton and a starter yaks-config.yaml for a new test.`,
+		Args:    options.validateArgs,
+		PreRunE: decode(&options),
+		RunE:    options.run,
+		Annotations: map[string]string{
+			offlineCommandLabel: "true",
+		},
+	}
+
+	cmd.Flags().String("dir", "", "Directory to create the test in, defaults to the current directory")
+	cmd.Flags().String("template", "", "Preset for the test. One of: http|messaging|db")
+	cmd.Flags().Bool("steps", false, "Add a steps directory holding a Maven module for custom glue code")
+	cmd.Flags().Bool("force", false, "Overwrite existing files")
+
+	return &cmd, &options
+}
+
+type initCmdOptions struct {
+	*RootCmdOptions
+	Dir      string `mapstructure:"dir"`
+	Template string `mapstructure:"template"`
+	Steps    bool   `mapstructure:"steps"`
+	Force    bool   `mapstructure:"force"`
+}
+
+func (o *initCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("init expects exactly one argument, the name of the test")
+	}
+
+	if name := args[0]; name == "" || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid test name '%s', must not be empty or contain path separators", name)
+	}
+
+	return nil
+}
+
+func (o *initCmdOptions) run(cmd *cobra.Command, args []string) error {
+	switch o.Template {
+	case "", InitTemplateHTTP, InitTemplateMessaging, InitTemplateDB:
+	default:
+		return fmt.Errorf("invalid template '%s', should be one of: http|messaging|db", o.Template)
+	}
+
+	name := strings.TrimSuffix(args[0], FileSuffix)
+	files, err := scaffold(name, o.Template, o.Steps)
+	if err != nil {
+		return err
+	}
+
+	dir := o.Dir
+	if dir == "" {
+		dir = "."
+	}
+
+	if !o.Force {
+		for _, file := range files {
+			if _, err := os.Stat(path.Join(dir, file.name)); err == nil {
+				return fmt.Errorf("file '%s' already exists - use the force option to overwrite", path.Join(dir, file.name))
+			}
+		}
+	}
+
+	for _, file := range files {
+		target := path.Join(dir, file.name)
+		if err := os.MkdirAll(path.Dir(target), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(target, []byte(file.content), 0644); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Created %s\n", target)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Run the test with: yaks run %s\n", path.Join(dir, name+FileSuffix))
+	return nil
+}
+
+type scaffoldFile struct {
+	name    string
+	content string
+}
+
+// scaffold renders the files of a new test with given name and template
+func scaffold(name string, preset string, steps bool) ([]scaffoldFile, error) {
+	data := struct {
+		Name     string
+		Template string
+		Steps    bool
+	}{
+		Name:     name,
+		Template: preset,
+		Steps:    steps,
+	}
+
+	files := []scaffoldFile{
+		{name: name + FileSuffix, content: featureTemplate},
+		{name: ConfigFile, content: configTemplate},
+	}
+	for i, file := range files {
+		tmpl, err := template.New(file.name).Parse(file.content)
+		if err != nil {
+			return nil, err
+		}
+
+		var out bytes.Buffer
+		if err := tmpl.Execute(&out, data); err != nil {
+			return nil, err
+		}
+		files[i].content = out.String()
+	}
+
+	if steps {
+		files = append(files,
+			scaffoldFile{name: "steps/pom.xml", content: stepsPomTemplate},
+			scaffoldFile{name: "steps/src/main/java/org/example/steps/CustomSteps.java", content: stepsTemplate})
+	}
+
+	return files, nil
+}
+
+const featureTemplate = `Feature: {{ .Name }}
+{{- if eq .Template "http" }}
+
+  Background:
+    Given URL: http://my-service:8080
+
+  Scenario: Health check
+    When send GET /health
+    Then receive HTTP 200 OK
+{{- else if eq .Template "messaging" }}
+
+  Background:
+    Given Kafka connection
+      | url   | my-cluster-kafka-bootstrap:9092 |
+      | topic | my-topic                        |
+
+  Scenario: Send and receive message
+    Given Kafka message body: Hello from YAKS!
+    When send Kafka message
+    Then receive Kafka message with body: Hello from YAKS!
+{{- else if eq .Template "db" }}
+
+  Background:
+    Given Database connection
+      | driver   | org.postgresql.Driver                    |
+      | url      | jdbc:postgresql://postgresql:5432/testdb |
+      | username | test                                     |
+      | password | secret                                   |
+
+  Scenario: Query database
+    Given SQL query: SELECT 1 AS result
+    Then verify column result=1
+{{- else }}
+
+  Scenario: Print message
+    Given print 'Hello from YAKS!'
+{{- end }}
+{{- if .Steps }}
+
+  Scenario: Custom steps
+    Then YAKS can be extended!
+{{- end }}
+`
+
+const configTemplate = `config:
+  namespace:
+    temporary: false
+    autoRemove: true
+  runtime:
+{{- if or (eq .Template "messaging") (eq .Template "db") }}
+    env:
+      - name: CITRUS_DEFAULT_MESSAGE_TYPE
+        value: PLAINTEXT
+{{- end }}
+    cucumber:
+      tags:
+        - "not @ignored"
+{{- if .Steps }}
+      glue:
+        - "org.citrusframework.yaks"
+        - "org.example.steps"
+{{- end }}
+{{- if or .Steps (eq .Template "db") }}
+    settings:
+      dependencies:
+{{- if eq .Template "db" }}
+        - groupId: org.postgresql
+          artifactId: postgresql
+          version: "@postgresql.version@"
+{{- end }}
+{{- if .Steps }}
+        - groupId: org.example
+          artifactId: steps
+          version: "1.0.0-SNAPSHOT"
+{{- end }}
+{{- end }}
+`
+
+const stepsPomTemplate = `<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+         xsi:schemaLocation="http://maven.apache.org/POM/4.0.0 http://maven.apache.org/xsd/maven-4.0.0.xsd">
+    <modelVersion>4.0.0</modelVersion>
+
+    <groupId>org.example</groupId>
+    <artifactId>steps</artifactId>
+    <version>1.0.0-SNAPSHOT</version>
+
+    <properties>
+        <citrus.version>3.0.0-M1</citrus.version>
+        <cucumber.version>5.4.2</cucumber.version>
+    </properties>
+
+    <dependencies>
+        <dependency>
+            <groupId>io.cucumber</groupId>
+            <artifactId>cucumber-java</artifactId>
+            <version>${cucumber.version}</version>
+        </dependency>
+        <dependency>
+            <groupId>com.consol.citrus</groupId>
+            <artifactId>citrus-core</artifactId>
+            <version>${citrus.version}</version>
+        </dependency>
+        <dependency>
+            <groupId>com.consol.citrus</groupId>
+            <artifactId>citrus-cucumber</artifactId>
+            <version>${citrus.version}</version>
+        </dependency>
+    </dependencies>
+</project>
+`
+
+const stepsTemplate = `package org.example.steps;
+
+import com.consol.citrus.TestCaseRunner;
+import com.consol.citrus.annotations.CitrusResource;
+import io.cucumber.java.en.Then;
+
+import static com.consol.citrus.actions.EchoAction.Builder.echo;
+
+public class CustomSteps {
+
+    @CitrusResource
+    private TestCaseRunner runner;
+
+    @Then("^YAKS can be extended!$")
+    public void yaksCanBeExtended() {
+        runner.run(echo("YAKS can be extended!"));
+    }
+}
+`
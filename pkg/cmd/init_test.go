@@ -0,0 +1,49 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/citrusframework/yaks/pkg/cmd/config"
+	"gopkg.in/yaml.v2"
+	"gotest.tools/v3/assert"
+)
+
+func TestScaffold(t *testing.T) {
+	files, err := scaffold("my-test", InitTemplateDB, true)
+	assert.NilError(t, err)
+	assert.Equal(t, len(files), 4)
+	assert.Equal(t, files[0].name, "my-test.feature")
+	assert.Assert(t, strings.HasPrefix(files[0].content, "Feature: my-test\n"))
+	assert.Assert(t, strings.Contains(files[0].content, "Given Database connection"))
+
+	runConfig := config.NewWithDefaults()
+	assert.NilError(t, yaml.Unmarshal([]byte(files[1].content), runConfig))
+	assert.DeepEqual(t, runConfig.Config.Runtime.Cucumber.Glue, []string{"org.citrusframework.yaks", "org.example.steps"})
+	assert.Equal(t, len(runConfig.Config.Runtime.Settings.Dependencies), 2)
+	assert.Equal(t, runConfig.Config.Runtime.Settings.Dependencies[0].ArtifactId, "postgresql")
+
+	files, err = scaffold("hello", "", false)
+	assert.NilError(t, err)
+	assert.Equal(t, len(files), 2)
+	runConfig = config.NewWithDefaults()
+	assert.NilError(t, yaml.Unmarshal([]byte(files[1].content), runConfig))
+	assert.Equal(t, len(runConfig.Config.Runtime.Settings.Dependencies), 0)
+}
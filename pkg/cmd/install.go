@@ -61,6 +61,8 @@ func newCmdInstall(rootCmdOptions *RootCmdOptions) (*cobra.Command, *installCmdO
 	cmd.Flags().Bool("force", false, "Force replacement of configuration resources when already present.")
 	cmd.Flags().String("operator-image", "", "Set the operator Image used for the operator deployment")
 	cmd.Flags().String("operator-image-pull-policy", "", "Set the operator ImagePullPolicy used for the operator deployment")
+	cmd.Flags().StringArray("operator-resources", nil, "Set a resource request or limit of the operator deployment, e.g. \"limits.memory=1Gi\". "+
+		"One of: requests.cpu|requests.memory|limits.cpu|limits.memory")
 	cmd.Flags().StringP("output", "o", "", "Output format. One of: json|yaml")
 
 	// olm
@@ -85,6 +87,7 @@ type installCmdOptions struct {
 	SkipClusterSetup        bool     `mapstructure:"no-cluster-setup"`
 	OperatorImage           string   `mapstructure:"operator-image"`
 	OperatorImagePullPolicy string   `mapstructure:"operator-image-pull-policy"`
+	OperatorResources       []string `mapstructure:"operator-resources"`
 	Global                  bool     `mapstructure:"global"`
 	Force                   bool     `mapstructure:"force"`
 	Olm                     bool     `mapstructure:"olm"`
@@ -192,10 +195,8 @@ func (o *installCmdOptions) install(cobraCmd *cobra.Command, _ []string) error {
 func setupCluster(ctx context.Context, clientProvider client.Provider, collection *kubernetes.Collection) error {
 	err := install.SetupClusterWideResourcesOrCollect(ctx, clientProvider, collection)
 	if err != nil && k8serrors.IsForbidden(err) {
-		fmt.Println("Current user is not authorized to create cluster-wide objects like custom resource definitions or cluster roles: ", err)
-
-		meg := `please login as cluster-admin and execute "yaks install --cluster-setup" to install cluster-wide resources (one-time operation)`
-		return errors.New(meg)
+		return errors.Wrap(err, "current user is not authorized to create cluster-wide objects like custom resource definitions or cluster roles - "+
+			`please login as cluster-admin and execute "yaks install --cluster-setup" to install cluster-wide resources (one-time operation)`)
 	}
 
 	return err
@@ -208,6 +209,7 @@ func (o *installCmdOptions) setupOperator(c client.Client, collection *kubernete
 		Namespace:             o.Namespace,
 		Global:                o.Global,
 		ClusterType:           o.ClusterType,
+		Resources:             o.OperatorResources,
 	}
 	err := install.OperatorOrCollect(o.Context, c, cfg, collection, o.Force)
 
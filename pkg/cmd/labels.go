@@ -0,0 +1,100 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/citrusframework/yaks/pkg/cmd/config"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const labelDirective = "@label"
+
+// fileLabels collects the labels of given test file. Labels are read from the config label mappings
+// matching the file name and from "# @label key=value" comment lines in the file header.
+func fileLabels(runConfig *config.RunConfig, fileName string, content string) (labels.Set, error) {
+	set := labels.Set{}
+
+	for pattern, mapping := range runConfig.Config.Labels {
+		matched, err := path.Match(pattern, path.Base(fileName))
+		if err != nil {
+			return nil, fmt.Errorf("invalid label mapping pattern '%s': %v", pattern, err)
+		}
+
+		if matched {
+			for key, value := range mapping {
+				set[key] = value
+			}
+		}
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		if !strings.HasPrefix(line, "#") {
+			// end of file header
+			break
+		}
+
+		directive := strings.TrimSpace(strings.TrimPrefix(line, "#"))
+		if !strings.HasPrefix(directive, labelDirective+" ") {
+			continue
+		}
+
+		pair := strings.SplitN(strings.TrimSpace(strings.TrimPrefix(directive, labelDirective)), "=", 2)
+		if len(pair) == 2 {
+			set[strings.TrimSpace(pair[0])] = strings.TrimSpace(pair[1])
+		} else {
+			set[strings.TrimSpace(pair[0])] = ""
+		}
+	}
+
+	return set, scanner.Err()
+}
+
+// isSelected evaluates the label selector on given test file
+func (o *runCmdOptions) isSelected(runConfig *config.RunConfig, fileName string) (bool, error) {
+	if o.Select == "" {
+		return true, nil
+	}
+
+	selector, err := labels.Parse(o.Select)
+	if err != nil {
+		return false, fmt.Errorf("invalid test selector '%s': %v", o.Select, err)
+	}
+
+	data, err := loadData(fileName)
+	if err != nil {
+		return false, err
+	}
+
+	set, err := fileLabels(runConfig, fileName, data)
+	if err != nil {
+		return false, err
+	}
+
+	return selector.Matches(set), nil
+}
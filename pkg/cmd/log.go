@@ -54,13 +54,19 @@ func newCmdLog(rootCmdOptions *RootCmdOptions) (*cobra.Command, *logCmdOptions)
 	}
 
 	cmd.Flags().String("timeout", "", "Time to wait for individual logs")
+	cmd.Flags().String("since", "", "Only print logs newer than given duration, e.g. \"5m\". By default all logs are printed")
+	cmd.Flags().BoolP("follow", "f", true, "Follow the logs of a running test. If disabled the logs available so far are printed and the command exits")
+	cmd.Flags().Bool("no-follow", false, "Print the logs available so far and exit, same as \"--follow=false\"")
 
 	return &cmd, &options
 }
 
 type logCmdOptions struct {
 	*RootCmdOptions
-	Timeout string `mapstructure:"timeout"`
+	Timeout  string `mapstructure:"timeout"`
+	Follow   bool   `mapstructure:"follow"`
+	NoFollow bool   `mapstructure:"no-follow"`
+	Since    string `mapstructure:"since"`
 }
 
 func (o *logCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
@@ -72,6 +78,18 @@ func (o *logCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
 }
 
 func (o *logCmdOptions) run(cmd *cobra.Command, args []string) error {
+	if o.NoFollow {
+		if cmd.Flags().Changed("follow") && o.Follow {
+			return errors.New("options --follow and --no-follow are mutually exclusive")
+		}
+		o.Follow = false
+	}
+
+	since, err := parseSince(o.Since)
+	if err != nil {
+		return err
+	}
+
 	c, err := o.GetCmdClient()
 	if err != nil {
 		return err
@@ -94,6 +112,10 @@ func (o *logCmdOptions) run(cmd *cobra.Command, args []string) error {
 		Name:      name,
 	}
 
+	if !o.Follow {
+		return o.printCurrentLogs(cmd, c, key, since)
+	}
+
 	var timeout string
 	if o.Timeout != "" {
 		timeout = o.Timeout
@@ -103,7 +125,7 @@ func (o *logCmdOptions) run(cmd *cobra.Command, args []string) error {
 
 	waitTimeout, parseErr := time.ParseDuration(timeout)
 	if parseErr != nil {
-		fmt.Println(fmt.Sprintf("failed to parse test timeout setting - %s", parseErr.Error()))
+		fmt.Fprintf(cmd.ErrOrStderr(), "failed to parse test timeout setting - %s\n", parseErr.Error())
 		waitTimeout, _ = time.ParseDuration(config.DefaultTimeout)
 	}
 
@@ -119,7 +141,7 @@ func (o *logCmdOptions) run(cmd *cobra.Command, args []string) error {
 		// and checking if its different from the new message
 		//
 		if newLogMsg != currLogMsg {
-			fmt.Println(newLogMsg)
+			fmt.Fprintln(cmd.OutOrStdout(), newLogMsg)
 			currLogMsg = newLogMsg
 		}
 
@@ -165,8 +187,8 @@ func (o *logCmdOptions) run(cmd *cobra.Command, args []string) error {
 			//
 			// Found the running test so step over to scraping its pod log
 			//
-			fmt.Printf("Test '%s' is now running. Showing log ...\n", name)
-			if err := k8slog.Print(ctx, c, o.Namespace, name, cmd.OutOrStdout()); err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Test '%s' is now running. Showing log ...\n", name)
+			if err := k8slog.PrintSince(ctx, c, o.Namespace, name, since, cmd.OutOrStdout()); err != nil {
 				return false, err
 			} else {
 				return true, nil
@@ -175,8 +197,8 @@ func (o *logCmdOptions) run(cmd *cobra.Command, args []string) error {
 			//
 			// Test is finished or even in error
 			//
-			fmt.Printf("Test '%s' is finished. Showing logs ...\n", name)
-			if err := printLogs(ctx, c, o.Namespace, name, test.Status.TestID, cmd.OutOrStdout()); err != nil {
+			fmt.Fprintf(cmd.OutOrStdout(), "Test '%s' is finished. Showing logs ...\n", name)
+			if err := printLogs(ctx, c, o.Namespace, name, test.Status.TestID, since, cmd.OutOrStdout()); err != nil {
 				return false, err
 			} else {
 				cancel()
@@ -202,18 +224,53 @@ func (o *logCmdOptions) run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func printLogs(ctx context.Context, c client.Client, namespace string, name string, testId string, out io.Writer) error {
+// printCurrentLogs prints the logs available so far without waiting for the test to complete
+func (o *logCmdOptions) printCurrentLogs(cmd *cobra.Command, c client.Client, key k8sclient.ObjectKey, since time.Duration) error {
+	test := v1alpha1.Test{}
+	if err := c.Get(o.Context, key, &test); err != nil {
+		if k8errors.IsNotFound(err) {
+			return fmt.Errorf("test '%s' not found in namespace %s", key.Name, key.Namespace)
+		}
+		return err
+	}
+
+	if test.Status.TestID == "" {
+		return fmt.Errorf("test '%s' has not been started yet, current phase: %s", key.Name, test.Status.Phase)
+	}
+
+	return printLogs(o.Context, c, key.Namespace, key.Name, test.Status.TestID, since, cmd.OutOrStdout())
+}
+
+// parseSince parses the duration limiting the printed logs, an empty value prints all logs
+func parseSince(since string) (time.Duration, error) {
+	if since == "" {
+		return 0, nil
+	}
+
+	duration, err := time.ParseDuration(since)
+	if err != nil || duration <= 0 {
+		return 0, fmt.Errorf("invalid log duration '%s', expected a positive duration such as 5m", since)
+	}
+
+	return duration, nil
+}
+
+func printLogs(ctx context.Context, c client.Client, namespace string, name string, testId string, since time.Duration, out io.Writer) error {
 	pods, err := c.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
 		LabelSelector: v1alpha1.TestIdLabel + "=" + testId,
 	})
+	if err != nil {
+		return err
+	}
 
 	if pods == nil || len(pods.Items) == 0 {
 		return errors.New(fmt.Sprintf("unable to locate test pod for name %s in namespace %s", name, namespace))
 	}
 
 	logOptions := corev1.PodLogOptions{
-		Follow:    false,
-		Container: "test",
+		Follow:       false,
+		Container:    "test",
+		SinceSeconds: k8slog.SinceSeconds(since),
 	}
 	byteReader, err := c.CoreV1().Pods(namespace).GetLogs(pods.Items[0].Name, &logOptions).Stream(ctx)
 	if err != nil {
@@ -0,0 +1,61 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	k8slog "github.com/citrusframework/yaks/pkg/util/kubernetes/log"
+	"gotest.tools/v3/assert"
+)
+
+func TestLogNoFollow(t *testing.T) {
+	cmd, options := newCmdLog(&RootCmdOptions{Context: context.Background()})
+	assert.NilError(t, cmd.Flags().Set("follow", "true"))
+	options.Follow = true
+	options.NoFollow = true
+
+	err := options.run(cmd, []string{"hello"})
+	assert.ErrorContains(t, err, "options --follow and --no-follow are mutually exclusive")
+
+	cmd, options = newCmdLog(&RootCmdOptions{Context: context.Background()})
+	options.Follow = true
+	options.NoFollow = true
+	options.Since = "invalid"
+
+	// the follow setting is resolved before the options are validated
+	err = options.run(cmd, []string{"hello"})
+	assert.ErrorContains(t, err, "invalid log duration 'invalid'")
+	assert.Assert(t, !options.Follow)
+}
+
+func TestParseSince(t *testing.T) {
+	since, err := parseSince("")
+	assert.NilError(t, err)
+	assert.Equal(t, since, time.Duration(0))
+
+	since, err = parseSince("90s")
+	assert.NilError(t, err)
+	assert.Equal(t, *k8slog.SinceSeconds(since), int64(90))
+	assert.Equal(t, *k8slog.SinceSeconds(1500 * time.Millisecond), int64(2))
+
+	_, err = parseSince("-5m")
+	assert.ErrorContains(t, err, "invalid log duration '-5m'")
+}
@@ -0,0 +1,138 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
+	"github.com/citrusframework/yaks/pkg/client"
+	"github.com/citrusframework/yaks/pkg/cmd/config"
+	"github.com/citrusframework/yaks/pkg/util/kubernetes"
+	"github.com/citrusframework/yaks/pkg/util/openshift"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var documentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+// openShiftGroupSuffix is the suffix of the API groups of OpenShift resources, e.g. route.openshift.io
+const openShiftGroupSuffix = ".openshift.io"
+
+// manifestResource is a resource loaded from one of the configured manifest files
+type manifestResource struct {
+	obj      ctrl.Object
+	manifest string
+}
+
+// loadManifests loads all resources of the configured manifest files and labels them with the run id. OpenShift resources
+// are skipped on plain Kubernetes clusters.
+func (o *runCmdOptions) loadManifests(c client.Client, runConfig *config.RunConfig) ([]manifestResource, error) {
+	resources := make([]manifestResource, 0)
+	if len(runConfig.Config.Runtime.Manifests) == 0 {
+		return resources, nil
+	}
+
+	isOpenShift, err := openshift.IsOpenShift(c)
+	if err != nil {
+		return resources, err
+	}
+
+	for _, manifest := range runConfig.Config.Runtime.Manifests {
+		data, err := loadData(resolvePath(runConfig, manifest))
+		if err != nil {
+			return resources, err
+		}
+
+		for _, document := range documentSeparator.Split(data, -1) {
+			if strings.TrimSpace(document) == "" {
+				continue
+			}
+
+			obj, err := kubernetes.LoadRawResourceFromYaml(document)
+			if err != nil {
+				return resources, fmt.Errorf("failed to load manifest %s: %v", manifest, err)
+			}
+
+			kind := obj.GetObjectKind().GroupVersionKind()
+			if !isOpenShift && strings.HasSuffix(kind.Group, openShiftGroupSuffix) {
+				o.out.Errorf("WARN: Skipping %s '%s' from manifest %s - OpenShift resources are not supported on Kubernetes", kind.Kind, obj.GetName(), manifest)
+				continue
+			}
+
+			labels := obj.GetLabels()
+			if labels == nil {
+				labels = make(map[string]string)
+			}
+			labels[v1alpha1.TestRunIdLabel] = o.runID
+			obj.SetLabels(labels)
+			obj.SetNamespace(runConfig.Config.Namespace.Name)
+
+			resources = append(resources, manifestResource{obj: obj, manifest: manifest})
+		}
+	}
+
+	return resources, nil
+}
+
+// applyManifests creates all resources of the configured manifest files in the test namespace. Resources that already
+// exist are kept as is, only the resources created by this run get labeled with the run id and are returned for deletion.
+func (o *runCmdOptions) applyManifests(c client.Client, runConfig *config.RunConfig) ([]ctrl.Object, error) {
+	applied := make([]ctrl.Object, 0)
+
+	resources, err := o.loadManifests(c, runConfig)
+	if err != nil {
+		return applied, err
+	}
+
+	for _, resource := range resources {
+		obj, kind := resource.obj, resource.obj.GetObjectKind().GroupVersionKind().Kind
+		if err := c.Create(o.Context, obj); k8serrors.IsAlreadyExists(err) {
+			// never touch resources this run does not own, they must survive the cleanup
+			o.out.Errorf("WARN: %s '%s' from manifest %s already exists - keeping the existing resource", kind, obj.GetName(), resource.manifest)
+			continue
+		} else if err != nil {
+			return applied, fmt.Errorf("failed to create %s '%s' from manifest %s: %v", kind, obj.GetName(), resource.manifest, err)
+		}
+		applied = append(applied, obj)
+
+		o.out.Printf("Created %s '%s' from manifest %s", kind, obj.GetName(), resource.manifest)
+	}
+
+	return applied, nil
+}
+
+// deleteManifests removes the resources created by this run that are still flagged with the run id
+func (o *runCmdOptions) deleteManifests(c client.Client, objects []ctrl.Object) {
+	for _, obj := range objects {
+		if obj.GetLabels()[v1alpha1.TestRunIdLabel] != o.runID {
+			continue
+		}
+
+		if o.NoCleanup {
+			o.keepResource(obj.GetObjectKind().GroupVersionKind().Kind, obj.GetNamespace(), obj.GetName())
+			continue
+		}
+
+		if err := c.Delete(o.RootContext, obj); err != nil && !k8serrors.IsNotFound(err) {
+			o.out.Errorf("WARN: Failed to delete %s '%s': %s", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err.Error())
+		}
+	}
+}
@@ -21,16 +21,10 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"github.com/citrusframework/yaks/pkg/util/envvar"
-
-	k8serrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/tools/record"
 	"math/rand"
 	"os"
 	"runtime"
-	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"strings"
 	"time"
 
 	"github.com/citrusframework/yaks/pkg/apis"
@@ -40,13 +34,21 @@ import (
 	"github.com/citrusframework/yaks/pkg/event"
 	"github.com/citrusframework/yaks/pkg/install"
 	"github.com/citrusframework/yaks/pkg/util/defaults"
+	"github.com/citrusframework/yaks/pkg/util/envvar"
 	"github.com/citrusframework/yaks/pkg/util/kubernetes"
+	logutil "github.com/citrusframework/yaks/pkg/util/log"
 
 	"github.com/operator-framework/operator-lib/leader"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 )
 
@@ -58,6 +60,9 @@ const (
 
 var log = logf.Log.WithName("cmd")
 
+// cmdLog is the cmd logger that exits on fatal errors
+var cmdLog = logutil.FromLogr(log)
+
 // GitCommit --
 var GitCommit string
 
@@ -80,21 +85,20 @@ func Run() {
 	// uniform and structured logs.
 	logf.SetLogger(zap.New(func(o *zap.Options) {
 		o.Development = false
+		o.Level = logutil.Level()
 	}))
 
 	printVersion()
 
 	watchNamespace, err := getWatchNamespace()
 	if err != nil {
-		log.Error(err, "failed to get watch namespace")
-		os.Exit(1)
+		cmdLog.Fatal(err, "failed to get watch namespace")
 	}
 
 	// Get a config to talk to the API server
 	cfg, err := config.GetConfig()
 	if err != nil {
-		log.Error(err, "")
-		os.Exit(1)
+		cmdLog.Fatal(err, "")
 	}
 
 	// Become the leader before proceeding
@@ -103,16 +107,14 @@ func Run() {
 		if err == leader.ErrNoNamespace {
 			log.Info("Local run detected, leader election is disabled")
 		} else {
-			log.Error(err, "")
-			os.Exit(1)
+			cmdLog.Fatal(err, "")
 		}
 	}
 
 	// Configure an event broadcaster
 	c, err := client.NewClient(false)
 	if err != nil {
-		log.Error(err, "cannot initialize client")
-		os.Exit(1)
+		cmdLog.Fatal(err, "cannot initialize client")
 	}
 
 	// We do not rely on the event broadcaster managed by controller runtime,
@@ -121,7 +123,9 @@ func Run() {
 	// admin users, that are not granted create permission on Events by default.
 	broadcaster := record.NewBroadcaster()
 	// nolint: gocritic
-	if ok, err := kubernetes.CheckPermission(context.TODO(), c, corev1.GroupName, "events", watchNamespace, "", "create"); err != nil || !ok {
+	// the first watched namespace is the operator namespace, additional namespaces are separated by commas
+	watchNamespaces := strings.Split(watchNamespace, ",")
+	if ok, err := kubernetes.CheckPermission(context.TODO(), c, corev1.GroupName, "events", watchNamespaces[0], "", "create"); err != nil || !ok {
 		// Do not sink Events to the server as they'll be rejected
 		broadcaster = event.NewSinkLessBroadcaster(broadcaster)
 		if err != nil {
@@ -132,21 +136,24 @@ func Run() {
 	}
 
 	// Create a new Cmd to provide shared dependencies and start components
-	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+	options := ctrl.Options{
 		Namespace:        watchNamespace,
 		EventBroadcaster: broadcaster,
-	})
+	}
+	if len(watchNamespaces) > 1 {
+		options.Namespace = ""
+		options.NewCache = cache.MultiNamespacedCacheBuilder(watchNamespaces)
+	}
+	mgr, err := ctrl.NewManager(cfg, options)
 	if err != nil {
-		log.Error(err, "")
-		os.Exit(1)
+		cmdLog.Fatal(err, "")
 	}
 
 	log.Info("Registering Components.")
 
 	// Setup Scheme for all resources
 	if err := apis.AddToScheme(mgr.GetScheme()); err != nil {
-		log.Error(err, "")
-		os.Exit(1)
+		cmdLog.Fatal(err, "")
 	}
 
 	// Try to register the OpenShift CLI Download link if possible
@@ -155,21 +162,18 @@ func Run() {
 	install.OperatorStartupOptionalTools(installCtx, c, log)
 
 	if err := installInstance(installCtx, c, watchNamespace == "", defaults.Version); err != nil {
-		log.Error(err, "failed to install yaks custom resource")
-		os.Exit(1)
+		cmdLog.Fatal(err, "failed to install yaks custom resource")
 	}
 
 	// Setup all Controllers
 	if err := controller.AddToManager(mgr); err != nil {
-		log.Error(err, "")
-		os.Exit(1)
+		cmdLog.Fatal(err, "")
 	}
 
 	log.Info("Starting the Cmd.")
 
 	if err := mgr.Start(signals.SetupSignalHandler()); err != nil {
-		log.Error(err, "manager exited non-zero")
-		os.Exit(1)
+		cmdLog.Fatal(err, "manager exited non-zero")
 	}
 
 	broadcaster.Shutdown()
@@ -0,0 +1,158 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/citrusframework/yaks/pkg/util/log"
+)
+
+// output prints status messages of a command run. In quiet mode informational messages
+// are sent to the logger on debug level instead, errors are always printed.
+type output struct {
+	log.Logger
+	out   io.Writer
+	err   io.Writer
+	quiet bool
+	// rawSteps passes the output of step scripts unmodified to the standard streams
+	rawSteps bool
+}
+
+func newOutput(out io.Writer, quiet bool, logger log.Logger) *output {
+	return &output{
+		Logger: logger,
+		out:    out,
+		err:    os.Stderr,
+		quiet:  quiet,
+	}
+}
+
+// Println prints an informational message
+func (o *output) Println(msg string) {
+	if o.quiet {
+		o.Debug(msg)
+		return
+	}
+
+	fmt.Fprintln(o.out, msg)
+}
+
+// Printf prints a formatted informational message
+func (o *output) Printf(format string, args ...interface{}) {
+	o.Println(fmt.Sprintf(format, args...))
+}
+
+// Errorf prints a formatted error message regardless of quiet mode
+func (o *output) Errorf(format string, args ...interface{}) {
+	fmt.Fprintln(o.err, fmt.Sprintf(format, args...))
+}
+
+// stepWriters returns the writers for the standard and error output of given step. Each line is prefixed with the step
+// name, the output of the step is printed regardless of quiet mode as it belongs to the test and not to the CLI.
+func (o *output) stepWriters(step string) (*lineWriter, *lineWriter) {
+	prefix := fmt.Sprintf("[step:%s] ", step)
+	stdout := newLineWriter(prefix, func(line string) {
+		fmt.Fprintln(o.out, line)
+	})
+	stderr := newLineWriter(prefix, func(line string) {
+		o.Errorf("%s", line)
+	})
+	return stdout, stderr
+}
+
+// lineWriter hands each complete line written to the print function using the given prefix
+type lineWriter struct {
+	prefix string
+	print  func(line string)
+	buf    []byte
+	lock   sync.Mutex
+}
+
+func newLineWriter(prefix string, print func(line string)) *lineWriter {
+	return &lineWriter{
+		prefix: prefix,
+		print:  print,
+	}
+}
+
+func (w *lineWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+
+		w.print(w.prefix + strings.TrimSuffix(string(w.buf[:i]), "\r"))
+		w.buf = w.buf[i+1:]
+	}
+
+	return len(p), nil
+}
+
+// Flush prints the remaining output that is not terminated by a line break
+func (w *lineWriter) Flush() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if len(w.buf) > 0 {
+		w.print(w.prefix + string(w.buf))
+		w.buf = nil
+	}
+}
+
+// tailBuffer keeps the last bytes written up to the given limit
+type tailBuffer struct {
+	limit     int
+	data      []byte
+	truncated bool
+}
+
+func newTailBuffer(limit int) *tailBuffer {
+	return &tailBuffer{
+		limit: limit,
+	}
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.data = append(b.data, p...)
+	if len(b.data) > b.limit {
+		b.data = b.data[len(b.data)-b.limit:]
+		b.truncated = true
+	}
+
+	return len(p), nil
+}
+
+// String returns the captured output, prefixed with a marker when leading output has been dropped
+func (b *tailBuffer) String() string {
+	if b.truncated {
+		return "[... truncated]\n" + string(b.data)
+	}
+
+	return string(b.data)
+}
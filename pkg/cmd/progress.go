@@ -0,0 +1,74 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
+)
+
+var spinnerFrames = []string{"|", "/", "-", "\\"}
+
+// progress renders a spinner with the current test phase and the elapsed time while waiting for a test to complete
+type progress struct {
+	out      io.Writer
+	name     string
+	phase    atomic.Value
+	interval time.Duration
+}
+
+func newProgress(out io.Writer, name string) *progress {
+	p := progress{
+		out:      out,
+		name:     name,
+		interval: 200 * time.Millisecond,
+	}
+	p.phase.Store(v1alpha1.TestPhaseNew)
+	return &p
+}
+
+// SetPhase updates the phase shown, safe to be called from another go routine
+func (p *progress) SetPhase(phase v1alpha1.TestPhase) {
+	if phase != v1alpha1.TestPhaseNone {
+		p.phase.Store(phase)
+	}
+}
+
+// Run renders the spinner until the given context is done and clears the line afterwards
+func (p *progress) Run(ctx context.Context) {
+	start := time.Now()
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for frame := 0; ; frame++ {
+		fmt.Fprintf(p.out, "\r\033[K%s Test '%s' %s (%ds)", spinnerFrames[frame%len(spinnerFrames)],
+			p.name, p.phase.Load().(v1alpha1.TestPhase), int(time.Since(start).Seconds()))
+
+		select {
+		case <-ctx.Done():
+			fmt.Fprint(p.out, "\r\033[K")
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,164 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/citrusframework/yaks/pkg/client"
+	"github.com/citrusframework/yaks/pkg/cmd/config"
+	"github.com/citrusframework/yaks/pkg/install"
+	"github.com/citrusframework/yaks/pkg/util/kubernetes"
+	"github.com/spf13/cobra"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// provision prepares the test namespace with the cluster resources, the operator and the operator roles of the
+// run configuration without running any test. Existing resources are updated so provisioning can be repeated.
+func (o *runCmdOptions) provision(source string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	runConfig := config.NewWithDefaults()
+	runConfig.Config.Namespace.Name = o.Namespace
+	if source != "" {
+		if runConfig, err = o.getRunConfig(source); err != nil {
+			return err
+		}
+	} else if err := o.applyConfigOverrides(runConfig); err != nil {
+		return err
+	}
+
+	if runConfig.Config.Namespace.Temporary {
+		namespace, err := o.createTempNamespace(runConfig, c)
+		if err != nil {
+			return err
+		}
+
+		o.out.Printf("Provisioned temporary namespace %s", namespace.GetName())
+		return nil
+	}
+
+	namespace := o.operatorNamespace(runConfig)
+
+	// Let's use a client provider during cluster installation, to eliminate the problem of CRD object caching
+	clientProvider := client.Provider{Get: o.NewCmdClient}
+	if err := setupCluster(o.Context, clientProvider, nil); err != nil {
+		return err
+	}
+
+	cfg, err := o.operatorConfiguration(runConfig, c)
+	if err != nil {
+		return err
+	}
+
+	planned := kubernetes.NewCollection()
+	if err := install.OperatorOrCollect(o.Context, c, cfg, planned, true); err != nil {
+		return err
+	}
+
+	existing := make([]bool, planned.Size())
+	for i, obj := range planned.Items() {
+		if existing[i], err = o.resourceExists(c, obj, namespace); err != nil {
+			return err
+		}
+	}
+
+	if err := o.setupOperator(runConfig, c, nil); err != nil {
+		return err
+	}
+
+	for i, obj := range planned.Items() {
+		action := "Created"
+		if existing[i] {
+			action = "Updated"
+		}
+		o.out.Printf("%s %s %s", action, obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName())
+	}
+
+	for _, role := range runConfig.Config.Operator.Roles {
+		o.out.Printf("Applied operator role %s", role)
+	}
+
+	o.out.Printf("Provisioned YAKS in namespace %s", namespace)
+	return nil
+}
+
+func (o *runCmdOptions) resourceExists(c client.Client, obj ctrl.Object, namespace string) (bool, error) {
+	current := unstructured.Unstructured{}
+	current.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+
+	err := c.Get(o.Context, ctrl.ObjectKey{Namespace: namespace, Name: obj.GetName()}, &current)
+	if err != nil && k8serrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// dumpInstall prints the operator resources and roles that would be installed into the test namespace without applying them
+func (o *runCmdOptions) dumpInstall(cmd *cobra.Command, source string) error {
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	runConfig := config.NewWithDefaults()
+	runConfig.Config.Namespace.Name = o.Namespace
+	if source != "" {
+		if runConfig, err = o.getRunConfig(source); err != nil {
+			return err
+		}
+	} else if err := o.applyConfigOverrides(runConfig); err != nil {
+		return err
+	}
+
+	if runConfig.Config.Namespace.Temporary {
+		// the actual temporary namespace gets a new random name when running the tests
+		if runConfig.Config.Namespace.Name, err = tempNamespaceName(runConfig.Config.Namespace.Prefix); err != nil {
+			return err
+		}
+	}
+
+	collection := kubernetes.NewCollection()
+	if err := o.setupOperator(runConfig, c, collection); err != nil {
+		return err
+	}
+
+	var data []byte
+	switch o.DumpInstall {
+	case "yaml":
+		data, err = kubernetes.ToYAML(collection.AsKubernetesList())
+	case "json":
+		data, err = kubernetes.ToJSON(collection.AsKubernetesList())
+	default:
+		return fmt.Errorf("invalid dump install output format option '%s', should be one of: yaml|json", o.DumpInstall)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprint(cmd.OutOrStdout(), string(data))
+	return nil
+}
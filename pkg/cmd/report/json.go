@@ -27,16 +27,9 @@ const (
 )
 
 
-func createJsonReport(results *v1alpha1.TestResults, outputDir string) (string, error) {
+func renderJsonReport(results *v1alpha1.TestResults) (string, error) {
 	if bytes, err := json.MarshalIndent(results, "", "  "); err == nil {
-		report := string(bytes)
-
-		fileError := writeReport(report, JsonReportFile, outputDir)
-		if fileError != nil {
-			return "", fileError
-		}
-
-		return report, nil
+		return string(bytes), nil
 	} else {
 		return "", err
 	}
@@ -25,6 +25,8 @@ import (
 const (
 	JunitReportFile = "junit-reports.xml"
 	XmlProcessingInstruction = `<?xml version="1.0" encoding="UTF-8"?>`
+	// JUnit timestamps are ISO 8601 without timezone
+	TimestampFormat = "2006-01-02T15:04:05"
 )
 
 type JUnitReport struct {
@@ -38,7 +40,16 @@ type TestSuite struct {
 	Skipped int `xml:"skipped,attr"`
 	Tests int `xml:"tests,attr"`
 	Time float32 `xml:"time,attr"`
+	Timestamp string `xml:"timestamp,attr,omitempty"`
+	Hostname string `xml:"hostname,attr,omitempty"`
+	Properties []Property `xml:"properties>property,omitempty"`
 	TestCase []TestCase `xml:"testcase"`
+	SystemOut string `xml:"system-out,omitempty"`
+}
+
+type Property struct {
+	Name string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
 }
 
 type TestCase struct {
@@ -48,6 +59,7 @@ type TestCase struct {
 	SystemOut string `xml:"system-out,omitempty"`
 	Failure *Failure
 	Error *Error
+	Skipped *Skipped
 }
 
 type Failure struct {
@@ -64,7 +76,12 @@ type Error struct {
 	Stacktrace string `xml:",chardata"`
 }
 
-func createJUnitReport(results *v1alpha1.TestResults, outputDir string) (string, error) {
+type Skipped struct {
+	XMLName xml.Name `xml:"skipped,omitempty"`
+	Message string `xml:"message,attr,omitempty"`
+}
+
+func renderJUnitReport(results *v1alpha1.TestResults) (string, error) {
 	var report = JUnitReport {
 		Suite: []TestSuite {},
 	}
@@ -76,6 +93,18 @@ func createJUnitReport(results *v1alpha1.TestResults, outputDir string) (string,
 			Skipped:  testSuite.Summary.Skipped,
 			Tests:    testSuite.Summary.Total,
 			Errors:   testSuite.Summary.Errors,
+			Hostname: results.Hostname,
+			SystemOut: testSuite.SystemOut,
+		}
+
+		if testSuite.StartTime != nil {
+			suite.Timestamp = testSuite.StartTime.UTC().Format(TimestampFormat)
+		} else if results.StartTime != nil {
+			suite.Timestamp = results.StartTime.UTC().Format(TimestampFormat)
+		}
+
+		if results.RunID != "" {
+			suite.Properties = append(suite.Properties, Property{Name: "run-id", Value: results.RunID})
 		}
 
 		for _, test := range testSuite.Tests {
@@ -95,6 +124,16 @@ func createJUnitReport(results *v1alpha1.TestResults, outputDir string) (string,
 			suite.TestCase = append(suite.TestCase, testCase)
 		}
 
+		if testSuite.SkipReason != "" {
+			suite.TestCase = append(suite.TestCase, TestCase{
+				Name: testSuite.Name,
+				ClassName: testSuite.Name,
+				Skipped: &Skipped{
+					Message: testSuite.SkipReason,
+				},
+			})
+		}
+
 		report.Suite = append(report.Suite, suite)
 	}
 
@@ -105,14 +144,7 @@ func createJUnitReport(results *v1alpha1.TestResults, outputDir string) (string,
 	}{JUnitReport: report}
 
 	if bytes, err := xml.MarshalIndent(tmp, "", "  "); err == nil {
-		report := XmlProcessingInstruction + string(bytes)
-
-		fileError := writeReport(report, JunitReportFile, outputDir)
-		if fileError != nil {
-			return "", fileError
-		}
-
-		return report, nil
+		return XmlProcessingInstruction + string(bytes), nil
 	} else {
 		return "", err
 	}
@@ -22,12 +22,15 @@ import (
 	"errors"
 	"fmt"
 	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
+	"github.com/citrusframework/yaks/pkg/util/color"
 	"github.com/citrusframework/yaks/pkg/util/kubernetes"
+	"io"
 	"io/ioutil"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"os"
 	"path"
+	"time"
 )
 
 type OutputFormat string
@@ -55,53 +58,89 @@ const (
 	JsonOutput    OutputFormat = "json"
 	JUnitOutput   OutputFormat = "junit"
 	SummaryOutput OutputFormat = "summary"
+	NoneOutput    OutputFormat = "none"
 )
 
+// ValidateOutputFormat checks that given format is one of the known report output formats
+func ValidateOutputFormat(output OutputFormat) error {
+	switch output {
+	case DefaultOutput, NoneOutput, SummaryOutput, JUnitOutput, JsonOutput:
+		return nil
+	default:
+		return fmt.Errorf("unsupported report output format '%s', should be one of: none|summary|json|junit", output)
+	}
+}
+
+// GenerateReport creates the report of given results in the output format. JUnit and JSON reports are saved as files
+// in the output directory of the current working directory.
 func GenerateReport(results *v1alpha1.TestResults, output OutputFormat) (string, error) {
 	outputDir, err := createInWorkingDir(OutputDir)
 	if err != nil {
 		return "", err
 	}
 
+	report, err := render(results, output)
+	if err != nil {
+		return "", err
+	}
+
 	switch output {
-		case SummaryOutput, DefaultOutput:
-			summaryReport := GetSummaryReport(results)
-			return summaryReport, nil
-		case JUnitOutput:
-			if junitReport, err := createJUnitReport(results, outputDir); err != nil {
-				return "", err
-			} else {
-				return junitReport, nil
-			}
-		case JsonOutput:
-			if jsonReport, err := createJsonReport(results, outputDir); err != nil {
-				return "", err
-			} else {
-				return jsonReport, nil
-			}
-		default:
-			return "", errors.New(fmt.Sprintf("Unsupported report output format '%s'. Please use one of 'summary', 'json', 'junit'", output))
+	case JUnitOutput:
+		err = writeReport(report, JunitReportFile, outputDir)
+	case JsonOutput:
+		err = writeReport(report, JsonReportFile, outputDir)
 	}
+
+	if err != nil {
+		return "", err
+	}
+	return report, nil
 }
 
-func AppendTestResults(suites *v1alpha1.TestSuite, suite v1alpha1.TestSuite) {
-	suites.Name = suite.Name
+// Generate writes the report of given results in the output format to the writer
+func Generate(results *v1alpha1.TestResults, output OutputFormat, w io.Writer) error {
+	report, err := render(results, output)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, report)
+	return err
+}
+
+func render(results *v1alpha1.TestResults, output OutputFormat) (string, error) {
+	switch output {
+	case SummaryOutput, DefaultOutput:
+		return GetSummaryReport(results), nil
+	case JUnitOutput:
+		return renderJUnitReport(results)
+	case JsonOutput:
+		return renderJsonReport(results)
+	case NoneOutput:
+		return "", nil
+	default:
+		return "", errors.New(fmt.Sprintf("Unsupported report output format '%s'. Please use one of 'summary', 'json', 'junit'", output))
+	}
+}
 
-	AppendSummary(&suites.Summary, &suite.Summary)
+func AppendTestResults(suites *v1alpha1.TestSuite, suite v1alpha1.TestSuite) {
+	suites.AddResults(suite)
+}
 
-	for _, test := range suite.Tests {
-		suites.Tests = append(suites.Tests, test)
+// GetSkippedResult creates the result of a test that has not been run for given reason
+func GetSkippedResult(name string, reason string) v1alpha1.TestSuite {
+	return v1alpha1.TestSuite{
+		Name: name,
+		Summary: v1alpha1.TestSummary{
+			Total:   1,
+			Skipped: 1,
+		},
+		SkipReason: reason,
 	}
 }
 
 func AppendSummary(overall *v1alpha1.TestSummary, summary *v1alpha1.TestSummary) {
-	overall.Errors += summary.Errors
-	overall.Passed += summary.Passed
-	overall.Failed += summary.Failed
-	overall.Skipped += summary.Skipped
-	overall.Undefined += summary.Undefined
-	overall.Pending += summary.Pending
-	overall.Total += summary.Total
+	overall.Add(summary)
 }
 
 func SaveTestResults(test *v1alpha1.Test) error {
@@ -180,10 +219,36 @@ func LoadTestResults() (*v1alpha1.TestResults, error) {
 }
 
 func PrintSummaryReport(results *v1alpha1.TestResults) {
-	fmt.Printf("%s\n", GetSummaryReport(results))
+	fmt.Printf("%s\n", getSummaryReport(results, color.Enabled()))
+}
+
+// GetSummaryLine creates a stable single line summary of the test results for automation, e.g.
+// "YAKS_SUMMARY total=2 passed=1 failed=1 errors=0 skipped=0 duration=12.345s"
+func GetSummaryLine(results *v1alpha1.TestResults, end time.Time) string {
+	overall := v1alpha1.TestSummary{}
+	for _, suite := range results.Suites {
+		AppendSummary(&overall, &suite.Summary)
+	}
+
+	var duration time.Duration
+	if results.StartTime != nil {
+		duration = end.Sub(results.StartTime.Time)
+	}
+
+	return fmt.Sprintf("YAKS_SUMMARY total=%d passed=%d failed=%d errors=%d skipped=%d duration=%.3fs",
+		overall.Total, overall.Passed, overall.Failed, overall.Errors, overall.Skipped, duration.Seconds())
 }
 
 func GetSummaryReport(results *v1alpha1.TestResults) string {
+	return getSummaryReport(results, false)
+}
+
+func getSummaryReport(results *v1alpha1.TestResults, colored bool) string {
+	green, red, bold := noColor, noColor, noColor
+	if colored {
+		green, red, bold = color.Green, color.Red, color.Bold
+	}
+
 	overall := v1alpha1.TestSuite{}
 
 	for _, suite := range results.Suites {
@@ -192,21 +257,37 @@ func GetSummaryReport(results *v1alpha1.TestResults) string {
 
 	overall.Name = "All tests"
 
-	summary := fmt.Sprintf("Test results: Total: %d, Passed: %d, Failed: %d, Errors: %d, Skipped: %d\n",
-		overall.Summary.Total, overall.Summary.Passed, overall.Summary.Failed, overall.Summary.Errors, overall.Summary.Skipped)
+	summary := fmt.Sprintf("Test results: Total: %d, %s, %s, %s, Skipped: %d\n",
+		overall.Summary.Total,
+		green(fmt.Sprintf("Passed: %d", overall.Summary.Passed)),
+		red(fmt.Sprintf("Failed: %d", overall.Summary.Failed)),
+		bold(fmt.Sprintf("Errors: %d", overall.Summary.Errors)),
+		overall.Summary.Skipped)
+
+	if steps := overall.Summary.Steps; steps.Total > 0 {
+		summary += fmt.Sprintf("%d scenarios (%d passed, %d failed), %d steps (%d passed, %d failed, %d skipped)\n",
+			overall.Summary.Total, overall.Summary.Passed, overall.Summary.Failed,
+			steps.Total, steps.Passed, steps.Failed, steps.Skipped+steps.Pending+steps.Undefined)
+	}
 
 	for _, test := range overall.Tests {
-		result := "Passed"
+		result := green("Passed")
 		if len(test.ErrorMessage) > 0 {
-			result = fmt.Sprintf("Failure caused by %s - %s", test.ErrorType, test.ErrorMessage)
+			result = red(fmt.Sprintf("Failure caused by %s - %s", test.ErrorType, test.ErrorMessage))
 		}
 		_, className := path.Split(test.ClassName)
 		summary += fmt.Sprintf("\t%s (%s): %s\n", test.Name, className, result)
 	}
 
+	for _, suite := range results.Suites {
+		if suite.SkipReason != "" {
+			summary += fmt.Sprintf("\t%s: Skipped - %s\n", suite.Name, suite.SkipReason)
+		}
+	}
+
 	if len(overall.Errors) > 0 {
 		if prettyPrint, err := json.MarshalIndent(overall.Errors, "", "  "); err == nil {
-			summary += fmt.Sprintf("\nErrors: %d\n%s", len(overall.Errors), string(prettyPrint))
+			summary += fmt.Sprintf("\n%s\n%s", bold(fmt.Sprintf("Errors: %d", len(overall.Errors))), string(prettyPrint))
 		} else {
 			fmt.Printf("Failed to read error details from test results: %s", err.Error())
 		}
@@ -214,6 +295,10 @@ func GetSummaryReport(results *v1alpha1.TestResults) string {
 	return summary
 }
 
+func noColor(s string) string {
+	return s
+}
+
 func GetErrorResult(namespace string, source string, err error) *v1alpha1.Test {
 	return &v1alpha1.Test{
 		TypeMeta: metav1.TypeMeta{
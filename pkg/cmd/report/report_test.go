@@ -0,0 +1,98 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
+	"gotest.tools/v3/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGenerate(t *testing.T) {
+	results := v1alpha1.TestResults{
+		Summary: v1alpha1.TestSummary{Total: 1, Passed: 1},
+		Suites: []v1alpha1.TestSuite{
+			{
+				Name:    "hello",
+				Summary: v1alpha1.TestSummary{Total: 1, Passed: 1},
+				Tests: []v1alpha1.TestResult{
+					{Name: "Hello", ClassName: "hello.feature"},
+				},
+			},
+		},
+	}
+
+	var out bytes.Buffer
+	assert.NilError(t, Generate(&results, JsonOutput, &out))
+	var parsed v1alpha1.TestResults
+	assert.NilError(t, json.Unmarshal(out.Bytes(), &parsed))
+	assert.DeepEqual(t, parsed, results)
+
+	out.Reset()
+	assert.NilError(t, Generate(&results, JUnitOutput, &out))
+	assert.Assert(t, strings.HasPrefix(out.String(), XmlProcessingInstruction))
+	assert.Assert(t, strings.Contains(out.String(), `<testsuite name="hello"`))
+
+	out.Reset()
+	assert.NilError(t, Generate(&results, SummaryOutput, &out))
+	assert.Assert(t, strings.HasPrefix(out.String(), "Test results: Total: 1, Passed: 1, Failed: 0, Errors: 0, Skipped: 0"))
+
+	out.Reset()
+	assert.NilError(t, Generate(&results, NoneOutput, &out))
+	assert.Equal(t, out.Len(), 0)
+
+	assert.ErrorContains(t, Generate(&results, "html", &out), "Unsupported report output format 'html'")
+}
+
+func TestGetSummaryLine(t *testing.T) {
+	start := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	results := v1alpha1.TestResults{
+		StartTime: &metav1.Time{Time: start},
+		Suites: []v1alpha1.TestSuite{
+			{Summary: v1alpha1.TestSummary{Total: 2, Passed: 1, Failed: 1}},
+			{Summary: v1alpha1.TestSummary{Errors: 1}},
+		},
+	}
+
+	assert.Equal(t, GetSummaryLine(&results, start.Add(1500*time.Millisecond)),
+		"YAKS_SUMMARY total=2 passed=1 failed=1 errors=1 skipped=0 duration=1.500s")
+}
+
+func TestSkippedResult(t *testing.T) {
+	results := v1alpha1.TestResults{
+		Suites: []v1alpha1.TestSuite{
+			GetSkippedResult("wip", "not matching selector 'suite=smoke'"),
+		},
+	}
+
+	var out bytes.Buffer
+	assert.NilError(t, Generate(&results, JUnitOutput, &out))
+	assert.Assert(t, strings.Contains(out.String(), `skipped="1" tests="1"`))
+	assert.Assert(t, strings.Contains(out.String(), `<skipped message="not matching selector &#39;suite=smoke&#39;"></skipped>`))
+
+	out.Reset()
+	assert.NilError(t, Generate(&results, SummaryOutput, &out))
+	assert.Assert(t, strings.Contains(out.String(), "Skipped: 1"))
+	assert.Assert(t, strings.Contains(out.String(), "\twip: Skipped - not matching selector 'suite=smoke'"))
+}
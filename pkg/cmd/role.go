@@ -101,12 +101,12 @@ func (o *roleCmdOptions) run(cmd *cobra.Command, args []string) error {
 					}
 
 					for _, f := range files {
-						err = applyOperatorRole(o.Context, c, path.Join(role, f.Name()), namespace, customizer)
+						err = applyOperatorRole(o.Context, c, path.Join(role, f.Name()), namespace, customizer, nil)
 						if err != nil {
 							return err
 						}
 					}
-				} else if err := applyOperatorRole(o.Context, c, role, namespace, customizer); err != nil {
+				} else if err := applyOperatorRole(o.Context, c, role, namespace, customizer, nil); err != nil {
 					return err
 				}
 			}
@@ -138,17 +138,48 @@ func (o *roleCmdOptions) run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func applyOperatorRole(ctx context.Context, c client.Client, role string, namespace string, customizer install.ResourceCustomizer) error {
+// isPredefinedRole checks if given role refers to one of the roles shipped with YAKS
+func isPredefinedRole(role string) bool {
+	return role == config.RoleKnative || role == config.RoleCamelK || role == config.RoleStrimzi
+}
+
+// roleFiles lists the role definitions for given role. A directory provides all YAML files in the directory in
+// sorted order.
+func roleFiles(role string) ([]string, error) {
+	if !isDir(role) {
+		return []string{role}, nil
+	}
+
+	files, err := ioutil.ReadDir(role)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]string, 0)
+	for _, f := range files {
+		if !f.IsDir() && strings.HasSuffix(f.Name(), ".yaml") {
+			roles = append(roles, path.Join(role, f.Name()))
+		}
+	}
+
+	if len(roles) == 0 {
+		return nil, fmt.Errorf("no role definitions found in directory %s", role)
+	}
+
+	return roles, nil
+}
+
+func applyOperatorRole(ctx context.Context, c client.Client, role string, namespace string, customizer install.ResourceCustomizer, collection *kubernetes.Collection) error {
 	if role == config.RoleKnative {
-		if err := install.InstallKnative(ctx, c, namespace, customizer, nil, true); err != nil {
+		if err := install.InstallKnative(ctx, c, namespace, customizer, collection, true); err != nil {
 			return err
 		}
 	} else if role == config.RoleCamelK {
-		if err := install.InstallCamelK(ctx, c, namespace, customizer, nil, true); err != nil {
+		if err := install.InstallCamelK(ctx, c, namespace, customizer, collection, true); err != nil {
 			return err
 		}
 	} else if role == config.RoleStrimzi {
-		if err := install.InstallStrimzi(ctx, c, namespace, customizer, nil, true); err != nil {
+		if err := install.InstallStrimzi(ctx, c, namespace, customizer, collection, true); err != nil {
 			return err
 		}
 	} else if strings.HasSuffix(role, ".yaml") {
@@ -157,26 +188,37 @@ func applyOperatorRole(ctx context.Context, c client.Client, role string, namesp
 			return err
 		}
 
-		obj, err := kubernetes.LoadResourceFromYaml(c.GetScheme(), data)
-		if err != nil {
-			return err
-		}
+		return applyOperatorRoleData(ctx, c, role, data, namespace, customizer, collection)
+	} else {
+		return errors.New(fmt.Sprintf("unsupported role definition - please use one of '%s', '%s', '%s' or 'role.yaml'", config.RoleCamelK, config.RoleKnative, config.RoleStrimzi))
+	}
 
-		if r, ok := obj.(*v1.Role); ok {
-			verifyRole(r, config.OperatorServiceAccount)
-		} else if rb, ok := obj.(*v1.RoleBinding); ok {
-			verifyRoleBinding(rb, config.OperatorServiceAccount)
-		} else {
-			return errors.New("unsupported resource type - expected Role or RoleBinding")
-		}
+	return nil
+}
 
-		if err := install.RuntimeObjectOrCollect(ctx, c, namespace, nil, true, customizer(obj)); err != nil {
-			return err
-		}
-		fmt.Printf("Added role permission '%s' from file %s to YAKS operator in namespace '%s'\n", obj.GetName(), path.Base(role), namespace)
+// applyOperatorRoleData applies the role or role binding given as YAML data to the YAKS operator or adds it to the collection if present
+func applyOperatorRoleData(ctx context.Context, c client.Client, role string, data string, namespace string, customizer install.ResourceCustomizer, collection *kubernetes.Collection) error {
+	obj, err := kubernetes.LoadResourceFromYaml(c.GetScheme(), data)
+	if err != nil {
+		return err
+	}
+
+	if r, ok := obj.(*v1.Role); ok {
+		verifyRole(r, config.OperatorServiceAccount)
+	} else if rb, ok := obj.(*v1.RoleBinding); ok {
+		verifyRoleBinding(rb, config.OperatorServiceAccount)
 	} else {
-		return errors.New(fmt.Sprintf("unsupported role definition - please use one of '%s', '%s', '%s' or 'role.yaml'", config.RoleCamelK, config.RoleKnative, config.RoleStrimzi))
+		return errors.New("unsupported resource type - expected Role or RoleBinding")
+	}
+
+	if err := install.RuntimeObjectOrCollect(ctx, c, namespace, collection, true, customizer(obj)); err != nil {
+		return err
+	}
+
+	if collection != nil {
+		return nil
 	}
+	fmt.Printf("Added role permission '%s' from file %s to YAKS operator in namespace '%s'\n", obj.GetName(), path.Base(role), namespace)
 
 	return nil
 }
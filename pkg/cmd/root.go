@@ -19,6 +19,7 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 	"os"
@@ -26,25 +27,43 @@ import (
 
 	"github.com/citrusframework/yaks/pkg/client"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
 	ConfigNameEnv = "YAKS_CONFIG_NAME"
 	ConfigPathEnv = "YAKS_CONFIG_PATH"
 
+	// defaultK8sBurst is the burst of the controller runtime client, used when only the QPS is set
+	defaultK8sBurst = 30
+	maxK8sQPS       = 500
+	maxK8sBurst     = 1000
+
+	// origins of the namespace of the command options
+	namespaceFromFlag       = "--namespace"
+	namespaceFromKubeConfig = "current kubeconfig context"
+	namespaceFromDefault    = "default"
+
 	commandShortDescription = `YAKS is a client tool for running tests natively on Kubernetes`
 	commandLongDescription  = `YAKS is a platform to enable Cloud Native BDD testing on Kubernetes.`
 )
 
 // RootCmdOptions --
 type RootCmdOptions struct {
-	RootContext   context.Context    `mapstructure:"-"`
-	Context       context.Context    `mapstructure:"-"`
-	ContextCancel context.CancelFunc `mapstructure:"-"`
-	_client       client.Client      `mapstructure:"-"`
-	KubeConfig    string             `mapstructure:"kube-config"`
-	Namespace     string             `mapstructure:"namespace"`
-	Verbose       bool               `mapstructure:"verbose"`
+	RootContext                 context.Context    `mapstructure:"-"`
+	Context                     context.Context    `mapstructure:"-"`
+	ContextCancel               context.CancelFunc `mapstructure:"-"`
+	_client                     client.Client      `mapstructure:"-"`
+	KubeConfig                  string             `mapstructure:"kube-config"`
+	KubeContext                 string             `mapstructure:"context"`
+	InCluster                   bool               `mapstructure:"in-cluster"`
+	Namespace                   string             `mapstructure:"namespace"`
+	Verbose                     bool               `mapstructure:"verbose"`
+	RemoteInsecureSkipTLSVerify bool               `mapstructure:"remote-insecure-skip-tls-verify"`
+	K8sQPS                      float32            `mapstructure:"k8s-qps"`
+	K8sBurst                    int                `mapstructure:"k8s-burst"`
+	// namespaceOrigin tells how the namespace got resolved
+	namespaceOrigin string `mapstructure:"-"`
 }
 
 // NewYaksCommand --
@@ -67,18 +86,29 @@ func NewYaksCommand(ctx context.Context) (*cobra.Command, error) {
 	}
 
 	cmd.PersistentFlags().StringVar(&options.KubeConfig, "config", os.Getenv("KUBECONFIG"), "Path to the config file to use for CLI requests")
+	cmd.PersistentFlags().StringVar(&options.KubeContext, "context", "", "Name of the kubeconfig context to use, overrides the current context for this invocation")
+	cmd.PersistentFlags().BoolVar(&options.InCluster, "in-cluster", false, "Use the service account of the pod the CLI is running in, used by default when running in a pod without a kubeconfig")
 	cmd.PersistentFlags().StringVarP(&options.Namespace, "namespace", "n", "", "Namespace to use for all operations")
 	cmd.PersistentFlags().BoolVarP(&options.Verbose, "verbose", "v", false, "Print details while performing an operation")
+	cmd.PersistentFlags().BoolVar(&options.RemoteInsecureSkipTLSVerify, "remote-insecure-skip-tls-verify", false, "Skip the certificate verification when fetching remote files, "+
+		"e.g. behind a proxy with a self-signed certificate. This makes the connection insecure")
+	cmd.PersistentFlags().Float32Var(&options.K8sQPS, "k8s-qps", 0, fmt.Sprintf("Maximum queries per second to the Kubernetes API server, "+
+		"at most %d. Overrides the client.qps config setting, 0 keeps the client default", maxK8sQPS))
+	cmd.PersistentFlags().IntVar(&options.K8sBurst, "k8s-burst", 0, fmt.Sprintf("Maximum burst of queries to the Kubernetes API server, "+
+		"at most %d. Overrides the client.burst config setting, 0 keeps the client default", maxK8sBurst))
 
 	cmd.AddCommand(newCmdCompletion(&cmd))
-	cmd.AddCommand(newCmdVersion())
+	cmd.AddCommand(cmdOnly(newCmdVersion(&options)))
+	cmd.AddCommand(cmdOnly(newCmdInit(&options)))
 	cmd.AddCommand(cmdOnly(newCmdRun(&options)))
 	cmd.AddCommand(cmdOnly(newCmdDelete(&options)))
 	cmd.AddCommand(cmdOnly(newCmdList(&options)))
 	cmd.AddCommand(cmdOnly(newCmdLog(&options)))
+	cmd.AddCommand(cmdOnly(newCmdStatus(&options)))
 	cmd.AddCommand(cmdOnly(newCmdInstall(&options)))
 	cmd.AddCommand(cmdOnly(newCmdRole(&options)))
 	cmd.AddCommand(cmdOnly(newCmdUninstall(&options)))
+	cmd.AddCommand(newCmdConfig())
 	cmd.AddCommand(newCmdOperator())
 	cmd.AddCommand(cmdOnly(newCmdUpload(&options)))
 	cmd.AddCommand(cmdOnly(newCmdReport(&options)))
@@ -149,7 +179,15 @@ func addHelpSubCommands(cmd *cobra.Command, options *RootCmdOptions) error {
 }
 
 func (command *RootCmdOptions) preRun(cmd *cobra.Command, _ []string) error {
-	if command.Namespace == "" && !isOfflineCommand(cmd) {
+	if command.RemoteInsecureSkipTLSVerify {
+		fmt.Fprintln(cmd.ErrOrStderr(), "WARN: Certificates of remote files are not verified - the connection is insecure and "+
+			"should only be used with trusted proxies")
+		remoteClient = newHTTPClient(true)
+	}
+
+	if command.Namespace != "" {
+		command.namespaceOrigin = namespaceFromFlag
+	} else if !isOfflineCommand(cmd) {
 		var current string
 		c, err := command.GetCmdClient()
 		if err != nil {
@@ -159,6 +197,11 @@ func (command *RootCmdOptions) preRun(cmd *cobra.Command, _ []string) error {
 		if err != nil {
 			return errors.Wrap(err, "cannot get current namespace")
 		}
+		command.namespaceOrigin = namespaceFromKubeConfig
+		if current == "" {
+			current = metav1.NamespaceDefault
+			command.namespaceOrigin = namespaceFromDefault
+		}
 		err = cmd.Flag("namespace").Value.Set(current)
 		if err != nil {
 			return err
@@ -180,5 +223,41 @@ func (command *RootCmdOptions) GetCmdClient() (client.Client, error) {
 
 // NewCmdClient returns a new client that can be used from command line tools
 func (command *RootCmdOptions) NewCmdClient() (client.Client, error) {
-	return client.NewOutOfClusterClient(command.KubeConfig)
+	rateLimit, err := command.rateLimit()
+	if err != nil {
+		return nil, err
+	}
+
+	if command.InCluster {
+		return client.NewInClusterClient(rateLimit)
+	}
+
+	if command.KubeConfig == "" && command.KubeContext == "" {
+		if inCluster, err := client.IsInCluster(); err == nil && inCluster {
+			return client.NewInClusterClient(rateLimit)
+		}
+	}
+
+	return client.NewOutOfClusterClient(command.KubeConfig, command.KubeContext, rateLimit)
+}
+
+// rateLimit validates the client rate limits of the options, unset values use the defaults
+func (command *RootCmdOptions) rateLimit() (client.ConfigCustomizer, error) {
+	qps, burst := command.K8sQPS, command.K8sBurst
+	if qps < 0 || qps > maxK8sQPS {
+		return nil, fmt.Errorf("invalid Kubernetes client QPS %g, must be between 0 and %d", qps, maxK8sQPS)
+	}
+	if burst < 0 || burst > maxK8sBurst {
+		return nil, fmt.Errorf("invalid Kubernetes client burst %d, must be between 0 and %d", burst, maxK8sBurst)
+	}
+
+	if qps > 0 && burst == 0 {
+		// client-go requires a burst when rate limiting
+		burst = defaultK8sBurst
+		if int(qps) > burst {
+			burst = int(qps)
+		}
+	}
+
+	return client.RateLimit(qps, burst), nil
 }
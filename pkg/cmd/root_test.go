@@ -0,0 +1,77 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/citrusframework/yaks/pkg/util/log"
+	"gotest.tools/v3/assert"
+	"k8s.io/client-go/rest"
+)
+
+func TestRateLimit(t *testing.T) {
+	cfg := rest.Config{QPS: 20, Burst: 30}
+	rateLimit, err := (&RootCmdOptions{K8sQPS: 100}).rateLimit()
+	assert.NilError(t, err)
+	rateLimit(&cfg)
+	assert.Equal(t, cfg.QPS, float32(100))
+	assert.Equal(t, cfg.Burst, 100)
+
+	rateLimit, err = (&RootCmdOptions{K8sBurst: 200}).rateLimit()
+	assert.NilError(t, err)
+	rateLimit(&cfg)
+	assert.Equal(t, cfg.QPS, float32(100))
+	assert.Equal(t, cfg.Burst, 200)
+
+	_, err = (&RootCmdOptions{K8sQPS: 1000, K8sBurst: 10}).rateLimit()
+	assert.Error(t, err, "invalid Kubernetes client QPS 1000, must be between 0 and 500")
+
+	_, err = (&RootCmdOptions{K8sBurst: -1}).rateLimit()
+	assert.Error(t, err, "invalid Kubernetes client burst -1, must be between 0 and 1000")
+}
+
+func TestRateLimitConfig(t *testing.T) {
+	dir := t.TempDir()
+	assert.NilError(t, ioutil.WriteFile(path.Join(dir, ConfigFile), []byte("config:\n  client:\n    qps: 50\n    burst: 100\n"), 0644))
+
+	var errOut bytes.Buffer
+	options := runCmdOptions{RootCmdOptions: &RootCmdOptions{}, out: newOutput(&bytes.Buffer{}, false, log.Log)}
+	options.out.err = &errOut
+	assert.NilError(t, options.applyClientConfig(dir))
+	assert.Equal(t, options.K8sQPS, float32(50))
+	assert.Equal(t, options.K8sBurst, 100)
+	assert.Equal(t, errOut.String(), "")
+
+	// options take precedence over the config file
+	options = runCmdOptions{RootCmdOptions: &RootCmdOptions{K8sQPS: 10}, out: newOutput(&bytes.Buffer{}, false, log.Log)}
+	options.out.err = &errOut
+	assert.NilError(t, options.applyClientConfig(dir))
+	assert.Equal(t, options.K8sQPS, float32(10))
+	assert.Equal(t, options.K8sBurst, 100)
+	assert.Equal(t, errOut.String(), "WARN: Option --k8s-qps overrides config setting client.qps\n")
+
+	// config overrides on the command line
+	options = runCmdOptions{RootCmdOptions: &RootCmdOptions{}, Sets: []string{"config.client.qps=25.5"}}
+	assert.NilError(t, options.applyClientConfig(t.TempDir()))
+	assert.Equal(t, options.K8sQPS, float32(25.5))
+	assert.Equal(t, options.K8sBurst, 0)
+}
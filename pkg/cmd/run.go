@@ -19,37 +19,76 @@ package cmd
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"github.com/citrusframework/yaks/pkg/install"
 	"gopkg.in/yaml.v2"
+	"io"
 	"io/ioutil"
+	"math/rand"
+	"net/url"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path"
+	"path/filepath"
+	"regexp"
 	r "runtime"
+	"sort"
 	"strings"
+	"sync"
+	"syscall"
+	"text/template"
 	"time"
 
 	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
 	"github.com/citrusframework/yaks/pkg/client"
 	"github.com/citrusframework/yaks/pkg/cmd/config"
 	"github.com/citrusframework/yaks/pkg/cmd/report"
+	"github.com/citrusframework/yaks/pkg/run"
+	"github.com/citrusframework/yaks/pkg/util"
+	"github.com/citrusframework/yaks/pkg/util/color"
+	"github.com/citrusframework/yaks/pkg/util/defaults"
 	"github.com/citrusframework/yaks/pkg/util/kubernetes"
-	k8slog "github.com/citrusframework/yaks/pkg/util/kubernetes/log"
+	"github.com/citrusframework/yaks/pkg/util/log"
 	"github.com/citrusframework/yaks/pkg/util/openshift"
 	"github.com/google/uuid"
 	projectv1 "github.com/openshift/api/project/v1"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	FileSuffix = ".feature"
 	ConfigFile = "yaks-config.yaml"
+	// StdinSource reads the feature source from stdin
+	StdinSource = "-"
+
+	// defaultReportOutputLimit keeps the test log output in reports at a reasonable size
+	defaultReportOutputLimit = 64 * 1024
+
+	// inlineResourcesLimit is the maximum size of resources inlined into the test, the API server rejects objects
+	// larger than ~1MB so bigger resources are moved to a ConfigMap
+	inlineResourcesLimit = 512 * 1024
+	// configMapLimit is the maximum size of a ConfigMap accepted by the API server
+	configMapLimit = 1024 * 1024
+
+	// eventsLookBack defines how long before the test start pod events are considered when a test errors
+	eventsLookBack = 5 * time.Minute
+
+	// maxParallelUploads bounds the number of libraries uploaded at the same time
+	maxParallelUploads = 4
+
+	// tempNamespaceRandomChars is the minimum number of random characters in a temporary namespace name
+	tempNamespaceRandomChars = 8
 )
 
 const (
@@ -64,6 +103,39 @@ const (
 	CucumberFilterTags = "CUCUMBER_FILTER_TAGS"
 )
 
+// DefaultGlue is the glue package of the YAKS steps, it is kept when glue packages of uploads are added
+const DefaultGlue = "org.citrusframework.yaks"
+
+// uploadGlueSeparator separates the uploaded library from its glue packages, e.g. "steps::com.acme.steps"
+const uploadGlueSeparator = "::"
+
+// gluePattern matches Java package names
+var gluePattern = regexp.MustCompile(`^[a-zA-Z_$][\w$]*(\.[a-zA-Z_$][\w$]*)*$`)
+
+// dependencyPattern matches Maven coordinates the same way the runtime does, versions may use @property@ placeholders
+var dependencyPattern = regexp.MustCompile(`^[^:\s,]+:[^:\s,]+:[@.0-9][^:\s,]*$`)
+
+// camelDependencyPattern matches Camel component dependencies of form camel:<component>
+var camelDependencyPattern = regexp.MustCompile(`^camel:([^:\s,]+)$`)
+
+// loggerLevels are the log levels supported by the runtime, level names are case insensitive
+var loggerLevels = map[string]bool{
+	"TRACE": true,
+	"DEBUG": true,
+	"INFO":  true,
+	"WARN":  true,
+	"ERROR": true,
+	"FATAL": true,
+	"OFF":   true,
+	"ALL":   true,
+}
+
+const (
+	OrderByName    = "name"
+	OrderByModTime = "mtime"
+	OrderShuffle   = "shuffle"
+)
+
 func newCmdRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *runCmdOptions) {
 	options := runCmdOptions{
 		RootCmdOptions: rootCmdOptions,
@@ -72,56 +144,191 @@ func newCmdRun(rootCmdOptions *RootCmdOptions) (*cobra.Command, *runCmdOptions)
 	cmd := cobra.Command{
 		Use:     "run [options] [test file to execute]",
 		Short:   "Run tests",
-		Long:    `Deploys and executes a test on given namespace.`,
+		Long:    `Deploys and executes a test on given namespace. Use "-" as test file to read the feature from stdin.`,
 		Args:    options.validateArgs,
 		Aliases: []string{"test"},
 		PreRunE: decode(&options),
-		RunE:    options.run,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := options.run(cmd, args)
+			var exitErr *ExitError
+			if err != nil && !errors.As(err, &exitErr) {
+				return &ExitError{Code: ExitCodeError, Err: err}
+			}
+			return err
+		},
 	}
 
 	cmd.Flags().StringArray("maven-repository", nil, "Adds custom Maven repository URL that is added to the runtime.")
+	cmd.Flags().StringArray("maven-server", nil, "Adds credentials for a Maven repository from a secret holding username and password entries. E.g. \"--maven-server my-repo=my-repo-credentials\"")
 	cmd.Flags().StringArrayP("logger", "l", nil, "Adds logger configuration setting log levels.")
 	cmd.Flags().StringArrayP("dependency", "d", nil, "Adds runtime dependencies that get automatically loaded before the test is executed.")
-	cmd.Flags().StringArrayP("upload", "u", nil, "Upload a given library to the cluster to allow it to be used by tests.")
+	cmd.Flags().StringArrayP("upload", "u", nil, "Upload a given library to the cluster to allow it to be used by tests. Append glue packages of the library with \"::\", e.g. \"-u steps::com.acme.steps\"")
+	cmd.Flags().String("upload-timeout", "", "Time to wait for a single upload of a library, e.g. \"5m\". By default uploads are not limited")
+	cmd.Flags().Int("upload-retries", 0, "Number of retries of a failed library upload, retries wait with an exponential backoff")
 	cmd.Flags().StringP("settings", "s", "", "Path to runtime settings file. File content is added to the test runtime and can hold runtime dependency information for instance.")
+	cmd.Flags().StringArray("set", nil, "Override a setting of the yaks-config.yaml with a dotted path relative to the config section, lists are comma separated. E.g. \"--set runtime.cucumber.tags=@smoke\"")
 	cmd.Flags().StringArrayP("env", "e", nil, "Set an environment variable in the integration container. E.g \"-e MY_VAR=my-value\"")
-	cmd.Flags().StringArrayP("tag", "t", nil, "Specify a tag filter to only run tests that match given tag expression")
+	cmd.Flags().StringArrayP("tag", "t", nil, "Specify a tag filter to only run tests that match given tag expression. E.g. \"-t '@smoke and not @wip'\"")
+	cmd.Flags().String("tag-expression-file", "", "Read a tag expression filter from given file, combined with tag options using \"and\"")
 	cmd.Flags().StringArrayP("feature", "f", nil, "Feature file to include in the test run")
 	cmd.Flags().StringArray("resource", nil, "Add a resource")
+	cmd.Flags().StringArray("resource-from-configmap", nil, "Mount the entries of an existing ConfigMap as test resources, use name/key to mount a single entry. E.g. \"--resource-from-configmap fixtures/order.json\"")
+	cmd.Flags().StringArray("resource-from-secret", nil, "Mount the entries of an existing Secret as test resources, use name/key to mount a single entry. E.g. \"--resource-from-secret credentials\"")
 	cmd.Flags().StringArray("property-file", nil, "Bind a property file to the test. E.g. \"--property-file test.properties\"")
 	cmd.Flags().StringArrayP("glue", "g", nil, "Additional glue path to be added in the Cucumber runtime options")
 	cmd.Flags().StringP("options", "o", "", "Cucumber runtime options")
-	cmd.Flags().String("dump", "", "Dump output format. One of: json|yaml. If set the test CR is created and printed to the CLI output instead of running the test.")
-	cmd.Flags().StringP("report", "r", "junit", "Create test report in given output format")
+	cmd.Flags().String("dump", "", "Dump output format. One of: json|yaml|env. If set the test CR is created and printed to the CLI output instead of running the test. Use env to print the resolved test environment only.")
+	cmd.Flags().StringSliceP("report", "r", []string{string(report.JUnitOutput)}, "Create test reports in given output formats. One or more of: junit|json|summary|none. Use none to disable report files")
 	cmd.Flags().String("timeout", "", "Time to wait for individual test to complete")
+	cmd.Flags().String("group-timeout", "", "Maximum time for running all tests of a test group. When exceeded running tests are cancelled and remaining tests are skipped")
 	cmd.Flags().BoolP("wait", "w", true, "Wait for the test to be complete")
 	cmd.Flags().Bool("logs", true, "Print test logs")
+	cmd.Flags().String("order", OrderByName, "Order of the tests in a test group. One of: name|mtime|shuffle")
+	cmd.Flags().Int64("seed", 0, "Seed for shuffling the test order, a random seed is used and printed if not set")
+	cmd.Flags().Bool("fail-fast", false, "Stop running the tests of a test group as soon as a test fails")
+	cmd.Flags().Int("report-output-limit", defaultReportOutputLimit, "Maximum number of bytes of the test log output added to the test report, 0 disables capturing the output")
+	cmd.Flags().String("name", "", "Name of the test, overrides the name derived from the test file. Not supported for test groups")
+	cmd.Flags().String("select", "", "Label selector to filter the test files of a test group, e.g. \"suite=smoke\"")
+	cmd.Flags().Bool("skip-validation", false, "Skip the validation of the Gherkin syntax of feature files before the test is created")
+	cmd.Flags().Bool("force", false, "Delete an existing test with the same name and create a fresh test instead of updating the existing test")
+	cmd.Flags().Bool("prune", false, "Delete finished tests of previous runs in the test namespace before running the tests")
+	cmd.Flags().String("prune-ttl", "", "Only prune tests that are older than given duration, e.g. \"24h\"")
+	cmd.Flags().String("shell", "", "Interpreter used to run pre and post steps, defaults to powershell.exe on Windows and /bin/bash on other systems")
+	cmd.Flags().Bool("raw-step-output", false, "Print the output of pre and post steps unmodified instead of prefixing each line with the step name")
+	cmd.Flags().String("logs-since", "", "Only print test logs newer than given duration, e.g. \"5m\". By default all logs are printed")
+	cmd.Flags().String("logs-dir", "", "Save the logs of each test to a file <test-name>.log in given directory")
+	cmd.Flags().Bool("install-only", false, "Install YAKS cluster resources, operator and roles into the test namespace and exit without running tests")
+	cmd.Flags().Bool("server-dry-run", false, "Submit the manifests and tests with server-side dry run in order to validate them against the admission policies of the cluster without persisting or running anything")
+	cmd.Flags().Bool("print-config", false, "Print the effective run configuration with defaults and overrides applied as YAML and exit without running tests")
+	cmd.Flags().String("dump-install", "", "Dump output format. One of: json|yaml. If set the operator resources and roles that would be installed into the test namespace are printed instead of running the test")
+	cmd.Flags().Bool("global", false, "Install a global operator watching all namespaces when no global operator is available, requires cluster-scoped permissions")
+	cmd.Flags().Bool("allow-empty", false, "Do not fail when the test directory does not contain any test files")
+	cmd.Flags().Bool("no-cleanup", false, "Keep all created resources such as temporary namespaces and manifests and skip the post steps")
+	cmd.Flags().Bool("print-name", false, "Print name and namespace of each created test as JSON object, one per line")
+	cmd.Flags().String("color", string(color.Auto), "Colorize the output. One of: auto|always|never")
+	cmd.Flags().Bool("summary-line", false, "Print a single line summary \"YAKS_SUMMARY total=N passed=N failed=N errors=N skipped=N duration=Ns\" at the end of the run, always printed in quiet mode")
+	cmd.Flags().BoolP("quiet", "q", false, "Suppress informational output and only print test logs, step output, errors and the final summary")
 
 	return &cmd, &options
 }
 
 type runCmdOptions struct {
 	*RootCmdOptions
-	Repositories  []string            `mapstructure:"maven-repository"`
-	Dependencies  []string            `mapstructure:"dependency"`
-	Logger        []string            `mapstructure:"logger"`
-	Uploads       []string            `mapstructure:"upload"`
-	Settings      string              `mapstructure:"settings"`
-	Env           []string            `mapstructure:"env"`
-	Tags          []string            `mapstructure:"tag"`
-	Features      []string            `mapstructure:"feature"`
-	Resources     []string            `mapstructure:"resources"`
-	PropertyFiles []string            `mapstructure:"property-files"`
-	Glue          []string            `mapstructure:"glue"`
-	Options       string              `mapstructure:"options"`
-	DumpFormat    string              `mapstructure:"dump"`
-	ReportFormat  report.OutputFormat `mapstructure:"report"`
-	Timeout       string              `mapstructure:"timeout"`
-	Wait          bool                `mapstructure:"wait"`
-	Logs          bool                `mapstructure:"logs"`
-}
-
-func (o *runCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
+	Repositories   []string              `mapstructure:"maven-repository"`
+	MavenServers   []string              `mapstructure:"maven-server"`
+	Dependencies   []string              `mapstructure:"dependency"`
+	Logger         []string              `mapstructure:"logger"`
+	Uploads        []string              `mapstructure:"upload"`
+	UploadTimeout  string                `mapstructure:"upload-timeout"`
+	UploadRetries  int                   `mapstructure:"upload-retries"`
+	Settings       string                `mapstructure:"settings"`
+	Env            []string              `mapstructure:"env"`
+	Sets           []string              `mapstructure:"set"`
+	Tags           []string              `mapstructure:"tag"`
+	TagFile        string                `mapstructure:"tag-expression-file"`
+	Features       []string              `mapstructure:"feature"`
+	Resources      []string              `mapstructure:"resources"`
+	ConfigMapRefs  []string              `mapstructure:"resource-from-configmap"`
+	SecretRefs     []string              `mapstructure:"resource-from-secret"`
+	PropertyFiles  []string              `mapstructure:"property-files"`
+	Glue           []string              `mapstructure:"glue"`
+	Options        string                `mapstructure:"options"`
+	DumpFormat     string                `mapstructure:"dump"`
+	ReportFormats  []report.OutputFormat `mapstructure:"report"`
+	Timeout        string                `mapstructure:"timeout"`
+	GroupTimeout   string                `mapstructure:"group-timeout"`
+	Wait           bool                  `mapstructure:"wait"`
+	Logs           bool                  `mapstructure:"logs"`
+	FailFast       bool                  `mapstructure:"fail-fast"`
+	Order          string                `mapstructure:"order"`
+	Seed           int64                 `mapstructure:"seed"`
+	OutputLimit    int                   `mapstructure:"report-output-limit"`
+	Quiet          bool                  `mapstructure:"quiet"`
+	SummaryLine    bool                  `mapstructure:"summary-line"`
+	Color          color.Mode            `mapstructure:"color"`
+	PrintName      bool                  `mapstructure:"print-name"`
+	Name           string                `mapstructure:"name"`
+	Select         string                `mapstructure:"select"`
+	Force          bool                  `mapstructure:"force"`
+	SkipValidation bool                  `mapstructure:"skip-validation"`
+	Prune          bool                  `mapstructure:"prune"`
+	PruneTTL       string                `mapstructure:"prune-ttl"`
+	NoCleanup      bool                  `mapstructure:"no-cleanup"`
+	Shell          string                `mapstructure:"shell"`
+	RawStepOutput  bool                  `mapstructure:"raw-step-output"`
+	LogsSince      string                `mapstructure:"logs-since"`
+	LogsDir        string                `mapstructure:"logs-dir"`
+	AllowEmpty     bool                  `mapstructure:"allow-empty"`
+	InstallOnly    bool                  `mapstructure:"install-only"`
+	DumpInstall    string                `mapstructure:"dump-install"`
+	ServerDryRun   bool                  `mapstructure:"server-dry-run"`
+	PrintConfig    bool                  `mapstructure:"print-config"`
+	Global         bool                  `mapstructure:"global"`
+
+	// runID correlates all tests, steps and reports of a single run
+	runID string
+	out   *output
+	// crdVerified caches the result of the Test CRD preflight check for the duration of the run
+	crdVerified bool
+	// rng shuffles the test order
+	rng *rand.Rand
+	// shuffleReported is set once the seed of the shuffled test order has been printed
+	shuffleReported bool
+	// logsSince is the parsed duration of the logs-since option
+	logsSince time.Duration
+	// uploadTimeout is the parsed duration of the upload-timeout option
+	uploadTimeout time.Duration
+	// remoteRoles caches the operator role definitions fetched from remote URLs
+	remoteRoles map[string]string
+	// logFiles holds the test log files written to the logs directory
+	logFiles []string
+	// leftovers holds the commands to manually remove the resources kept because of the no-cleanup option
+	leftovers []string
+	// stdinSource holds the feature source read from stdin
+	stdinSource string
+	// overrides holds the config settings reported as overridden by command line options
+	overrides map[string]bool
+	// setupFailed is set when an error prevented a test from running, as opposed to errors reported by the test itself
+	setupFailed bool
+	// configNamespaceOrigin tells how the namespace of the last loaded run config got resolved
+	configNamespaceOrigin string
+}
+
+// reportFiles returns the distinct report formats that generate report files, the summary is always printed
+func (o *runCmdOptions) reportFiles() []report.OutputFormat {
+	var formats []report.OutputFormat
+	for _, format := range o.ReportFormats {
+		switch format {
+		case report.DefaultOutput, report.SummaryOutput, report.NoneOutput:
+			continue
+		}
+
+		if !containsFormat(formats, format) {
+			formats = append(formats, format)
+		}
+	}
+
+	return formats
+}
+
+func containsFormat(formats []report.OutputFormat, format report.OutputFormat) bool {
+	for _, f := range formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *runCmdOptions) validateArgs(cmd *cobra.Command, args []string) error {
+	installOnly, _ := cmd.Flags().GetBool("install-only")
+	dumpInstall, _ := cmd.Flags().GetString("dump-install")
+	printConfig, _ := cmd.Flags().GetBool("print-config")
+	if (installOnly || dumpInstall != "" || printConfig) && len(args) <= 1 {
+		// test source is optional and only used to load the run configuration
+		return nil
+	}
+
 	if len(args) != 1 {
 		return errors.New(fmt.Sprintf("accepts exactly 1 test name to execute, received %d", len(args)))
 	}
@@ -130,146 +337,545 @@ func (o *runCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
 }
 
 func (o *runCmdOptions) run(cmd *cobra.Command, args []string) error {
-	source := args[0]
+	source := ""
+	if len(args) > 0 {
+		source = args[0]
+	}
+
+	// every path of the run may print messages, including the dumps
+	o.out = newOutput(cmd.OutOrStdout(), o.Quiet, log.Log)
+	o.out.err = cmd.ErrOrStderr()
+	o.out.rawSteps = o.RawStepOutput
+
+	switch o.DumpFormat {
+	case "", "yaml", "json", "env":
+	default:
+		return fmt.Errorf("invalid dump output format option '%s', should be one of: yaml|json|env", o.DumpFormat)
+	}
+
+	switch o.DumpInstall {
+	case "", "yaml", "json":
+	default:
+		return fmt.Errorf("invalid dump install output format option '%s', should be one of: yaml|json", o.DumpInstall)
+	}
+
+	if o.ServerDryRun && (o.DumpFormat != "" || o.DumpInstall != "" || o.InstallOnly) {
+		return errors.New("option --server-dry-run can not be combined with --dump, --dump-install or --install-only")
+	}
+
+	if o.PrintConfig && (o.DumpFormat != "" || o.DumpInstall != "" || o.InstallOnly || o.ServerDryRun) {
+		return errors.New("option --print-config can not be combined with --dump, --dump-install, --install-only or --server-dry-run")
+	}
+
+	for _, format := range o.ReportFormats {
+		if err := report.ValidateOutputFormat(format); err != nil {
+			return err
+		}
+	}
+
+	for _, upload := range o.Uploads {
+		if _, glue := splitUpload(upload); len(glue) > 0 {
+			if err := validateGlue(glue); err != nil {
+				return fmt.Errorf("invalid upload '%s': %v", upload, err)
+			}
+		}
+	}
+
+	if err := color.Setup(o.Color, cmd.OutOrStdout()); err != nil {
+		return err
+	}
+
+	switch o.Order {
+	case "", OrderByName, OrderByModTime:
+	case OrderShuffle:
+		// any seed given is used as is, including 0, so every printed seed reproduces its order
+		if !cmd.Flags().Changed("seed") {
+			o.Seed = time.Now().UnixNano()
+		}
+		/* #nosec */
+		o.rng = rand.New(rand.NewSource(o.Seed))
+	default:
+		return fmt.Errorf("invalid test order option '%s', should be one of: name|mtime|shuffle", o.Order)
+	}
 
-	results := v1alpha1.TestResults{}
+	var err error
+	if o.logsSince, err = parseSince(o.LogsSince); err != nil {
+		return err
+	}
+
+	if o.UploadTimeout != "" {
+		if o.uploadTimeout, err = time.ParseDuration(o.UploadTimeout); err != nil || o.uploadTimeout <= 0 {
+			return fmt.Errorf("invalid upload timeout '%s', expected a positive duration such as 5m", o.UploadTimeout)
+		}
+	}
+	if o.UploadRetries < 0 {
+		return fmt.Errorf("invalid upload retries %d, must not be negative", o.UploadRetries)
+	}
+
+	if o.Shell != "" {
+		if _, err := lookupShell(o.Shell); err != nil {
+			return err
+		}
+	}
+
+	if isArchive(source) {
+		dir, cleanup, err := extractArchive(source)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		source = dir
+	}
+
+	if o.Name != "" {
+		if isDir(source) {
+			return errors.New("option --name is not supported when running a test group")
+		}
+
+		if errs := validation.IsDNS1123Subdomain(o.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid test name '%s': %s", o.Name, strings.Join(errs, ", "))
+		}
+	}
+
+	// fail early on invalid config overrides
+	if err := o.applyConfigOverrides(config.NewWithDefaults()); err != nil {
+		return err
+	}
+
+	if o.PrintConfig {
+		return o.printConfig(cmd.OutOrStdout(), source)
+	}
+
+	if source == StdinSource {
+		if o.Name == "" {
+			return errors.New("option --name is required when reading the feature from stdin")
+		}
+
+		data, err := ioutil.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return fmt.Errorf("failed to read feature from stdin: %v", err)
+		}
+		if strings.TrimSpace(string(data)) == "" {
+			return errors.New("no feature source provided on stdin")
+		}
+		o.stdinSource = string(data)
+	}
+
+	if isDir(source) && !o.AllowEmpty && !o.InstallOnly && o.DumpInstall == "" {
+		if err := o.verifyTestFiles(source); err != nil {
+			return err
+		}
+	}
+
+	if o.DumpInstall != "" {
+		return o.dumpInstall(cmd, source)
+	}
+
+	if o.DumpFormat != "" {
+		// dump is a pure transformation of the test sources, no need to connect to the cluster
+		return o.dump(cmd, source)
+	}
+
+	if err := o.applyClientConfig(source); err != nil {
+		return err
+	}
+
+	// dumps stay reproducible, only runs that talk to the cluster get a run id
+	o.runID = uuid.New().String()
+	o.out.Logger = log.WithValues("run-id", o.runID)
+
+	if o.ServerDryRun {
+		return o.serverDryRun(source)
+	}
+
+	stop := o.handleInterrupt()
+	defer stop()
+
+	if o.NoCleanup {
+		defer o.printLeftovers()
+	}
+
+	if o.InstallOnly {
+		return o.provision(source)
+	}
+
+	if o.LogsDir != "" {
+		defer o.printLogFiles()
+	}
+
+	startTime := metav1.Now()
+	results := v1alpha1.TestResults{
+		RunID:     o.runID,
+		StartTime: &startTime,
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		results.Hostname = hostname
+	}
 	if o.Wait {
+		if o.SummaryLine || o.Quiet {
+			defer func() {
+				fmt.Fprintln(cmd.OutOrStdout(), report.GetSummaryLine(&results, time.Now()))
+			}()
+		}
 		defer report.PrintSummaryReport(&results)
-		if o.ReportFormat != report.DefaultOutput && o.ReportFormat != report.SummaryOutput {
-			defer report.GenerateReport(&results, o.ReportFormat)
+		for _, format := range o.reportFiles() {
+			defer report.GenerateReport(&results, format)
 		}
 	}
 
 	if isDir(source) {
-		o.runTestGroup(cmd, source, &results)
+		if o.GroupTimeout != "" {
+			groupTimeout, err := time.ParseDuration(o.GroupTimeout)
+			if err != nil {
+				return fmt.Errorf("failed to parse group timeout setting - %s", err.Error())
+			}
+
+			ctx, cancel := context.WithTimeout(o.Context, groupTimeout)
+			defer cancel()
+			o.Context = ctx
+		}
+
+		o.runTestGroup(cmd, source, nil, &results)
+
+		if o.groupTimedOut() {
+			// marks the group in the summary, the remaining tests have already been reported as skipped
+			results.Suites = append(results.Suites, v1alpha1.TestSuite{
+				Name:       source,
+				SkipReason: fmt.Sprintf("group timeout after %s", o.GroupTimeout),
+			})
+		}
 	} else {
 		o.runTest(cmd, source, &results)
 	}
 
-	if hasErrors(&results) {
-		return errors.New("There are test failures!")
+	switch exitCode(&results, o.setupFailed) {
+	case ExitCodeError:
+		return &ExitError{Code: ExitCodeError, Err: errors.New("There are tests that could not be run!")}
+	case ExitCodeTestFailure:
+		return &ExitError{Code: ExitCodeTestFailure, Err: errors.New("There are test failures!")}
 	}
 
 	return nil
 }
 
+// handleInterrupt cancels the command context on the first SIGINT/SIGTERM so running tests and temporary
+// namespaces get cleaned up, a second signal forces the process to exit immediately
+func (o *runCmdOptions) handleInterrupt() func() {
+	signals := make(chan os.Signal, 2)
+	done := make(chan struct{})
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case <-signals:
+			o.out.Errorf("Interrupted - cleaning up test resources, press Ctrl-C again to force exit")
+			o.ContextCancel()
+		case <-done:
+			return
+		}
+
+		select {
+		case <-signals:
+			os.Exit(1)
+		case <-done:
+		}
+	}()
+
+	return func() {
+		signal.Stop(signals)
+		close(done)
+	}
+}
+
 func (o *runCmdOptions) runTest(cmd *cobra.Command, source string, results *v1alpha1.TestResults) {
+	o.out.Debug("Running test", "source", source)
+
 	c, err := o.GetCmdClient()
 	if err != nil {
-		handleTestError("", source, results, err)
+		o.handleTestError("", source, results, err)
 		return
 	}
 
 	var runConfig *config.RunConfig
 	if runConfig, err = o.getRunConfig(source); err != nil {
-		handleTestError("", source, results, err)
+		o.handleTestError("", source, results, err)
 		return
 	}
 
 	if runConfig.Config.Namespace.Temporary {
 		if namespace, err := o.createTempNamespace(runConfig, c); namespace != nil {
 			if runConfig.Config.Namespace.AutoRemove && o.Wait {
-				defer deleteTempNamespace(namespace, c, o.Context)
+				if o.NoCleanup {
+					o.keepResource("namespace", "", namespace.GetName())
+				} else {
+					defer deleteTempNamespace(namespace, runConfig.Config.Operator.WatchNamespaces, c, o.RootContext, o.out)
+				}
 			}
 
 			if err != nil {
-				handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+				o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
 				return
 			}
 		} else if err != nil {
-			handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+			o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
 			return
 		}
 	}
 
 	if err = o.uploadArtifacts(runConfig); err != nil {
-		handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+		o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
 		return
 	}
 
-	defer runSteps(runConfig.Post, runConfig.Config.Namespace.Name, runConfig.BaseDir)
-	if err = runSteps(runConfig.Pre, runConfig.Config.Namespace.Name, runConfig.BaseDir); err != nil {
-		handleTestError(runConfig.Config.Namespace.Name, source, results, err)
-		return
+	if o.Prune && !runConfig.Config.Namespace.Temporary {
+		if err = o.pruneTests(c, runConfig.Config.Namespace.Name); err != nil {
+			o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+			return
+		}
 	}
 
-	suite := v1alpha1.TestSuite{}
-	var test *v1alpha1.Test
-	test, err = o.createAndRunTest(cmd, c, source, runConfig)
-	if test != nil {
-		handleTestResult(test, &suite)
-		results.Suites = append(results.Suites, suite)
-
-		if err != nil {
-			suite.Errors = append(suite.Errors, err.Error())
-		}
-	} else if err != nil {
-		handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+	defer o.runCleanupSteps(runConfig.Post, runConfig, "")
+	if err = runSteps(runConfig.Pre, runConfig.Config.Namespace.Name, runConfig.BaseDir, runConfig.Config.Runtime.ShellPath, "", o.out); err != nil {
+		o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+		return
 	}
+
+	o.runSingleTest(cmd, c, source, runConfig, results)
 }
 
-func (o *runCmdOptions) runTestGroup(cmd *cobra.Command, source string, results *v1alpha1.TestResults) {
+func (o *runCmdOptions) runTestGroup(cmd *cobra.Command, source string, ignore ignoreRules, results *v1alpha1.TestResults) {
+	o.out.Debug("Running test group", "source", source)
+
 	c, err := o.GetCmdClient()
 	if err != nil {
-		handleTestError("", source, results, err)
+		o.handleTestError("", source, results, err)
 		return
 	}
 
 	var runConfig *config.RunConfig
 	if runConfig, err = o.getRunConfig(source); err != nil {
-		handleTestError("", source, results, err)
+		o.handleTestError("", source, results, err)
 		return
 	}
 
 	if runConfig.Config.Namespace.Temporary {
-		if namespace, err := o.createTempNamespace(runConfig, c); err != nil {
-			handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+		if namespace, err := o.createTempNamespace(runConfig, c); namespace != nil {
+			if runConfig.Config.Namespace.AutoRemove && o.Wait {
+				if o.NoCleanup {
+					o.keepResource("namespace", "", namespace.GetName())
+				} else {
+					defer deleteTempNamespace(namespace, runConfig.Config.Operator.WatchNamespaces, c, o.RootContext, o.out)
+				}
+			}
+
+			if err != nil {
+				o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+				return
+			}
+		} else if err != nil {
+			o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
 			return
-		} else if namespace != nil && runConfig.Config.Namespace.AutoRemove && o.Wait {
-			defer deleteTempNamespace(namespace, c, o.Context)
 		}
 	}
 
 	if err = o.uploadArtifacts(runConfig); err != nil {
-		handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+		o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
 		return
 	}
 
+	if o.Prune && !runConfig.Config.Namespace.Temporary {
+		if err = o.pruneTests(c, runConfig.Config.Namespace.Name); err != nil {
+			o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+			return
+		}
+	}
+
 	var files []os.FileInfo
 	if files, err = ioutil.ReadDir(source); err != nil {
-		handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+		o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
 		return
 	}
 
-	defer runSteps(runConfig.Post, runConfig.Config.Namespace.Name, runConfig.BaseDir)
-	if err = runSteps(runConfig.Pre, runConfig.Config.Namespace.Name, runConfig.BaseDir); err != nil {
-		handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+	if ignore, err = loadIgnoreRules(source, ignore); err != nil {
+		o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
 		return
 	}
+	o.orderFiles(files)
+
+	defer o.runCleanupSteps(runConfig.Post, runConfig, "")
+	if err = runSteps(runConfig.Pre, runConfig.Config.Namespace.Name, runConfig.BaseDir, runConfig.Config.Runtime.ShellPath, "", o.out); err != nil {
+		o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+		return
+	}
+
+	for i, f := range files {
+		if o.Context.Err() != nil {
+			if o.groupTimedOut() {
+				o.skipTests(source, runConfig, files[i:], ignore, "group timeout", results)
+			}
+			// run has been interrupted
+			break
+		}
+
+		if o.FailFast && hasErrors(results) {
+			o.out.Debug("Skip remaining tests after test failure", "source", source)
+			o.skipTests(source, runConfig, files[i:], ignore, "fail fast after previous test failure", results)
+			break
+		}
+
+		name := path.Join(source, f.Name())
+		if ignore.isIgnored(name, f.IsDir()) {
+			o.out.Debug("Ignore test source", "source", name)
+			if !f.IsDir() && isTestFile(runConfig, f.Name()) {
+				results.Suites = append(results.Suites, report.GetSkippedResult(o.testName(name), "ignored by "+IgnoreFile))
+			}
+			continue
+		}
+
+		if f.IsDir() && runConfig.Config.Recursive {
+			o.runTestGroup(cmd, name, ignore, results)
+		} else if isTestFile(runConfig, f.Name()) {
+			if selected, err := o.isSelected(runConfig, name); err != nil {
+				o.handleTestError(runConfig.Config.Namespace.Name, name, results, err)
+				continue
+			} else if !selected {
+				o.out.Printf("Skip test '%s' not matching selector '%s'", name, o.Select)
+				results.Suites = append(results.Suites, report.GetSkippedResult(o.testName(name), fmt.Sprintf("not matching selector '%s'", o.Select)))
+				continue
+			}
+
+			o.runSingleTest(cmd, c, name, runConfig, results)
+		}
+	}
+}
+
+// orderFiles sorts the files of a test group according to the test order option
+func (o *runCmdOptions) orderFiles(files []os.FileInfo) {
+	switch o.Order {
+	case OrderByModTime:
+		sort.SliceStable(files, func(i, j int) bool {
+			return files[i].ModTime().Before(files[j].ModTime())
+		})
+	case OrderShuffle:
+		sort.SliceStable(files, func(i, j int) bool {
+			return files[i].Name() < files[j].Name()
+		})
+		if o.rng != nil && len(files) > 1 {
+			// dumps must stay free of informational messages
+			if !o.shuffleReported && o.DumpFormat == "" {
+				o.out.Printf("Shuffling test order with seed %d, use --seed %d to reproduce the order", o.Seed, o.Seed)
+				o.shuffleReported = true
+			}
+			o.rng.Shuffle(len(files), func(i, j int) {
+				files[i], files[j] = files[j], files[i]
+			})
+		}
+	default:
+		sort.SliceStable(files, func(i, j int) bool {
+			return files[i].Name() < files[j].Name()
+		})
+	}
+}
+
+// groupTimedOut checks if the group timeout has been exceeded
+func (o *runCmdOptions) groupTimedOut() bool {
+	return o.GroupTimeout != "" && o.Context.Err() == context.DeadlineExceeded
+}
+
+// skipTests records the remaining test files of a group as skipped for given reason without running them
+func (o *runCmdOptions) skipTests(source string, runConfig *config.RunConfig, files []os.FileInfo, ignore ignoreRules, reason string, results *v1alpha1.TestResults) {
+	skip := func(name string) {
+		results.Suites = append(results.Suites, report.GetSkippedResult(name, reason))
+	}
 
 	for _, f := range files {
 		name := path.Join(source, f.Name())
+		if ignore.isIgnored(name, f.IsDir()) {
+			continue
+		}
+
 		if f.IsDir() && runConfig.Config.Recursive {
-			o.runTestGroup(cmd, name, results)
-		} else if strings.HasSuffix(f.Name(), FileSuffix) {
-			suite := v1alpha1.TestSuite{}
-			var test *v1alpha1.Test
-			test, err = o.createAndRunTest(cmd, c, name, runConfig)
-			if test != nil {
-				handleTestResult(test, &suite)
-				results.Suites = append(results.Suites, suite)
-
-				if err != nil {
-					suite.Errors = append(suite.Errors, err.Error())
-				}
-			} else if err != nil {
-				handleTestError(runConfig.Config.Namespace.Name, name, results, err)
+			tests, err := o.newTestGroup(name, ignore)
+			if err != nil {
+				o.handleTestError(runConfig.Config.Namespace.Name, name, results, err)
+				continue
+			}
+
+			for _, test := range tests {
+				skip(test.Name)
 			}
+		} else if isTestFile(runConfig, f.Name()) {
+			if selected, err := o.isSelected(runConfig, name); err == nil && selected {
+				skip(o.testName(name))
+			}
+		}
+	}
+}
+
+// runSingleTest runs given test file surrounded by the beforeEach and afterEach steps of the run configuration.
+// Failing beforeEach steps only fail this very test.
+func (o *runCmdOptions) runSingleTest(cmd *cobra.Command, c client.Client, source string, runConfig *config.RunConfig, results *v1alpha1.TestResults) {
+	testName := o.testName(source)
+	defer o.runCleanupSteps(runConfig.AfterEach, runConfig, testName)
+	if err := runSteps(runConfig.BeforeEach, runConfig.Config.Namespace.Name, runConfig.BaseDir, runConfig.Config.Runtime.ShellPath, testName, o.out); err != nil {
+		o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+		return
+	}
+
+	manifests, err := o.applyManifests(c, runConfig)
+	defer o.deleteManifests(c, manifests)
+	if err != nil {
+		o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+		return
+	}
+
+	if err := o.waitForDependencies(c, runConfig); err != nil {
+		o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+		return
+	}
+
+	startTime := metav1.Now()
+	suite := v1alpha1.TestSuite{
+		StartTime: &startTime,
+	}
+	test, err := o.createAndRunTest(cmd, c, source, runConfig)
+	if test != nil {
+		if test.Status.Phase == v1alpha1.TestPhaseError {
+			// the operator was not able to run the test, e.g. because the test pod could not be started
+			o.setupFailed = true
+		}
+		handleTestResult(test, &suite)
+
+		if err != nil {
+			suite.Errors = append(suite.Errors, err.Error())
 		}
+		skipEmptySuite(test, &suite)
+
+		results.Suites = append(results.Suites, suite)
+	} else if err != nil {
+		o.handleTestError(runConfig.Config.Namespace.Name, source, results, err)
+	}
+}
+
+// skipEmptySuite marks a passed test without any scenario results as skipped, e.g. when the tag filter does not match
+// any scenario of the feature file
+func skipEmptySuite(test *v1alpha1.Test, suite *v1alpha1.TestSuite) {
+	if test.Status.Phase != v1alpha1.TestPhasePassed || suite.Summary.Total > 0 || len(suite.Errors) > 0 {
+		return
 	}
+
+	suite.Summary.Total = 1
+	suite.Summary.Skipped = 1
+	suite.SkipReason = "no scenario has been run, e.g. because the tag filter does not match any scenario"
 }
 
-func handleTestError(namespace string, source string, results *v1alpha1.TestResults, err error) {
+// handleTestError records the error that prevented the test from running
+func (o *runCmdOptions) handleTestError(namespace string, source string, results *v1alpha1.TestResults, err error) {
+	var sourceErr *testSourceError
+	if !errors.As(err, &sourceErr) {
+		o.setupFailed = true
+	}
+
 	suite := v1alpha1.TestSuite{
 		Errors: []string{
 			fmt.Sprintf("%s - %s", k8serrors.ReasonForError(err), err.Error()),
@@ -289,24 +895,23 @@ func handleTestResult(test *v1alpha1.Test, suite *v1alpha1.TestSuite) {
 }
 
 func (o *runCmdOptions) getRunConfig(source string) (*config.RunConfig, error) {
-	var configFile string
 	var runConfig *config.RunConfig
 
 	if isRemoteFile(source) {
-		return config.NewWithDefaults(), nil
+		runConfig = config.NewWithDefaults()
+		if err := o.applyConfigOverrides(runConfig); err != nil {
+			return nil, err
+		}
+		o.resolveNamespace(runConfig)
+		return runConfig, nil
 	}
 
-	if isDir(source) {
-		// search for config file in given directory
-		configFile = path.Join(source, ConfigFile)
-	} else {
-		// search for config file in same directory as given file
-		dir, _ := path.Split(source)
-		configFile = path.Join(dir, ConfigFile)
+	runConfig, err := config.LoadConfig(configFileOf(source))
+	if err != nil {
+		return nil, err
 	}
 
-	runConfig, err := config.LoadConfig(configFile)
-	if err != nil {
+	if err := o.applyConfigOverrides(runConfig); err != nil {
 		return nil, err
 	}
 
@@ -314,20 +919,158 @@ func (o *runCmdOptions) getRunConfig(source string) (*config.RunConfig, error) {
 		runConfig.BaseDir = getBaseDir(source)
 	}
 
-	if runConfig.Config.Namespace.Name == "" && !runConfig.Config.Namespace.Temporary {
-		runConfig.Config.Namespace.Name = o.Namespace
+	o.resolveNamespace(runConfig)
+
+	if o.Shell != "" {
+		runConfig.Config.Runtime.ShellPath = o.Shell
+	}
+
+	if o.Global {
+		runConfig.Config.Operator.Global = true
 	}
 
 	return runConfig, nil
 }
 
-func (o *runCmdOptions) createTempNamespace(runConfig *config.RunConfig, c client.Client) (metav1.Object, error) {
-	namespaceName := "yaks-" + uuid.New().String()
-	namespace, err := initializeTempNamespace(namespaceName, c, o.Context)
-	if err != nil {
-		return nil, err
+// configFileOf returns the config file of the given source, the config file is located in the test directory or in the
+// same directory as the test file
+func configFileOf(source string) string {
+	if isDir(source) {
+		return path.Join(source, ConfigFile)
 	}
-	runConfig.Config.Namespace.Name = namespaceName
+
+	dir, _ := path.Split(source)
+	return path.Join(dir, ConfigFile)
+}
+
+// applyClientConfig sets the client rate limits of the config file next to the source, the --k8s-qps and --k8s-burst
+// options take precedence. A client created before, e.g. when looking up the current namespace, is discarded when the
+// limits change.
+func (o *runCmdOptions) applyClientConfig(source string) error {
+	runConfig := config.NewWithDefaults()
+	if !isRemoteFile(source) {
+		var err error
+		if runConfig, err = config.LoadConfig(configFileOf(source)); err != nil {
+			return err
+		}
+	}
+
+	if err := o.applyConfigOverrides(runConfig); err != nil {
+		return err
+	}
+
+	changed := false
+	if qps := runConfig.Config.Client.QPS; qps != 0 {
+		if o.K8sQPS != 0 {
+			o.warnOverride("--k8s-qps", "client.qps")
+		} else {
+			o.K8sQPS = qps
+			changed = true
+		}
+	}
+
+	if burst := runConfig.Config.Client.Burst; burst != 0 {
+		if o.K8sBurst != 0 {
+			o.warnOverride("--k8s-burst", "client.burst")
+		} else {
+			o.K8sBurst = burst
+			changed = true
+		}
+	}
+
+	if changed {
+		o._client = nil
+	}
+
+	return nil
+}
+
+// resolveNamespace sets the namespace of the run config with the fallback chain: explicit --namespace flag, config
+// namespace.name, the namespace of the current kubeconfig context and the default namespace. Temporary namespaces are kept.
+func (o *runCmdOptions) resolveNamespace(runConfig *config.RunConfig) {
+	ns := &runConfig.Config.Namespace
+	switch {
+	case ns.Temporary:
+		o.configNamespaceOrigin = "temporary namespace"
+	case ns.Name != "" && o.namespaceOrigin != namespaceFromFlag:
+		o.configNamespaceOrigin = "config namespace.name"
+	default:
+		if ns.Name != "" && ns.Name != o.Namespace {
+			o.warnOverride(namespaceFromFlag, "namespace.name")
+		}
+		ns.Name = o.Namespace
+		o.configNamespaceOrigin = o.namespaceOrigin
+	}
+}
+
+// printConfig prints the effective run configuration of given test source as YAML
+func (o *runCmdOptions) printConfig(out io.Writer, source string) error {
+	runConfig, err := o.getRunConfig(source)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(runConfig)
+	if err != nil {
+		return err
+	}
+
+	if o.configNamespaceOrigin != "" {
+		fmt.Fprintf(out, "# namespace resolved from %s\n", o.configNamespaceOrigin)
+	}
+	_, err = fmt.Fprint(out, string(data))
+	return err
+}
+
+// applyConfigOverrides sets the config fields given as key=value overrides
+func (o *runCmdOptions) applyConfigOverrides(runConfig *config.RunConfig) error {
+	for _, override := range o.Sets {
+		pair := strings.SplitN(override, "=", 2)
+		if len(pair) != 2 || strings.TrimSpace(pair[0]) == "" {
+			return fmt.Errorf("invalid config override '%s', expected key=value", override)
+		}
+
+		if err := config.Set(runConfig, strings.TrimSpace(pair[0]), pair[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *runCmdOptions) createTempNamespace(runConfig *config.RunConfig, c client.Client) (metav1.Object, error) {
+	namespaceName, err := tempNamespaceName(runConfig.Config.Namespace.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	var namespaceLabels map[string]string
+	if o.runID != "" {
+		// marks the namespace as owned by YAKS so it can be cleaned up with the delete command
+		namespaceLabels = map[string]string{
+			v1alpha1.TestRunIdLabel: o.runID,
+		}
+	}
+
+	timeout := config.DefaultNamespaceTimeout
+	if runConfig.Config.Namespace.Timeout != "" {
+		timeout = runConfig.Config.Namespace.Timeout
+	}
+
+	namespaceTimeout, err := time.ParseDuration(timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse namespace timeout setting - %s", err.Error())
+	}
+
+	namespace, err := initializeTempNamespace(namespaceName, namespaceLabels, c, o.Context, o.out)
+	if err != nil {
+		return namespace, err
+	}
+
+	if err := waitForNamespace(o.Context, c, namespaceName, namespaceTimeout); err != nil {
+		return namespace, err
+	}
+	runConfig.Config.Namespace.Name = namespaceName
 
 	// looking for existing operator instance in current namespace
 	instance, err := o.findInstance(c, o.Namespace)
@@ -341,12 +1084,19 @@ func (o *runCmdOptions) createTempNamespace(runConfig *config.RunConfig, c clien
 				}
 			}
 
-			if len(instanceList.Items) == 0 {
-				fmt.Println("Unable to find existing YAKS instance - " +
+			if runConfig.Config.Operator.Global {
+				o.out.Printf("Unable to find global YAKS operator - installing global operator in namespace %s", o.operatorNamespace(runConfig))
+			} else if len(instanceList.Items) == 0 {
+				o.out.Println("Unable to find existing YAKS instance - " +
 					"adding new operator instance to temporary namespace by default")
 			}
+		} else if k8serrors.IsForbidden(err) {
+			// least privilege users may not list instances cluster-wide, so there is no way to detect a global operator
+			o.out.Errorf("WARN: Not permitted to look for a global YAKS operator in other namespaces - " +
+				"adding new operator instance to temporary namespace")
 		} else {
-			return namespace, err
+			o.out.Errorf("WARN: Unable to look for a global YAKS operator in other namespaces: %s - "+
+				"adding new operator instance to temporary namespace", err.Error())
 		}
 	} else if err != nil {
 		return namespace, err
@@ -363,55 +1113,212 @@ func (o *runCmdOptions) createTempNamespace(runConfig *config.RunConfig, c clien
 		return namespace, err
 	}
 
-	if err := o.setupOperator(runConfig, c); err != nil {
+	if err := o.setupOperator(runConfig, c, nil); err != nil {
 		return namespace, err
 	}
 
 	return namespace, nil
 }
 
-func (o *runCmdOptions) setupOperator(runConfig *config.RunConfig, c client.Client) error {
-	namespace := runConfig.Config.Namespace.Name
-	var cluster v1alpha1.ClusterType
-	if isOpenshift, err := openshift.IsOpenShift(c); err != nil {
+// tempNamespaceName generates a random namespace name with given prefix. Long prefixes are truncated first so the name
+// fits into the namespace name length limit and always keeps a random part of at least tempNamespaceRandomChars
+func tempNamespaceName(prefix string) (string, error) {
+	if maxPrefix := validation.DNS1123LabelMaxLength - tempNamespaceRandomChars; len(prefix) > maxPrefix {
+		// truncate long prefixes so the name stays unique
+		prefix = prefix[:maxPrefix]
+	}
+
+	name := prefix + strings.ReplaceAll(uuid.New().String(), "-", "")
+	if len(name) > validation.DNS1123LabelMaxLength {
+		name = name[:validation.DNS1123LabelMaxLength]
+	}
+
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return "", fmt.Errorf("invalid temporary namespace name '%s' for prefix '%s': %s", name, prefix, strings.Join(errs, ", "))
+	}
+
+	return name, nil
+}
+
+// setupOperator installs the operator and its roles into the test namespace or adds the resources to the collection if present
+func (o *runCmdOptions) setupOperator(runConfig *config.RunConfig, c client.Client, collection *kubernetes.Collection) error {
+	namespace := o.operatorNamespace(runConfig)
+	cfg, err := o.operatorConfiguration(runConfig, c)
+	if err != nil {
 		return err
-	} else if isOpenshift {
-		cluster = v1alpha1.ClusterTypeOpenShift
-	} else {
-		cluster = v1alpha1.ClusterTypeKubernetes
 	}
 
-	cfg := install.OperatorConfiguration{
-		CustomImage:           "",
-		CustomImagePullPolicy: "",
-		Namespace:             namespace,
-		Global:                false,
-		ClusterType:           string(cluster),
+	customizer := install.IdentityResourceCustomizer
+	if cfg.Global {
+		if collection == nil {
+			if err := o.verifyGlobalPermissions(c); err != nil {
+				return err
+			}
+		}
+
+		// operator roles of a global operator are turned into cluster roles
+		customizer = (&roleCmdOptions{RootCmdOptions: o.RootCmdOptions}).customizer(namespace, true)
+	}
+
+	if len(runConfig.Config.Operator.WatchNamespaces) > 0 {
+		if cfg.Global {
+			o.out.Errorf("WARN: Ignoring watch namespaces of the operator - a global operator watches all namespaces")
+		} else if collection == nil {
+			if err := o.verifyWatchNamespaces(c, cfg.WatchNamespaces); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := install.OperatorOrCollect(o.Context, c, cfg, collection, true); err != nil {
+		return err
 	}
-	err := install.OperatorOrCollect(o.Context, c, cfg, nil, true)
 
 	for _, role := range runConfig.Config.Operator.Roles {
-		err = applyOperatorRole(o.Context, c, resolvePath(runConfig, role), namespace, install.IdentityResourceCustomizer)
+		if isRemoteFile(role) {
+			data, err := o.loadRemoteRole(role)
+			if err != nil {
+				return err
+			}
+
+			if err := applyOperatorRoleData(o.Context, c, role, data, namespace, customizer, collection); err != nil {
+				return fmt.Errorf("failed to apply operator role %s: %v", role, err)
+			}
+			continue
+		}
+
+		if !isPredefinedRole(role) {
+			role = resolvePath(runConfig, role)
+		}
+
+		files, err := roleFiles(role)
 		if err != nil {
 			return err
 		}
+
+		for _, file := range files {
+			if err := applyOperatorRole(o.Context, c, file, namespace, customizer, collection); err != nil {
+				return fmt.Errorf("failed to apply operator role %s: %v", file, err)
+			}
+		}
 	}
 
-	return err
+	return nil
 }
 
-func (o *runCmdOptions) createAndRunTest(cmd *cobra.Command, c client.Client, rawName string, runConfig *config.RunConfig) (*v1alpha1.Test, error) {
+// operatorNamespace is the namespace of the operator installed by the CLI. A global operator is installed into the
+// configured operator namespace or the current namespace, otherwise the operator runs in the test namespace.
+func (o *runCmdOptions) operatorNamespace(runConfig *config.RunConfig) string {
+	if !runConfig.Config.Operator.Global {
+		return runConfig.Config.Namespace.Name
+	}
+
+	if runConfig.Config.Operator.Namespace != "" {
+		return runConfig.Config.Operator.Namespace
+	}
+
+	return o.Namespace
+}
+
+// verifyGlobalPermissions makes sure the current user is allowed to create the cluster-scoped resources of a global operator
+func (o *runCmdOptions) verifyGlobalPermissions(c client.Client) error {
+	for _, resource := range []string{"clusterroles", "clusterrolebindings"} {
+		if ok, err := kubernetes.CheckPermission(o.Context, c, rbacv1.GroupName, resource, "", "", "create"); err != nil {
+			return err
+		} else if !ok {
+			return fmt.Errorf("installing a global operator requires permissions to create %s - "+
+				"please login as cluster-admin or run without the global operator option", resource)
+		}
+	}
+
+	return nil
+}
+
+// verifyWatchNamespaces makes sure the additional watched namespaces exist and that the current user is allowed to grant
+// the operator access to them
+func (o *runCmdOptions) verifyWatchNamespaces(c client.Client, namespaces []string) error {
+	for _, namespace := range namespaces {
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return fmt.Errorf("invalid operator watch namespace '%s': %s", namespace, strings.Join(errs, ", "))
+		}
+
+		if _, err := c.CoreV1().Namespaces().Get(o.Context, namespace, metav1.GetOptions{}); err != nil {
+			return fmt.Errorf("failed to verify operator watch namespace '%s': %v", namespace, err)
+		}
+
+		for _, resource := range []string{"roles", "rolebindings"} {
+			if ok, err := kubernetes.CheckPermission(o.Context, c, rbacv1.GroupName, resource, namespace, "", "create"); err != nil {
+				return err
+			} else if !ok {
+				return fmt.Errorf("watching namespace '%s' requires permissions to create %s in that namespace", namespace, resource)
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadRemoteRole fetches the role definition from given URL, the content is cached for the duration of the run
+func (o *runCmdOptions) loadRemoteRole(url string) (string, error) {
+	if data, ok := o.remoteRoles[url]; ok {
+		return data, nil
+	}
+
+	data, err := loadData(url)
+	if err != nil {
+		return "", fmt.Errorf("failed to load operator role: %v", err)
+	}
+
+	if o.remoteRoles == nil {
+		o.remoteRoles = make(map[string]string)
+	}
+	o.remoteRoles[url] = data
+
+	return data, nil
+}
+
+// operatorConfiguration creates the configuration of the operator installed into the test namespace
+func (o *runCmdOptions) operatorConfiguration(runConfig *config.RunConfig, c client.Client) (install.OperatorConfiguration, error) {
+	var cluster v1alpha1.ClusterType
+	if isOpenshift, err := openshift.IsOpenShift(c); err != nil {
+		return install.OperatorConfiguration{}, err
+	} else if isOpenshift {
+		cluster = v1alpha1.ClusterTypeOpenShift
+	} else {
+		cluster = v1alpha1.ClusterTypeKubernetes
+	}
+
+	return install.OperatorConfiguration{
+		CustomImage:           runConfig.Config.Operator.Image,
+		CustomImagePullPolicy: runConfig.Config.Operator.ImagePullPolicy,
+		Resources:             runConfig.Config.Operator.Resources,
+		Namespace:             o.operatorNamespace(runConfig),
+		Global:                runConfig.Config.Operator.Global,
+		ClusterType:           string(cluster),
+		WatchNamespaces:       runConfig.Config.Operator.WatchNamespaces,
+	}, nil
+}
+
+// newTest creates the test custom resource for given test file and run configuration
+func (o *runCmdOptions) newTest(rawName string, runConfig *config.RunConfig) (*v1alpha1.Test, error) {
 	namespace := runConfig.Config.Namespace.Name
-	fileName := kubernetes.SanitizeFileName(rawName)
-	name := kubernetes.SanitizeName(rawName)
+	fileName := featureFileName(runConfig, kubernetes.SanitizeFileName(rawName))
+	name := o.testName(rawName)
 
 	if name == "" {
 		return nil, errors.New("unable to determine test name")
 	}
 
-	data, err := loadData(rawName)
-	if err != nil {
-		return nil, err
+	var data string
+	if rawName == StdinSource {
+		// there is no file name to derive the source name from
+		fileName = name + FileSuffix
+		data = o.stdinSource
+	} else {
+		var err error
+		if data, err = loadData(rawName); err != nil {
+			return nil, err
+		}
 	}
 
 	test := v1alpha1.Test{
@@ -438,278 +1345,1332 @@ func (o *runCmdOptions) createAndRunTest(cmd *cobra.Command, c client.Client, ra
 			return nil, err
 		}
 
-		test.Spec.Resources = append(test.Spec.Resources, v1alpha1.ResourceSpec{
-			Name:    path.Base(resource),
-			Content: data,
-		})
+		o.addResource(&test, newResource(path.Base(resource), data))
 	}
 
-	for _, resource := range o.Resources {
-		data, err := loadData(resolvePath(runConfig, resource))
+	resources, err := expandResources(runConfig, "resource", o.Resources)
+	if err != nil {
+		return nil, err
+	}
+	for _, resource := range resources {
+		data, err := loadData(resource)
 		if err != nil {
 			return nil, err
 		}
 
-		test.Spec.Resources = append(test.Spec.Resources, v1alpha1.ResourceSpec{
-			Name:    path.Base(resource),
-			Content: data,
-		})
+		o.addResource(&test, newResource(path.Base(resource), data))
+	}
+
+	configMapRefs, err := parseResourceRefs(v1alpha1.ResourceRefKindConfigMap, o.ConfigMapRefs)
+	if err != nil {
+		return nil, err
+	}
+	secretRefs, err := parseResourceRefs(v1alpha1.ResourceRefKindSecret, o.SecretRefs)
+	if err != nil {
+		return nil, err
+	}
+	if len(configMapRefs)+len(secretRefs) > 0 {
+		test.Spec.ResourceRefs = append(configMapRefs, secretRefs...)
 	}
 
-	for _, propertyFile := range o.PropertyFiles {
-		data, err := loadData(resolvePath(runConfig, propertyFile))
+	propertyFiles, err := expandResources(runConfig, "property file", o.PropertyFiles)
+	if err != nil {
+		return nil, err
+	}
+	for _, propertyFile := range propertyFiles {
+		data, err := loadData(propertyFile)
 		if err != nil {
 			return nil, err
 		}
-
-		test.Spec.Resources = append(test.Spec.Resources, v1alpha1.ResourceSpec{
-			Name:    path.Base(propertyFile),
-			Content: data,
-		})
+
+		o.addResource(&test, newResource(path.Base(propertyFile), data))
+	}
+
+	if settings, err := o.newSettings(runConfig); err != nil {
+		return nil, err
+	} else if settings != nil {
+		test.Spec.Settings = *settings
+	}
+
+	if err := o.setupEnvSettings(&test, runConfig); err != nil {
+		return nil, err
+	}
+
+	if servers, err := o.mavenServers(runConfig); err != nil {
+		return nil, err
+	} else if len(servers) > 0 {
+		test.Spec.Maven = v1alpha1.MavenSpec{
+			Servers: servers,
+		}
+	}
+
+	if runConfig.Config.Runtime.Secret != "" {
+		test.Spec.Secret = runConfig.Config.Runtime.Secret
+	}
+
+	if selenium := runConfig.Config.Runtime.Selenium; selenium.Image != "" {
+		if selenium.ReadyTimeout != "" {
+			if timeout, err := time.ParseDuration(selenium.ReadyTimeout); err != nil || timeout <= 0 {
+				return nil, fmt.Errorf("invalid selenium ready timeout '%s', expected a positive duration such as '2m'", selenium.ReadyTimeout)
+			}
+		}
+
+		test.Spec.Selenium = v1alpha1.SeleniumSpec{
+			Image:        selenium.Image,
+			ReadyTimeout: selenium.ReadyTimeout,
+		}
+	}
+
+	if o.runID != "" {
+		test.Labels = map[string]string{
+			v1alpha1.TestRunIdLabel: o.runID,
+		}
+	}
+
+	if testContainers := runConfig.Config.Runtime.TestContainers; testContainers.Enabled {
+		image := testContainers.Image
+		if image == "" {
+			image = config.DefaultKubeDockImage
+		} else if err := validateImage(image); err != nil {
+			return nil, fmt.Errorf("invalid kubedock image: %v", err)
+		}
+
+		test.Spec.KubeDock = v1alpha1.KubeDockSpec{
+			Image: image,
+			Args:  testContainers.Args,
+		}
+	}
+
+	return &test, nil
+}
+
+func (o *runCmdOptions) createAndRunTest(cmd *cobra.Command, c client.Client, rawName string, runConfig *config.RunConfig) (*v1alpha1.Test, error) {
+	namespace := runConfig.Config.Namespace.Name
+	test, err := o.newTest(rawName, runConfig)
+	if err != nil {
+		return nil, err
+	}
+	name := test.Name
+	stampTest(test)
+
+	if !o.SkipValidation && test.Spec.Source.Language == v1alpha1.LanguageGherkin {
+		if err := validateGherkin(test.Spec.Source.Content); err != nil {
+			return nil, &testSourceError{fmt.Errorf("invalid feature file '%s' - %v", rawName, err)}
+		}
+	}
+
+	if err := o.verifyTestCRD(c); err != nil {
+		return nil, err
+	}
+
+	var resources *corev1.ConfigMap
+	// resourcesOwned is set once the test owns the resources ConfigMap, before that the ConfigMap is removed on failure
+	var resourcesOwned bool
+	if size := inlinedSize(test); size > inlineResourcesLimit && len(test.Spec.Resources) > 0 {
+		if resources, err = o.externalizeResources(c, test); err != nil {
+			return nil, err
+		}
+		defer func() {
+			if resourcesOwned {
+				return
+			}
+			if err := c.Delete(o.RootContext, resources); err != nil && !k8serrors.IsNotFound(err) {
+				o.out.Errorf("WARN: Failed to delete ConfigMap '%s': %s", resources.Name, err.Error())
+			}
+		}()
+		o.out.Errorf("WARN: Test '%s' exceeds %d KB of inlined resources - moved resources to ConfigMap '%s'",
+			name, inlineResourcesLimit/1024, resources.Name)
+	}
+
+	var timeout string
+	if o.Timeout != "" {
+		timeout = o.Timeout
+	} else if runConfig.Config.Timeout != "" {
+		timeout = runConfig.Config.Timeout
+	} else {
+		timeout = config.DefaultTimeout
+	}
+
+	waitTimeout, parseErr := time.ParseDuration(timeout)
+	if parseErr != nil {
+		o.out.Errorf("Failed to parse test timeout setting - %s", parseErr.Error())
+		waitTimeout, _ = time.ParseDuration(config.DefaultTimeout)
+	}
+
+	var events []string
+	started := time.Now()
+
+	// show progress on interactive terminals when there is no log output to follow
+	var spinner *progress
+	if o.Wait && !o.Logs && !o.Quiet && color.IsTerminal(cmd.OutOrStdout()) {
+		spinner = newProgress(cmd.OutOrStdout(), name)
+	}
+
+	options := run.Options{
+		Test:      test,
+		Recreate:  o.Force,
+		Wait:      o.Wait,
+		Timeout:   waitTimeout,
+		LogsSince: o.logsSince,
+		OnSubmit: func(test *v1alpha1.Test, updated bool) error {
+			o.out.ForTest(test).Debug("Test submitted", "updated", updated)
+
+			if resources != nil {
+				// remove the resources together with the test
+				resources.OwnerReferences = []metav1.OwnerReference{
+					{
+						APIVersion: v1alpha1.SchemeGroupVersion.String(),
+						Kind:       v1alpha1.TestKind,
+						Name:       test.Name,
+						UID:        test.UID,
+					},
+				}
+				if err := c.Update(o.Context, resources); err != nil {
+					return err
+				}
+				resourcesOwned = true
+			}
+
+			if !updated {
+				o.out.Printf("Test '%s' created", name)
+			} else if o.Force {
+				o.out.Printf("Test '%s' recreated", name)
+			} else {
+				o.out.Printf("Test '%s' updated", name)
+			}
+
+			if o.NoCleanup {
+				o.keepResource(v1alpha1.TestKind, namespace, name)
+			}
+
+			if o.PrintName {
+				return printTestRef(cmd.OutOrStdout(), test)
+			}
+			return nil
+		},
+		OnStatus: func(test *v1alpha1.Test) {
+			if spinner != nil {
+				spinner.SetPhase(test.Status.Phase)
+			}
+
+			if test.Status.Phase == v1alpha1.TestPhaseError {
+				// collect warning events of the test pod to help finding the cause of the error
+				events, _ = kubernetes.GetPodWarningEvents(o.Context, c, namespace, v1alpha1.TestLabel+"="+name,
+					started.Add(-eventsLookBack))
+			}
+		},
+	}
+
+	var captured *tailBuffer
+	if (o.Logs || o.LogsDir != "") && o.Wait {
+		writers := make([]io.Writer, 0)
+		if o.Logs {
+			writers = append(writers, cmd.OutOrStdout())
+		}
+
+		if o.OutputLimit > 0 {
+			captured = newTailBuffer(o.OutputLimit)
+			writers = append(writers, captured)
+		}
+
+		if o.LogsDir != "" {
+			logFile, err := o.createLogFile(name)
+			if err != nil {
+				return nil, err
+			}
+			defer logFile.Close()
+			writers = append(writers, logFile)
+		}
+		options.Logs = io.MultiWriter(writers...)
+	}
+
+	var stopSpinner context.CancelFunc
+	spinnerDone := make(chan struct{})
+	if spinner != nil {
+		var spinnerCtx context.Context
+		spinnerCtx, stopSpinner = context.WithCancel(o.Context)
+		go func() {
+			spinner.Run(spinnerCtx)
+			close(spinnerDone)
+		}()
+	}
+
+	test, err = run.Test(o.Context, c, options)
+	if stopSpinner != nil {
+		stopSpinner()
+		<-spinnerDone
+	}
+
+	if test == nil {
+		return nil, err
+	}
+
+	if !o.Wait {
+		o.out.Printf("Test '%s' started", name)
+		return test, nil
+	}
+
+	if o.Context.Err() != nil {
+		o.out.Printf("Test '%s' interrupted", name)
+		if !o.NoCleanup {
+			if err := c.Delete(o.RootContext, test); err != nil && !k8serrors.IsNotFound(err) {
+				o.out.Errorf("WARN: Failed to delete test %s: %s", name, err.Error())
+			}
+		}
+
+		if o.groupTimedOut() {
+			return test, fmt.Errorf("test '%s' has been cancelled due to group timeout", name)
+		}
+		return test, fmt.Errorf("test '%s' has been interrupted", name)
+	}
+
+	o.out.Printf("Test '%s' finished with status: %s", name, colorPhase(test.Status.Phase))
+
+	if captured != nil {
+		test.Status.Results.SystemOut = captured.String()
+	}
+
+	if err != nil {
+		if len(events) > 0 {
+			return test, fmt.Errorf("%v - pod events:\n%s", err, strings.Join(events, "\n"))
+		}
+		return test, err
+	}
+
+	return test, nil
+}
+
+// dump prints the test custom resources for given test source without any interaction with the cluster
+func (o *runCmdOptions) dump(cmd *cobra.Command, source string) error {
+	if isDir(source) {
+		tests, err := o.newTestGroup(source, nil)
+		if err != nil {
+			return err
+		}
+		return o.dumpTests(cmd.OutOrStdout(), tests, true)
+	}
+
+	runConfig, err := o.getRunConfig(source)
+	if err != nil {
+		return err
+	}
+
+	test, err := o.newTest(source, runConfig)
+	if err != nil {
+		return err
+	}
+	return o.dumpTests(cmd.OutOrStdout(), []*v1alpha1.Test{test}, false)
+}
+
+// verifyTestFiles makes sure that the test directory holds at least one test file that is not ignored or deselected
+func (o *runCmdOptions) verifyTestFiles(source string) error {
+	found, err := o.hasTestFiles(source, nil)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		runConfig, err := o.getRunConfig(source)
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("no test files found in directory '%s' - recognized test file extensions are: %s "+
+			"(use --allow-empty to run an empty test directory)", source, strings.Join(runConfig.Config.Runtime.FeatureExtensions, ", "))
+	}
+
+	return nil
+}
+
+func (o *runCmdOptions) hasTestFiles(source string, ignore ignoreRules) (bool, error) {
+	runConfig, err := o.getRunConfig(source)
+	if err != nil {
+		return false, err
+	}
+
+	files, err := ioutil.ReadDir(source)
+	if err != nil {
+		return false, err
+	}
+
+	if ignore, err = loadIgnoreRules(source, ignore); err != nil {
+		return false, err
+	}
+
+	for _, f := range files {
+		name := path.Join(source, f.Name())
+		if ignore.isIgnored(name, f.IsDir()) {
+			continue
+		}
+
+		if f.IsDir() && runConfig.Config.Recursive {
+			if found, err := o.hasTestFiles(name, ignore); err != nil || found {
+				return found, err
+			}
+		} else if isTestFile(runConfig, f.Name()) {
+			if selected, err := o.isSelected(runConfig, name); err != nil || selected {
+				return selected, err
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// newTestGroup creates the test custom resources for all test files in given directory
+func (o *runCmdOptions) newTestGroup(source string, ignore ignoreRules) ([]*v1alpha1.Test, error) {
+	runConfig, err := o.getRunConfig(source)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := ioutil.ReadDir(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if ignore, err = loadIgnoreRules(source, ignore); err != nil {
+		return nil, err
+	}
+	o.orderFiles(files)
+
+	tests := make([]*v1alpha1.Test, 0)
+	for _, f := range files {
+		name := path.Join(source, f.Name())
+		if ignore.isIgnored(name, f.IsDir()) {
+			continue
+		}
+
+		if f.IsDir() && runConfig.Config.Recursive {
+			group, err := o.newTestGroup(name, ignore)
+			if err != nil {
+				return nil, err
+			}
+			tests = append(tests, group...)
+		} else if isTestFile(runConfig, f.Name()) {
+			if selected, err := o.isSelected(runConfig, name); err != nil {
+				return nil, err
+			} else if !selected {
+				continue
+			}
+
+			test, err := o.newTest(name, runConfig)
+			if err != nil {
+				return nil, err
+			}
+			tests = append(tests, test)
+		}
+	}
+
+	return tests, nil
+}
+
+// dumpTests prints the tests in the dump output format. When printing a list multiple tests result in a
+// multi document YAML stream or a JSON array.
+func (o *runCmdOptions) dumpTests(out io.Writer, tests []*v1alpha1.Test, list bool) error {
+	for _, test := range tests {
+		stampTest(test)
+	}
+
+	switch o.DumpFormat {
+	case "yaml":
+		for i, test := range tests {
+			data, err := kubernetes.ToYAML(test)
+			if err != nil {
+				return err
+			}
+
+			if i > 0 {
+				fmt.Fprint(out, "---\n")
+			}
+			fmt.Fprint(out, string(data))
+		}
+	case "json":
+		var data []byte
+		var err error
+		if list {
+			data, err = json.Marshal(tests)
+		} else if len(tests) > 0 {
+			data, err = kubernetes.ToJSON(tests[0])
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, string(data))
+	case "env":
+		for i, test := range tests {
+			if list {
+				if i > 0 {
+					fmt.Fprintln(out)
+				}
+				fmt.Fprintf(out, "# %s\n", test.Name)
+			}
+			for _, e := range resolvedEnv(test) {
+				fmt.Fprintln(out, e)
+			}
+		}
+	default:
+		return fmt.Errorf("invalid dump output format option '%s', should be one of: yaml|json|env", o.DumpFormat)
+	}
+
+	return nil
+}
+
+// stampTest sorts the environment and resources of the test and records the CLI version and the hash of the test source, so
+// the test custom resource is the same for unchanged sources. Environment settings of the same name keep their order.
+func stampTest(test *v1alpha1.Test) {
+	sort.SliceStable(test.Spec.Env, func(i, j int) bool {
+		return envName(test.Spec.Env[i]) < envName(test.Spec.Env[j])
+	})
+	sort.SliceStable(test.Spec.Resources, func(i, j int) bool {
+		return test.Spec.Resources[i].Name < test.Spec.Resources[j].Name
+	})
+
+	if test.Annotations == nil {
+		test.Annotations = make(map[string]string)
+	}
+	test.Annotations[CLIVersionAnnotation] = defaults.Version
+	test.Annotations[SourceHashAnnotation] = fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(test.Spec.Source.Content)))
+}
+
+func envName(env string) string {
+	return strings.SplitN(env, "=", 2)[0]
+}
+
+// resolvedEnv returns the effective test environment as sorted KEY=VALUE lines. When a variable is set multiple
+// times the last setting wins, same as in the test container.
+func resolvedEnv(test *v1alpha1.Test) []string {
+	values := make(map[string]string)
+	for _, e := range test.Spec.Env {
+		pair := strings.SplitN(e, "=", 2)
+		if len(pair) == 2 {
+			values[pair[0]] = pair[1]
+		} else {
+			values[pair[0]] = ""
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	env := make([]string, 0, len(keys))
+	for _, key := range keys {
+		env = append(env, key+"="+values[key])
+	}
+
+	return env
+}
+
+// testRef identifies a test created by the run command
+type testRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+func printTestRef(out io.Writer, test *v1alpha1.Test) error {
+	data, err := json.Marshal(testRef{
+		Name:      test.Name,
+		Namespace: test.Namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(out, string(data))
+	return err
+}
+
+func colorPhase(phase v1alpha1.TestPhase) string {
+	switch phase {
+	case v1alpha1.TestPhasePassed:
+		return color.Green(string(phase))
+	case v1alpha1.TestPhaseFailed:
+		return color.Red(string(phase))
+	case v1alpha1.TestPhaseError:
+		return color.Bold(color.Red(string(phase)))
+	default:
+		return string(phase)
+	}
+}
+
+// pruneTests deletes finished tests in given namespace that have been created by previous runs of the YAKS CLI.
+// Tests without the run id label have not been created by the CLI and are never touched.
+func (o *runCmdOptions) pruneTests(c client.Client, namespace string) error {
+	var ttl time.Duration
+	if o.PruneTTL != "" {
+		var err error
+		if ttl, err = time.ParseDuration(o.PruneTTL); err != nil {
+			return errors.Wrap(err, "invalid prune ttl")
+		}
+	}
+
+	testList := v1alpha1.TestList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       v1alpha1.TestKind,
+		},
+	}
+
+	if err := c.List(o.Context, &testList, ctrl.InNamespace(namespace), ctrl.HasLabels{v1alpha1.TestRunIdLabel}); err != nil {
+		return err
+	}
+
+	for _, test := range testList.Items {
+		test := test // pin
+		if test.Labels[v1alpha1.TestRunIdLabel] == o.runID {
+			continue
+		}
+
+		switch test.Status.Phase {
+		case v1alpha1.TestPhasePassed, v1alpha1.TestPhaseFailed, v1alpha1.TestPhaseError:
+		default:
+			// leave tests that are still running
+			continue
+		}
+
+		if ttl > 0 && time.Since(test.CreationTimestamp.Time) < ttl {
+			continue
+		}
+
+		if err := c.Delete(o.Context, &test); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		o.out.Printf("Pruned test '%s'", test.Name)
+	}
+
+	return nil
+}
+
+// verifyTestCRD makes sure the Test custom resource definition is installed on the cluster
+func (o *runCmdOptions) verifyTestCRD(c client.Client) error {
+	if o.crdVerified {
+		return nil
+	}
+
+	installed, err := kubernetes.IsAPIResourceInstalled(c, v1alpha1.SchemeGroupVersion.String(), v1alpha1.TestKind)
+	if err != nil {
+		return err
+	}
+
+	if !installed {
+		return fmt.Errorf("the %s custom resource definition tests.%s is not installed on the cluster - "+
+			"please run 'yaks install' first", v1alpha1.TestKind, v1alpha1.SchemeGroupVersion.Group)
+	}
+
+	o.crdVerified = true
+	return nil
+}
+
+// isTestFile checks if given file name uses one of the configured feature file extensions
+func isTestFile(runConfig *config.RunConfig, fileName string) bool {
+	return featureExtension(runConfig, fileName) != ""
+}
+
+func featureExtension(runConfig *config.RunConfig, fileName string) string {
+	for _, extension := range runConfig.Config.Runtime.FeatureExtensions {
+		if extension != "" && strings.HasSuffix(fileName, extension) {
+			return extension
+		}
+	}
+
+	return ""
+}
+
+// featureFileName makes sure the test source uses the feature file suffix expected by the test runtime
+func featureFileName(runConfig *config.RunConfig, fileName string) string {
+	if extension := featureExtension(runConfig, fileName); extension != "" && extension != FileSuffix {
+		return strings.TrimSuffix(fileName, extension) + FileSuffix
+	}
+
+	return fileName
+}
+
+// testName resolves the name of the test created for given test file
+func (o *runCmdOptions) testName(rawName string) string {
+	if o.Name != "" {
+		return o.Name
+	}
+
+	return kubernetes.SanitizeName(rawName)
+}
+
+func (o *runCmdOptions) uploadArtifacts(runConfig *config.RunConfig) error {
+	if len(o.Uploads) == 0 {
+		return nil
+	}
+
+	namespace := runConfig.Config.Namespace.Name
+	if len(o.Uploads) > 1 {
+		// concurrent uploads must not install the artifact storage multiple times
+		if err := installSnap(o.Context, o.RootCmdOptions, namespace); err != nil {
+			return err
+		}
+	}
+
+	var lock sync.Mutex
+	dependencies, err := uploadAll(len(o.Uploads), maxParallelUploads, func(i int) (string, error) {
+		lib, _ := splitUpload(o.Uploads[i])
+		artifact := resolvePath(runConfig, lib)
+		return retryUpload(o.Context, artifact, o.uploadTimeout, o.UploadRetries,
+			func(ctx context.Context) (string, error) {
+				return uploadLocalArtifact(ctx, o.RootCmdOptions, artifact, namespace)
+			},
+			func(attempt int, err error, wait time.Duration) {
+				lock.Lock()
+				defer lock.Unlock()
+				o.out.Errorf("WARN: Upload of artifact '%s' failed (attempt %d of %d) - %v, retrying in %s", artifact, attempt, o.UploadRetries+1, err, wait)
+			})
+	})
+	if err != nil {
+		return err
+	}
+
+	o.Dependencies = append(o.Dependencies, dependencies...)
+	return nil
+}
+
+func (o *runCmdOptions) setupEnvSettings(test *v1alpha1.Test, runConfig *config.RunConfig) error {
+	env := make([]string, 0)
+
+	var header featureHeader
+	if test.Spec.Source.Language == v1alpha1.LanguageGherkin {
+		var err error
+		if header, err = parseFeatureHeader(test.Spec.Source.Content); err != nil {
+			return &testSourceError{fmt.Errorf("invalid feature header of '%s' - %v", test.Spec.Source.Name, err)}
+		}
+	}
+
+	env = append(env, NamespaceEnv+"="+runConfig.Config.Namespace.Name)
+
+	tags := o.Tags
+	if tags == nil {
+		tags = runConfig.Config.Runtime.Cucumber.Tags
+	} else if len(runConfig.Config.Runtime.Cucumber.Tags) > 0 {
+		o.warnOverride("--tag", "runtime.cucumber.tags")
+	}
+	if o.TagFile != "" {
+		expression, err := loadTagExpression(resolvePath(runConfig, o.TagFile))
+		if err != nil {
+			return err
+		}
+		tags = append(append([]string{}, tags...), expression)
+	}
+	if len(tags) > 0 {
+		filter, err := tagFilter(tags)
+		if err != nil {
+			return err
+		}
+		env = append(env, CucumberFilterTags+"="+filter)
+	}
+
+	if o.Features != nil {
+		features, err := expandFeatures(runConfig, o.Features)
+		if err != nil {
+			return err
+		}
+		env = append(env, CucumberFeatures+"="+strings.Join(features, ","))
+	}
+
+	glue := o.Glue
+	if glue != nil {
+		if len(runConfig.Config.Runtime.Cucumber.Glue) > 0 {
+			o.warnOverride("--glue", "runtime.cucumber.glue")
+		}
+	} else if len(runConfig.Config.Runtime.Cucumber.Glue) > 0 {
+		glue = runConfig.Config.Runtime.Cucumber.Glue
+	}
+	if extraGlue := append(o.uploadGlue(), header.glue...); len(extraGlue) > 0 {
+		if len(glue) == 0 {
+			// custom glue replaces the default glue of the runtime
+			glue = []string{DefaultGlue}
+		}
+		glue = unique(append(append([]string{}, glue...), extraGlue...))
+	}
+	if glue != nil {
+		env = append(env, CucumberGlue+"="+strings.Join(glue, ","))
+	}
+
+	if len(o.Options) > 0 {
+		if len(runConfig.Config.Runtime.Cucumber.Options) > 0 {
+			o.warnOverride("--options", "runtime.cucumber.options")
+		}
+		env = append(env, CucumberOptions+"="+o.Options)
+	} else if len(runConfig.Config.Runtime.Cucumber.Options) > 0 {
+		env = append(env, CucumberOptions+"="+runConfig.Config.Runtime.Cucumber.Options)
+	}
+
+	repositories, err := validateRepositories(append(append([]string{}, o.Repositories...), header.repositories...))
+	if err != nil {
+		return err
+	}
+	if len(repositories) > 0 {
+		env = append(env, RepositoriesEnv+"="+strings.Join(repositories, ","))
+	}
+
+	dependencies, err := validateDependencies(append(append([]string{}, o.Dependencies...), header.dependencies...))
+	if err != nil {
+		return err
+	}
+	if len(dependencies) > 0 {
+		env = append(env, DependenciesEnv+"="+strings.Join(dependencies, ","))
+	}
+
+	loggers, err := validateLoggers(append(append([]string{}, o.Logger...), header.loggers...))
+	if err != nil {
+		return err
+	}
+	if len(loggers) > 0 {
+		env = append(env, LoggersEnv+"="+strings.Join(loggers, ","))
+	}
+
+	for _, envConfig := range runConfig.Config.Runtime.Env {
+		env = append(env, envConfig.Name+"="+envConfig.Value)
+	}
+
+	if o.Env != nil {
+		for _, e := range o.Env {
+			for _, envConfig := range runConfig.Config.Runtime.Env {
+				if envName(e) == envConfig.Name {
+					o.warnOverride("--env "+envConfig.Name, "runtime.env")
+				}
+			}
+		}
+		env = append(env, o.Env...)
+	}
+
+	for _, e := range env {
+		// loggers set as explicit environment setting are validated, too
+		if value := strings.TrimPrefix(e, LoggersEnv+"="); value != e {
+			if _, err := validateLoggers(strings.Split(value, ",")); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(env) > 0 {
+		test.Spec.Env = env
+	}
+
+	return nil
+}
+
+// warnOverride tells that a command line option takes precedence over a non-empty setting of the run configuration.
+// Each override is only reported once per run. The overridden value is not printed as it may hold a secret.
+func (o *runCmdOptions) warnOverride(option string, setting string) {
+	key := option + "/" + setting
+	if o.overrides[key] {
+		return
+	}
+
+	if o.overrides == nil {
+		o.overrides = make(map[string]bool)
+	}
+	o.overrides[key] = true
+
+	o.out.Errorf("WARN: Option %s overrides config setting %s", option, setting)
+}
+
+// expandFeatures expands feature include patterns such as "features/smoke/*.feature" relative to the base directory.
+// Literal paths are kept unchanged.
+func expandFeatures(runConfig *config.RunConfig, features []string) ([]string, error) {
+	result := make([]string, 0, len(features))
+	for _, feature := range features {
+		if !strings.ContainsAny(feature, "*?[") || isRemoteFile(feature) {
+			result = append(result, feature)
+			continue
+		}
+
+		matches, err := filepath.Glob(resolvePath(runConfig, feature))
+		if err != nil {
+			return nil, fmt.Errorf("invalid feature pattern '%s': %v", feature, err)
+		}
+
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("feature pattern '%s' does not match any file", feature)
+		}
+
+		for _, match := range matches {
+			if !filepath.IsAbs(feature) && runConfig.BaseDir != "" {
+				if rel, err := filepath.Rel(runConfig.BaseDir, match); err == nil {
+					match = rel
+				}
+			}
+			result = append(result, match)
+		}
+	}
+
+	return result, nil
+}
+
+// expandResources resolves the given resource paths and expands glob patterns to the matching files
+func expandResources(runConfig *config.RunConfig, kind string, resources []string) ([]string, error) {
+	result := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		if !strings.ContainsAny(resource, "*?[") || isRemoteFile(resource) {
+			result = append(result, resolvePath(runConfig, resource))
+			continue
+		}
+
+		matches, err := filepath.Glob(resolvePath(runConfig, resource))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s pattern '%s': %v", kind, resource, err)
+		}
+
+		files := 0
+		for _, match := range matches {
+			if info, err := os.Stat(match); err == nil && !info.IsDir() {
+				result = append(result, match)
+				files++
+			}
+		}
+
+		if files == 0 {
+			return nil, fmt.Errorf("%s pattern '%s' does not match any file", kind, resource)
+		}
+	}
+
+	return result, nil
+}
+
+// splitUpload separates the library of given upload from its optional glue packages
+func splitUpload(upload string) (string, []string) {
+	idx := strings.LastIndex(upload, uploadGlueSeparator)
+	if idx < 0 {
+		return upload, nil
+	}
+
+	glue := make([]string, 0)
+	for _, pkg := range strings.Split(upload[idx+len(uploadGlueSeparator):], ",") {
+		if pkg = strings.TrimSpace(pkg); pkg != "" {
+			glue = append(glue, pkg)
+		}
+	}
+	return upload[:idx], glue
+}
+
+// uploadGlue collects the glue packages given with the uploaded libraries
+func (o *runCmdOptions) uploadGlue() []string {
+	glue := make([]string, 0)
+	for _, upload := range o.Uploads {
+		_, pkgs := splitUpload(upload)
+		glue = append(glue, pkgs...)
+	}
+	return glue
+}
+
+// validateGlue checks that all glue entries are Java package names
+func validateGlue(glue []string) error {
+	for _, pkg := range glue {
+		if !gluePattern.MatchString(pkg) {
+			return fmt.Errorf("invalid glue package '%s'", pkg)
+		}
+	}
+	return nil
+}
+
+// validateDependencies checks that all dependencies use Maven coordinates of form groupId:artifactId:version and
+// removes duplicates. The optional "mvn:" prefix is removed. Camel components of form camel:<component> are turned into
+// the Maven coordinates of the component using the Camel version of the runtime.
+func validateDependencies(dependencies []string) ([]string, error) {
+	result := make([]string, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		coordinates := strings.TrimPrefix(strings.TrimSpace(dependency), "mvn:")
+		if match := camelDependencyPattern.FindStringSubmatch(coordinates); match != nil {
+			coordinates = fmt.Sprintf("org.apache.camel:camel-%s:@camel.version@", match[1])
+		}
+		if !dependencyPattern.MatchString(coordinates) {
+			return nil, fmt.Errorf("invalid dependency '%s', must be of format groupId:artifactId:version or camel:component", dependency)
+		}
+		result = append(result, coordinates)
+	}
+
+	return unique(result), nil
+}
+
+// addResource adds the resource to the test, a resource with the same name replaces the previously added resource
+func (o *runCmdOptions) addResource(test *v1alpha1.Test, resource v1alpha1.ResourceSpec) {
+	var conflict bool
+	if test.Spec.Resources, conflict = addResource(test.Spec.Resources, resource); conflict {
+		o.out.Errorf("WARN: Resource '%s' is added multiple times with different content - using the last one", resource.Name)
+	}
+}
+
+// addResource adds or replaces the resource by name and reports if a replaced resource had different content
+func addResource(resources []v1alpha1.ResourceSpec, resource v1alpha1.ResourceSpec) ([]v1alpha1.ResourceSpec, bool) {
+	for i, existing := range resources {
+		if existing.Name == resource.Name {
+			resources[i] = resource
+			return resources, existing != resource
+		}
+	}
+
+	return append(resources, resource), false
+}
+
+// inlinedSize calculates the size of the content inlined into the test
+func inlinedSize(test *v1alpha1.Test) int {
+	size := len(test.Spec.Source.Content) + len(test.Spec.Settings.Content)
+	for _, resource := range test.Spec.Resources {
+		size += len(resource.Name) + len(resource.Content)
+	}
+
+	return size
+}
+
+// externalizeResources moves the inlined resources of the test to a ConfigMap and references the ConfigMap instead
+func (o *runCmdOptions) externalizeResources(c client.Client, test *v1alpha1.Test) (*corev1.ConfigMap, error) {
+	cm := corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: corev1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: test.Namespace,
+			Name:      fmt.Sprintf("test-%s-resources", test.Name),
+			Labels: map[string]string{
+				"app":              "yaks",
+				v1alpha1.TestLabel: test.Name,
+			},
+		},
+		Data: make(map[string]string, len(test.Spec.Resources)),
+	}
+	if o.runID != "" {
+		cm.Labels[v1alpha1.TestRunIdLabel] = o.runID
+	}
+
+	for _, resource := range test.Spec.Resources {
+		if resource.Encoding == v1alpha1.ResourceEncodingBase64 {
+			data, err := base64.StdEncoding.DecodeString(resource.Content)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode binary resource '%s': %v", resource.Name, err)
+			}
+			if cm.BinaryData == nil {
+				cm.BinaryData = make(map[string][]byte)
+			}
+			cm.BinaryData[resource.Name] = data
+			continue
+		}
+		cm.Data[resource.Name] = resource.Content
 	}
 
-	if settings, err := o.newSettings(runConfig); err != nil {
-		return nil, err
-	} else if settings != nil {
-		test.Spec.Settings = *settings
+	if size := configMapSize(&cm); size > configMapLimit {
+		return nil, fmt.Errorf("resources of test '%s' exceed the ConfigMap size limit of %d KB with %d KB - "+
+			"split them into several ConfigMaps and mount them with --resource-from-configmap", test.Name, configMapLimit/1024, size/1024)
 	}
 
-	if err := o.setupEnvSettings(&test, runConfig); err != nil {
-		return nil, err
+	err := c.Create(o.Context, &cm)
+	if err != nil && k8serrors.IsAlreadyExists(err) {
+		existing := corev1.ConfigMap{}
+		if err = c.Get(o.Context, ctrl.ObjectKey{Namespace: cm.Namespace, Name: cm.Name}, &existing); err == nil {
+			existing.Labels = cm.Labels
+			existing.Data = cm.Data
+			existing.BinaryData = cm.BinaryData
+			err = c.Update(o.Context, &existing)
+			cm = existing
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to move resources of test '%s' to ConfigMap '%s': %v", test.Name, cm.Name, err)
 	}
 
-	if runConfig.Config.Runtime.Secret != "" {
-		test.Spec.Secret = runConfig.Config.Runtime.Secret
+	test.Spec.Resources = nil
+	test.Spec.ResourceRefs = append(test.Spec.ResourceRefs, v1alpha1.ResourceRefSpec{
+		Kind: v1alpha1.ResourceRefKindConfigMap,
+		Name: cm.Name,
+	})
+
+	return &cm, nil
+}
+
+// configMapSize calculates the size of the entries of the ConfigMap
+func configMapSize(cm *corev1.ConfigMap) int {
+	size := 0
+	for key, value := range cm.Data {
+		size += len(key) + len(value)
+	}
+	for key, value := range cm.BinaryData {
+		size += len(key) + len(value)
 	}
 
-	if runConfig.Config.Runtime.Selenium.Image != "" {
-		test.Spec.Selenium = v1alpha1.SeleniumSpec{
-			Image: runConfig.Config.Runtime.Selenium.Image,
+	return size
+}
+
+// parseResourceRefs converts references of form name[/key] to ConfigMap or Secret resource references
+func parseResourceRefs(kind string, refs []string) ([]v1alpha1.ResourceRefSpec, error) {
+	result := make([]v1alpha1.ResourceRefSpec, 0, len(refs))
+	for _, ref := range refs {
+		pair := strings.SplitN(strings.TrimSpace(ref), "/", 2)
+		if errs := validation.IsDNS1123Subdomain(pair[0]); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid %s reference '%s', '%s' is not a valid name: %s", kind, ref, pair[0], strings.Join(errs, ", "))
 		}
-	}
 
-	if runConfig.Config.Runtime.TestContainers.Enabled {
-		test.Spec.KubeDock = v1alpha1.KubeDockSpec{
-			Image: "joyrex2001/kubedock:0.7.0",
+		resourceRef := v1alpha1.ResourceRefSpec{
+			Kind: kind,
+			Name: pair[0],
 		}
+		if len(pair) == 2 {
+			if errs := validation.IsConfigMapKey(pair[1]); len(errs) > 0 {
+				return nil, fmt.Errorf("invalid %s reference '%s', '%s' is not a valid key: %s", kind, ref, pair[1], strings.Join(errs, ", "))
+			}
+			resourceRef.Key = pair[1]
+		}
+		result = append(result, resourceRef)
 	}
 
-	switch o.DumpFormat {
-	case "":
-		// continue..
-	case "yaml":
-		data, err := kubernetes.ToYAML(&test)
-		if err != nil {
-			return nil, err
+	return result, nil
+}
+
+// validateRepositories checks that all repositories are of form id=url and removes duplicates
+func validateRepositories(repositories []string) ([]string, error) {
+	result := make([]string, 0, len(repositories))
+	for _, repository := range repositories {
+		repository = strings.TrimSpace(repository)
+		pair := strings.SplitN(repository, "=", 2)
+		if len(pair) != 2 || pair[0] == "" {
+			return nil, fmt.Errorf("invalid repository '%s', must be of format id=url", repository)
 		}
-		fmt.Print(string(data))
-		return nil, nil
 
-	case "json":
-		data, err := kubernetes.ToJSON(&test)
-		if err != nil {
+		u, err := url.Parse(pair[1])
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid repository '%s', '%s' is not a valid URL", pair[0], pair[1])
+		}
+		if err := verifyRepositoryURL(pair[0], u); err != nil {
 			return nil, err
 		}
-		fmt.Print(string(data))
-		return nil, nil
+		result = append(result, repository)
+	}
 
-	default:
-		return nil, fmt.Errorf("invalid dump output format option '%s', should be one of: yaml|json", o.DumpFormat)
+	return unique(result), nil
+}
+
+// verifyRepositoryURL makes sure the repository URL does not hold credentials, the error never prints the password
+func verifyRepositoryURL(id string, u *url.URL) error {
+	if u.User != nil {
+		return fmt.Errorf("invalid repository '%s=%s', credentials must not be part of the repository URL - use the maven-server option to reference a secret holding the credentials", id, u.Redacted())
 	}
 
-	existed := false
-	err = c.Create(o.Context, &test)
-	if err != nil && k8serrors.IsAlreadyExists(err) {
-		existed = true
-		clone := test.DeepCopy()
-		var key ctrl.ObjectKey
-		key = ctrl.ObjectKeyFromObject(clone)
-		err = c.Get(o.Context, key, clone)
-		if err != nil {
-			return nil, err
+	return nil
+}
+
+// mavenServers collects the Maven server credentials references from the configuration and the maven-server option,
+// option entries replace configuration entries with the same id
+func (o *runCmdOptions) mavenServers(runConfig *config.RunConfig) ([]v1alpha1.MavenServerSpec, error) {
+	servers := make([]v1alpha1.MavenServerSpec, 0)
+	index := make(map[string]int)
+	add := func(id string, secret string) error {
+		id = strings.TrimSpace(id)
+		secret = strings.TrimSpace(secret)
+		if id == "" || secret == "" {
+			return fmt.Errorf("invalid Maven server '%s=%s', must be of format id=secret", id, secret)
 		}
-		// Hold the resource from the operator controller
-		clone.Status.Phase = v1alpha1.TestPhaseUpdating
-		err = c.Status().Update(o.Context, clone)
-		if err != nil {
-			return nil, err
+		if errs := validation.IsDNS1123Subdomain(secret); len(errs) > 0 {
+			return fmt.Errorf("invalid Maven server '%s', '%s' is not a valid secret name: %s", id, secret, strings.Join(errs, ", "))
 		}
-		// Update the spec
-		test.ResourceVersion = clone.ResourceVersion
-		err = c.Update(o.Context, &test)
-		if err != nil {
-			return nil, err
+
+		server := v1alpha1.MavenServerSpec{ID: id, Secret: secret}
+		if i, ok := index[id]; ok {
+			servers[i] = server
+		} else {
+			index[id] = len(servers)
+			servers = append(servers, server)
 		}
-		// Reset status
-		test.Status = v1alpha1.TestStatus{}
-		err = c.Status().Update(o.Context, &test)
+		return nil
 	}
 
-	if err != nil {
-		return nil, err
+	for _, server := range runConfig.Config.Runtime.MavenServers {
+		if err := add(server.Id, server.Secret); err != nil {
+			return nil, err
+		}
 	}
 
-	if !existed {
-		fmt.Println(fmt.Sprintf("Test '%s' created", name))
-	} else {
-		fmt.Println(fmt.Sprintf("Test '%s' updated", name))
+	for _, server := range o.MavenServers {
+		pair := strings.SplitN(server, "=", 2)
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("invalid Maven server '%s', must be of format id=secret", server)
+		}
+		if err := add(pair[0], pair[1]); err != nil {
+			return nil, err
+		}
 	}
 
-	ctx, cancel := context.WithCancel(o.Context)
-	var status = v1alpha1.TestPhaseNew
-	go func() {
-		var timeout string
-		if o.Timeout != "" {
-			timeout = o.Timeout
-		} else if runConfig.Config.Timeout != "" {
-			timeout = runConfig.Config.Timeout
-		} else {
-			timeout = config.DefaultTimeout
-		}
+	return servers, nil
+}
 
-		waitTimeout, parseErr := time.ParseDuration(timeout)
-		if parseErr != nil {
-			fmt.Println(fmt.Sprintf("Failed to parse test timeout setting - %s", parseErr.Error()))
-			waitTimeout, _ = time.ParseDuration(config.DefaultTimeout)
+// validateLoggers checks that all loggers are of form name=LEVEL with a log level supported by the runtime
+// and removes duplicates
+func validateLoggers(loggers []string) ([]string, error) {
+	result := make([]string, 0, len(loggers))
+	for _, logger := range loggers {
+		logger = strings.TrimSpace(logger)
+		pair := strings.Split(logger, "=")
+		if len(pair) != 2 || strings.TrimSpace(pair[0]) == "" {
+			return nil, fmt.Errorf("invalid logger '%s', must be of format name=LEVEL", logger)
 		}
 
-		err = kubernetes.WaitCondition(o.Context, c, &test, func(obj interface{}) (bool, error) {
-			if val, ok := obj.(*v1alpha1.Test); ok {
-				if val.Status.Phase != v1alpha1.TestPhaseNone {
-					status = val.Status.Phase
-				}
-
-				if val.Status.Phase == v1alpha1.TestPhaseDeleting ||
-					val.Status.Phase == v1alpha1.TestPhaseError ||
-					val.Status.Phase == v1alpha1.TestPhasePassed ||
-					val.Status.Phase == v1alpha1.TestPhaseFailed {
-					return true, nil
-				}
-			}
-			return false, nil
-		}, waitTimeout)
+		if !loggerLevels[strings.ToUpper(strings.TrimSpace(pair[1]))] {
+			return nil, fmt.Errorf("invalid logger '%s', unsupported log level '%s' - should be one of: TRACE|DEBUG|INFO|WARN|ERROR|FATAL|OFF|ALL", logger, pair[1])
+		}
+		result = append(result, logger)
+	}
 
-		cancel()
-	}()
+	return unique(result), nil
+}
 
-	if o.Logs && o.Wait {
-		if err := k8slog.Print(ctx, c, namespace, name, cmd.OutOrStdout()); err != nil {
-			return nil, err
+// unique removes duplicate entries keeping the order of first occurrence
+func unique(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			result = append(result, value)
 		}
 	}
 
-	if o.Wait {
-		// Let's add a Wait point, otherwise the script terminates
-		<-ctx.Done()
+	return result
+}
 
-		fmt.Println(fmt.Sprintf("Test '%s' finished with status: %s", name, string(status)))
-	} else {
-		fmt.Println(fmt.Sprintf("Test '%s' started", name))
+func (o *runCmdOptions) newSettings(runConfig *config.RunConfig) (*v1alpha1.SettingsSpec, error) {
+	runtimeSettings := runConfig.Config.Runtime.Settings
+	hasRuntimeSettings := len(runtimeSettings.Dependencies) > 0 ||
+		len(runtimeSettings.Repositories) > 0 ||
+		len(runtimeSettings.Loggers) > 0
+
+	for _, repository := range runtimeSettings.Repositories {
+		if u, err := url.Parse(repository.Url); err == nil {
+			if err := verifyRepositoryURL(repository.Id, u); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	return &test, status.AsError(name)
-}
+	if o.Settings != "" {
+		rawName := o.Settings
+		configData, err := loadData(resolvePath(runConfig, rawName))
 
-func (o *runCmdOptions) uploadArtifacts(runConfig *config.RunConfig) error {
-	for _, lib := range o.Uploads {
-		additionalDep, err := uploadLocalArtifact(o.RootCmdOptions, resolvePath(runConfig, lib), runConfig.Config.Namespace.Name)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		o.Dependencies = append(o.Dependencies, additionalDep)
-	}
-	return nil
-}
 
-func (o *runCmdOptions) setupEnvSettings(test *v1alpha1.Test, runConfig *config.RunConfig) error {
-	env := make([]string, 0)
+		name := kubernetes.SanitizeFileName(rawName)
+		if ext := strings.ToLower(path.Ext(name)); ext != ".json" && ext != ".yaml" && ext != ".yml" &&
+			strings.HasPrefix(strings.TrimSpace(configData), "{") {
+			// the runtime selects the settings parser by file extension
+			name += ".json"
+		}
 
-	env = append(env, NamespaceEnv+"="+runConfig.Config.Namespace.Name)
+		if !hasRuntimeSettings {
+			settings := v1alpha1.SettingsSpec{
+				Name:    name,
+				Content: configData,
+			}
 
-	if o.Tags != nil {
-		env = append(env, CucumberFilterTags+"="+strings.Join(o.Tags, ","))
-	} else if len(runConfig.Config.Runtime.Cucumber.Tags) > 0 {
-		env = append(env, CucumberFilterTags+"="+strings.Join(runConfig.Config.Runtime.Cucumber.Tags, ","))
-	}
+			return &settings, nil
+		}
 
-	if o.Features != nil {
-		env = append(env, CucumberFeatures+"="+strings.Join(o.Features, ","))
-	}
+		// merge settings file with the runtime settings from the configuration, settings file entries win
+		fileSettings := config.SettingsConfig{}
+		format := runtimeSettings.Format
+		if strings.HasSuffix(name, ".json") {
+			err = json.Unmarshal([]byte(configData), &fileSettings)
+			if format == "" {
+				format = "json"
+			}
+		} else {
+			err = yaml.Unmarshal([]byte(configData), &fileSettings)
+		}
 
-	if o.Glue != nil {
-		env = append(env, CucumberGlue+"="+strings.Join(o.Glue, ","))
-	} else if len(runConfig.Config.Runtime.Cucumber.Glue) > 0 {
-		env = append(env, CucumberGlue+"="+strings.Join(runConfig.Config.Runtime.Cucumber.Glue, ","))
-	}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse settings file '%s': %v", rawName, err)
+		}
 
-	if len(o.Options) > 0 {
-		env = append(env, CucumberOptions+"="+o.Options)
-	} else if len(runConfig.Config.Runtime.Cucumber.Options) > 0 {
-		env = append(env, CucumberOptions+"="+runConfig.Config.Runtime.Cucumber.Options)
+		return marshalSettings(mergeSettings(runtimeSettings, fileSettings), format)
 	}
 
-	if len(o.Repositories) > 0 {
-		env = append(env, RepositoriesEnv+"="+strings.Join(o.Repositories, ","))
+	if hasRuntimeSettings {
+		return marshalSettings(runtimeSettings, runtimeSettings.Format)
 	}
 
-	if len(o.Dependencies) > 0 {
-		env = append(env, DependenciesEnv+"="+strings.Join(o.Dependencies, ","))
-	}
+	return nil, nil
+}
 
-	if len(o.Logger) > 0 {
-		env = append(env, LoggersEnv+"="+strings.Join(o.Logger, ","))
-	}
+// marshalSettings creates the runtime settings in given format
+func marshalSettings(runtimeSettings config.SettingsConfig, format string) (*v1alpha1.SettingsSpec, error) {
+	runtimeSettings.Format = ""
+	configData, err := yaml.Marshal(runtimeSettings)
 
-	for _, envConfig := range runConfig.Config.Runtime.Env {
-		env = append(env, envConfig.Name+"="+envConfig.Value)
+	if err != nil {
+		return nil, err
 	}
 
-	if o.Env != nil {
-		env = append(env, o.Env...)
+	settings := v1alpha1.SettingsSpec{
+		Name:    "yaks.settings.yaml",
+		Content: string(configData),
 	}
 
-	if len(env) > 0 {
-		test.Spec.Env = env
+	switch format {
+	case "", "yaml":
+	case "json":
+		if configData, err = kubernetes.YAMLToJSON(configData); err != nil {
+			return nil, err
+		}
+
+		settings.Name = "yaks.settings.json"
+		settings.Content = string(configData)
+	default:
+		return nil, fmt.Errorf("unsupported runtime settings format '%s', should be one of: yaml|json", format)
 	}
 
-	return nil
+	return &settings, nil
 }
 
-func (o *runCmdOptions) newSettings(runConfig *config.RunConfig) (*v1alpha1.SettingsSpec, error) {
-	if o.Settings != "" {
-		rawName := o.Settings
-		configData, err := loadData(resolvePath(runConfig, rawName))
+// mergeSettings unites the given runtime settings. Entries of the overrides replace entries of the base settings
+// with the same repository id, dependency group and artifact id or logger name.
+func mergeSettings(base config.SettingsConfig, overrides config.SettingsConfig) config.SettingsConfig {
+	merged := config.SettingsConfig{
+		Format: base.Format,
+	}
 
-		if err != nil {
-			return nil, err
+	repositories := map[string]int{}
+	for _, repository := range append(base.Repositories, overrides.Repositories...) {
+		key := repository.Id
+		if key == "" {
+			key = repository.Url
 		}
 
-		settings := v1alpha1.SettingsSpec{
-			Name:    kubernetes.SanitizeFileName(rawName),
-			Content: configData,
+		if i, ok := repositories[key]; ok {
+			merged.Repositories[i] = repository
+		} else {
+			repositories[key] = len(merged.Repositories)
+			merged.Repositories = append(merged.Repositories, repository)
 		}
-
-		return &settings, nil
 	}
 
-	if len(runConfig.Config.Runtime.Settings.Dependencies) > 0 ||
-		len(runConfig.Config.Runtime.Settings.Repositories) > 0 ||
-		len(runConfig.Config.Runtime.Settings.Loggers) > 0 {
-		configData, err := yaml.Marshal(runConfig.Config.Runtime.Settings)
-
-		if err != nil {
-			return nil, err
+	dependencies := map[string]int{}
+	for _, dependency := range append(base.Dependencies, overrides.Dependencies...) {
+		key := dependency.GroupId + ":" + dependency.ArtifactId
+		if i, ok := dependencies[key]; ok {
+			merged.Dependencies[i] = dependency
+		} else {
+			dependencies[key] = len(merged.Dependencies)
+			merged.Dependencies = append(merged.Dependencies, dependency)
 		}
+	}
 
-		settings := v1alpha1.SettingsSpec{
-			Name:    "yaks.settings.yaml",
-			Content: string(configData),
+	loggers := map[string]int{}
+	for _, logger := range append(base.Loggers, overrides.Loggers...) {
+		if i, ok := loggers[logger.Name]; ok {
+			merged.Loggers[i] = logger
+		} else {
+			loggers[logger.Name] = len(merged.Loggers)
+			merged.Loggers = append(merged.Loggers, logger)
 		}
-
-		return &settings, nil
 	}
 
-	return nil, nil
+	return merged
 }
 
 func (o *runCmdOptions) findInstance(c client.Client, namespace string) (*v1alpha1.Instance, error) {
@@ -744,28 +2705,46 @@ func (o *runCmdOptions) listInstances(c client.Client) (v1alpha1.InstanceList, e
 	return instanceList, err
 }
 
-func runSteps(steps []config.StepConfig, namespace, baseDir string) error {
+// runSteps runs the given steps with the shell interpreter. Steps may override the shell, an empty shell selects the
+// default interpreter of the operating system.
+func runSteps(steps []config.StepConfig, namespace, baseDir, shell, testName string, out *output) error {
 	for idx, step := range steps {
 		if len(step.Name) == 0 {
 			step.Name = fmt.Sprintf("step-%d", idx)
 		}
 
+		out.Debug("Processing step", "step", step.Name, "namespace", namespace)
+
 		if skipStep(step) {
-			fmt.Printf("Skip %s\n", step.Name)
+			out.Printf("Skip %s", step.Name)
 			continue
 		}
 
+		stepShell := shell
+		if step.Shell != "" {
+			stepShell = step.Shell
+		}
+
 		if len(step.Script) > 0 {
 			desc := step.Name
 			if desc == "" {
 				desc = fmt.Sprintf("script %s", step.Script)
 			}
-			if err := runScript(step.Script, desc, namespace, baseDir, step.Timeout); err != nil {
+			script, err := renderStep(step.Script, namespace, testName, step.Template)
+			if err != nil {
+				return fmt.Errorf("failed to render script of %s: %v", desc, err)
+			}
+			if err := runScript(script, desc, namespace, baseDir, stepShell, step.Timeout, out); err != nil {
 				return fmt.Errorf(fmt.Sprintf("Failed to run %s: %v", desc, err))
 			}
 		}
 
 		if len(step.Run) > 0 {
+			command, err := renderStep(step.Run, namespace, testName, step.Template)
+			if err != nil {
+				return fmt.Errorf("failed to render command of %s: %v", step.Name, err)
+			}
+
 			// Let's save it to a bash script to allow for multiline scripts
 			file, err := ioutil.TempFile("", "yaks-script-*.sh")
 			if err != nil {
@@ -778,7 +2757,7 @@ func runSteps(steps []config.StepConfig, namespace, baseDir string) error {
 				return err
 			}
 
-			_, err = file.WriteString(step.Run)
+			_, err = file.WriteString(command)
 			if err != nil {
 				return err
 			}
@@ -796,7 +2775,7 @@ func runSteps(steps []config.StepConfig, namespace, baseDir string) error {
 			if desc == "" {
 				desc = fmt.Sprintf("inline command %d", idx)
 			}
-			if err := runScript(file.Name(), desc, namespace, baseDir, step.Timeout); err != nil {
+			if err := runScript(file.Name(), desc, namespace, baseDir, stepShell, step.Timeout, out); err != nil {
 				return fmt.Errorf(fmt.Sprintf("Failed to run %s: %v", desc, err))
 			}
 		}
@@ -846,7 +2825,7 @@ func skipStep(step config.StepConfig) bool {
 	return false
 }
 
-func runScript(scriptFile, desc, namespace, baseDir, timeout string) error {
+func runScript(scriptFile, desc, namespace, baseDir, shell, timeout string, out *output) error {
 	if timeout == "" {
 		timeout = config.DefaultTimeout
 	}
@@ -854,14 +2833,13 @@ func runScript(scriptFile, desc, namespace, baseDir, timeout string) error {
 	if err != nil {
 		return err
 	}
+	executor, err := lookupShell(shell)
+	if err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), actualTimeout)
 	defer cancel()
-	var executor string
-	if r.GOOS == "windows" {
-		executor = "powershell.exe"
-	} else {
-		executor = "/bin/bash"
-	}
 
 	command := exec.CommandContext(ctx, executor, resolve(scriptFile))
 
@@ -870,28 +2848,104 @@ func runScript(scriptFile, desc, namespace, baseDir, timeout string) error {
 
 	command.Dir = baseDir
 
-	command.Stderr = os.Stderr
-	command.Stdout = os.Stdout
+	out.Printf("Running %s:", desc)
+	if out.rawSteps {
+		command.Stderr = os.Stderr
+		command.Stdout = os.Stdout
+		err = command.Run()
+	} else {
+		stdout, stderr := out.stepWriters(desc)
+		command.Stdout = stdout
+		command.Stderr = stderr
+		err = command.Run()
+		stdout.Flush()
+		stderr.Flush()
+	}
 
-	fmt.Println(fmt.Sprintf("Running %s:", desc))
-	if err := command.Run(); err != nil {
-		fmt.Println(fmt.Sprintf("Failed to run %s: \n%v", desc, err))
+	if err != nil {
+		out.Errorf("Failed to run %s: \n%v", desc, err)
 		return err
 	}
 	return nil
 }
 
+// lookupShell resolves the interpreter used to run step scripts. Custom interpreters must be available on the PATH.
+func lookupShell(shell string) (string, error) {
+	if shell == "" {
+		if r.GOOS == "windows" {
+			return "powershell.exe", nil
+		}
+		return "/bin/bash", nil
+	}
+
+	executor, err := exec.LookPath(shell)
+	if err != nil {
+		return "", fmt.Errorf("unable to find shell '%s' - make sure the interpreter is installed and available on the PATH", shell)
+	}
+
+	return executor, nil
+}
+
 func resolve(fileName string) string {
 	resolved := strings.ReplaceAll(fileName, "{{os.type}}", r.GOOS)
 	resolved = strings.ReplaceAll(resolved, "{{os.arch}}", r.GOARCH)
 	return resolved
 }
 
-func initializeTempNamespace(name string, c client.Client, context context.Context) (metav1.Object, error) {
+// resolveVariables replaces the os, namespace and test name placeholders in given step script or command.
+// The test name placeholder is left untouched when steps do not belong to a single test.
+func resolveVariables(value, namespace, testName string) string {
+	resolved := resolve(value)
+	resolved = strings.ReplaceAll(resolved, "{{namespace}}", namespace)
+	// group steps have no test name, replace the placeholder anyway so it never reaches the step template
+	resolved = strings.ReplaceAll(resolved, "{{test.name}}", testName)
+	return resolved
+}
+
+// stepContext is the data available in step templates
+type stepContext struct {
+	Namespace string
+	TestName  string
+	OS        string
+	Arch      string
+}
+
+// renderStep resolves the placeholders in given step script or command. Steps that opt in to templating are rendered
+// as Go template afterwards, all other steps keep literal curly braces.
+func renderStep(value, namespace, testName string, templated bool) (string, error) {
+	resolved := resolveVariables(value, namespace, testName)
+	if !templated || !strings.Contains(resolved, "{{") {
+		return resolved, nil
+	}
+
+	tmpl, err := template.New("step").
+		Option("missingkey=error").
+		Funcs(template.FuncMap{"env": os.Getenv}).
+		Parse(resolved)
+	if err != nil {
+		return "", err
+	}
+
+	var rendered strings.Builder
+	err = tmpl.Execute(&rendered, stepContext{
+		Namespace: namespace,
+		TestName:  testName,
+		OS:        r.GOOS,
+		Arch:      r.GOARCH,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return rendered.String(), nil
+}
+
+func initializeTempNamespace(name string, labels map[string]string, c client.Client, context context.Context, out *output) (metav1.Object, error) {
 	var obj ctrl.Object
 
-	if oc, err := openshift.IsOpenShift(c); err != nil {
-		panic(err)
+	oc, err := openshift.IsOpenShift(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create test namespace %s - unable to detect OpenShift cluster: %v", name, err)
 	} else if oc {
 		obj = &projectv1.ProjectRequest{
 			TypeMeta: metav1.TypeMeta{
@@ -909,18 +2963,156 @@ func initializeTempNamespace(name string, c client.Client, context context.Conte
 				Kind:       "Namespace",
 			},
 			ObjectMeta: metav1.ObjectMeta{
-				Name: name,
+				Name:   name,
+				Labels: labels,
 			},
 		}
 	}
-	fmt.Println(fmt.Sprintf("Creating new test namespace %s", name))
-	err := c.Create(context, obj)
-	return obj.(metav1.Object), err
+	out.Printf("Creating new test namespace %s", name)
+	if err = c.Create(context, obj); err != nil {
+		return obj.(metav1.Object), err
+	}
+
+	if oc && len(labels) > 0 {
+		// project requests do not support labels, so label the resulting namespace afterwards
+		if err := labelNamespace(context, c, name, labels); err != nil {
+			out.Errorf("WARN: Failed to label namespace %s: %s", name, err.Error())
+		}
+	}
+
+	return obj.(metav1.Object), nil
+}
+
+// waitForNamespace waits until the namespace is active and its default service account has been created
+func waitForNamespace(ctx context.Context, c client.Client, name string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	ns := corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+	}
+	err := kubernetes.WaitCondition(ctx, c, &ns, func(obj interface{}) (bool, error) {
+		if val, ok := obj.(*corev1.Namespace); ok {
+			return val.Status.Phase == corev1.NamespaceActive, nil
+		}
+		return false, nil
+	}, time.Until(deadline))
+	if err != nil {
+		return fmt.Errorf("namespace %s is not active after %s: %v", name, timeout, err)
+	}
+
+	sa := corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: name,
+			Name:      "default",
+		},
+	}
+	err = kubernetes.WaitCondition(ctx, c, &sa, func(obj interface{}) (bool, error) {
+		return true, nil
+	}, time.Until(deadline))
+	if err != nil {
+		return fmt.Errorf("default service account in namespace %s not available after %s: %v", name, timeout, err)
+	}
+
+	return nil
+}
+
+func labelNamespace(ctx context.Context, c client.Client, name string, labels map[string]string) error {
+	ns, err := c.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if ns.Labels == nil {
+		ns.Labels = map[string]string{}
+	}
+	for k, v := range labels {
+		ns.Labels[k] = v
+	}
+
+	_, err = c.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+	return err
+}
+
+// runCleanupSteps runs the given post or afterEach steps unless cleanup has been disabled
+func (o *runCmdOptions) runCleanupSteps(steps []config.StepConfig, runConfig *config.RunConfig, testName string) {
+	if o.NoCleanup {
+		if len(steps) > 0 {
+			o.out.Printf("Skipped %d cleanup step(s) of %s because of --no-cleanup", len(steps), runConfig.BaseDir)
+		}
+		return
+	}
+
+	_ = runSteps(steps, runConfig.Config.Namespace.Name, runConfig.BaseDir, runConfig.Config.Runtime.ShellPath, testName, o.out)
+}
+
+// createLogFile creates the file holding the logs of given test in the logs directory. Tests in different directories
+// may share a name, so the file name gets a numeric suffix when the name has been used before in this run.
+func (o *runCmdOptions) createLogFile(testName string) (*os.File, error) {
+	if err := os.MkdirAll(o.LogsDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create logs directory %s: %v", o.LogsDir, err)
+	}
+
+	fileName := path.Join(o.LogsDir, testName+".log")
+	for i := 2; util.StringSliceExists(o.logFiles, fileName); i++ {
+		fileName = path.Join(o.LogsDir, fmt.Sprintf("%s-%d.log", testName, i))
+	}
+	file, err := os.Create(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create log file %s: %v", fileName, err)
+	}
+
+	o.logFiles = append(o.logFiles, fileName)
+	return file, nil
+}
+
+func (o *runCmdOptions) printLogFiles() {
+	if len(o.logFiles) == 0 {
+		return
+	}
+
+	o.out.Printf("Test logs have been saved to %s:", o.LogsDir)
+	for _, logFile := range o.logFiles {
+		o.out.Println("  " + logFile)
+	}
 }
 
-func deleteTempNamespace(ns metav1.Object, c client.Client, context context.Context) {
+// keepResource records a resource that is left in place because of the no-cleanup option
+func (o *runCmdOptions) keepResource(kind string, namespace string, name string) {
+	command := fmt.Sprintf("kubectl delete %s %s", strings.ToLower(kind), name)
+	if namespace != "" {
+		command += " -n " + namespace
+	}
+
+	for _, leftover := range o.leftovers {
+		if leftover == command {
+			return
+		}
+	}
+	o.leftovers = append(o.leftovers, command)
+}
+
+func (o *runCmdOptions) printLeftovers() {
+	if len(o.leftovers) == 0 {
+		return
+	}
+
+	o.out.Println("Resources have been left in place because of --no-cleanup, remove them manually with:")
+	for _, leftover := range o.leftovers {
+		o.out.Println("  " + leftover)
+	}
+}
+
+func deleteTempNamespace(ns metav1.Object, watchNamespaces []string, c client.Client, context context.Context, out *output) {
+	// an operator in the temporary namespace may have been granted access to other namespaces
+	if err := install.DeleteWatchNamespaceRoles(context, c, ns.GetName(), watchNamespaces); err != nil {
+		out.Errorf("WARN: Failed to remove operator roles of namespace %s from watched namespaces: %v", ns.GetName(), err)
+	}
+
 	if oc, err := openshift.IsOpenShift(c); err != nil {
-		panic(err)
+		out.Errorf("WARN: Failed to AutoRemove namespace %s - unable to detect OpenShift cluster: %v", ns.GetName(), err)
+		return
 	} else if oc {
 		prj := &projectv1.Project{
 			TypeMeta: metav1.TypeMeta{
@@ -932,12 +3124,12 @@ func deleteTempNamespace(ns metav1.Object, c client.Client, context context.Cont
 			},
 		}
 		if err = c.Delete(context, prj); err != nil {
-			fmt.Fprintf(os.Stderr, "WARN: Failed to AutoRemove namespace %s\n", ns.GetName())
+			out.Errorf("WARN: Failed to AutoRemove namespace %s", ns.GetName())
 		}
 	} else {
 		if err = c.Delete(context, ns.(ctrl.Object)); err != nil {
-			fmt.Fprintf(os.Stderr, "WARN: Failed to AutoRemove namespace %s\n", ns.GetName())
+			out.Errorf("WARN: Failed to AutoRemove namespace %s", ns.GetName())
 		}
 	}
-	fmt.Println(fmt.Sprintf("AutoRemove namespace %s", ns.GetName()))
+	out.Printf("AutoRemove namespace %s", ns.GetName())
 }
@@ -0,0 +1,198 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
+	"github.com/citrusframework/yaks/pkg/cmd/config"
+	"github.com/citrusframework/yaks/pkg/util/kubernetes"
+	"github.com/spf13/cobra"
+	k8errors "k8s.io/apimachinery/pkg/api/errors"
+	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newCmdStatus(rootCmdOptions *RootCmdOptions) (*cobra.Command, *statusCmdOptions) {
+	options := statusCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "status [test]",
+		Short:   "Print the status of given test",
+		Long:    `Print the phase and the results of given test.`,
+		Args:    options.validateArgs,
+		PreRunE: decode(&options),
+		RunE:    options.run,
+	}
+
+	cmd.Flags().StringP("output", "o", "", "Output format. One of: json|yaml. If not set the status is printed as table")
+	cmd.Flags().BoolP("watch", "w", false, "Wait for the test to complete before printing the status")
+	cmd.Flags().String("timeout", "", "Time to wait for the test to complete when watching the test")
+
+	return &cmd, &options
+}
+
+type statusCmdOptions struct {
+	*RootCmdOptions
+	Output  string `mapstructure:"output"`
+	Watch   bool   `mapstructure:"watch"`
+	Timeout string `mapstructure:"timeout"`
+}
+
+// testStatus is the printable status of a test
+type testStatus struct {
+	Name      string             `json:"name"`
+	Namespace string             `json:"namespace"`
+	Phase     v1alpha1.TestPhase `json:"phase"`
+	Results   v1alpha1.TestSuite `json:"results"`
+	Errors    string             `json:"errors,omitempty"`
+}
+
+func (o *statusCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return errors.New("status expects a test name as argument")
+	}
+
+	return nil
+}
+
+func (o *statusCmdOptions) run(cmd *cobra.Command, args []string) error {
+	switch o.Output {
+	case "", "json", "yaml":
+	default:
+		return fmt.Errorf("invalid output format option '%s', should be one of: json|yaml", o.Output)
+	}
+
+	c, err := o.GetCmdClient()
+	if err != nil {
+		return err
+	}
+
+	key := k8sclient.ObjectKey{
+		Namespace: o.Namespace,
+		Name:      kubernetes.SanitizeName(args[0]),
+	}
+
+	test := v1alpha1.Test{}
+	if err := c.Get(o.Context, key, &test); err != nil {
+		if k8errors.IsNotFound(err) {
+			return fmt.Errorf("test '%s' not found in namespace %s", key.Name, key.Namespace)
+		}
+		return err
+	}
+
+	if o.Watch && !isFinished(test.Status.Phase) {
+		timeout := config.DefaultTimeout
+		if o.Timeout != "" {
+			timeout = o.Timeout
+		}
+
+		waitTimeout, err := time.ParseDuration(timeout)
+		if err != nil {
+			return fmt.Errorf("failed to parse timeout setting - %s", err.Error())
+		}
+
+		err = kubernetes.WaitCondition(o.Context, c, &test, func(obj interface{}) (bool, error) {
+			if val, ok := obj.(*v1alpha1.Test); ok {
+				return isFinished(val.Status.Phase), nil
+			}
+			return false, nil
+		}, waitTimeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	return printTestStatus(cmd.OutOrStdout(), &test, o.Output)
+}
+
+// isFinished checks if the test has reached a terminal phase
+func isFinished(phase v1alpha1.TestPhase) bool {
+	return phase == v1alpha1.TestPhaseDeleting ||
+		phase == v1alpha1.TestPhaseError ||
+		phase == v1alpha1.TestPhasePassed ||
+		phase == v1alpha1.TestPhaseFailed
+}
+
+func printTestStatus(out io.Writer, test *v1alpha1.Test, format string) error {
+	status := testStatus{
+		Name:      test.Name,
+		Namespace: test.Namespace,
+		Phase:     test.Status.Phase,
+		Results:   test.Status.Results,
+		Errors:    test.Status.Errors,
+	}
+
+	switch format {
+	case "json", "yaml":
+		data, err := json.Marshal(status)
+		if err != nil {
+			return err
+		}
+
+		if format == "yaml" {
+			if data, err = kubernetes.JSONToYAML(data); err != nil {
+				return err
+			}
+		}
+
+		fmt.Fprintln(out, string(data))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "NAME\tPHASE\tTOTAL\tPASSED\tFAILED\tSKIPPED\tERRORS")
+	fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\n", status.Name, string(status.Phase),
+		status.Results.Summary.Total,
+		status.Results.Summary.Passed,
+		status.Results.Summary.Failed,
+		status.Results.Summary.Skipped,
+		status.Results.Summary.Errors)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(status.Results.Tests) > 0 {
+		fmt.Fprintln(out)
+		w = tabwriter.NewWriter(out, 0, 8, 1, '\t', 0)
+		fmt.Fprintln(w, "TEST\tRESULT")
+		for _, result := range status.Results.Tests {
+			outcome := "Passed"
+			if result.ErrorMessage != "" {
+				outcome = fmt.Sprintf("Failed (%s)", result.ErrorMessage)
+			}
+			fmt.Fprintf(w, "%s\t%s\n", result.ClassName, outcome)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if status.Errors != "" {
+		fmt.Fprintf(out, "\nErrors: %s\n", status.Errors)
+	}
+
+	return nil
+}
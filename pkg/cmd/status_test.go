@@ -0,0 +1,52 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
+	"gotest.tools/v3/assert"
+)
+
+func TestPrintTestStatus(t *testing.T) {
+	test := v1alpha1.Test{}
+	test.Name = "hello"
+	test.Namespace = "default"
+	test.Status.Phase = v1alpha1.TestPhaseFailed
+	test.Status.Results.Summary.Total = 1
+	test.Status.Results.Summary.Failed = 1
+	test.Status.Results.Tests = []v1alpha1.TestResult{
+		{Name: "hello.feature:3", ClassName: "hello.feature:3", ErrorMessage: "boom"},
+	}
+
+	var out bytes.Buffer
+	assert.NilError(t, printTestStatus(&out, &test, ""))
+	assert.Assert(t, strings.Contains(out.String(), "hello\tFailed"))
+	assert.Assert(t, strings.Contains(out.String(), "Failed (boom)"))
+
+	out.Reset()
+	assert.NilError(t, printTestStatus(&out, &test, "json"))
+	assert.Assert(t, strings.Contains(out.String(), `"phase":"Failed"`))
+
+	out.Reset()
+	assert.NilError(t, printTestStatus(&out, &test, "yaml"))
+	assert.Assert(t, strings.Contains(out.String(), "phase: Failed"))
+}
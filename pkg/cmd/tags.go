@@ -0,0 +1,218 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// tagFilter creates the Cucumber tag filter from given tag options. A single option may hold a full Cucumber tag
+// expression such as "@smoke and not @wip". Multiple simple tags are joined with commas which selects scenarios
+// having all tags, multiple options holding tag expressions are combined with "and".
+func tagFilter(tags []string) (string, error) {
+	simple := true
+	for _, tag := range tags {
+		if err := validateTagExpression(tag); err != nil {
+			return "", err
+		}
+
+		if !isSimpleTag(tag) {
+			simple = false
+		}
+	}
+
+	if len(tags) == 1 || simple {
+		return strings.Join(tags, ","), nil
+	}
+
+	expressions := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if isSimpleTag(tag) {
+			expressions = append(expressions, strings.TrimSpace(tag))
+		} else {
+			expressions = append(expressions, "("+strings.TrimSpace(tag)+")")
+		}
+	}
+
+	return strings.Join(expressions, " and "), nil
+}
+
+// loadTagExpression reads a tag expression from given file, the expression may span multiple lines
+func loadTagExpression(file string) (string, error) {
+	content, err := loadData(file)
+	if err != nil {
+		return "", err
+	}
+
+	expression := strings.Join(strings.Fields(content), " ")
+	if expression == "" {
+		return "", fmt.Errorf("tag expression file '%s' is empty", file)
+	}
+
+	if err := validateTagExpression(expression); err != nil {
+		return "", fmt.Errorf("tag expression file '%s': %v", file, err)
+	}
+
+	return expression, nil
+}
+
+func isSimpleTag(tag string) bool {
+	tokens, err := tokenizeTagExpression(tag)
+	return err == nil && len(tokens) == 1 && strings.HasPrefix(tokens[0], "@")
+}
+
+// validateTagExpression checks the syntax of given Cucumber tag expression
+func validateTagExpression(expression string) error {
+	tokens, err := tokenizeTagExpression(expression)
+	if err != nil {
+		return fmt.Errorf("invalid tag expression '%s': %v", expression, err)
+	}
+
+	if len(tokens) == 0 {
+		return fmt.Errorf("invalid tag expression '%s': expression is empty", expression)
+	}
+
+	p := tagParser{tokens: tokens}
+	if err := p.parseOr(); err != nil {
+		return fmt.Errorf("invalid tag expression '%s': %v", expression, err)
+	}
+
+	if p.pos < len(p.tokens) {
+		return fmt.Errorf("invalid tag expression '%s': unexpected '%s'", expression, p.tokens[p.pos])
+	}
+
+	return nil
+}
+
+func tokenizeTagExpression(expression string) ([]string, error) {
+	tokens := make([]string, 0)
+	var token strings.Builder
+	escaped := false
+
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	for _, c := range expression {
+		switch {
+		case escaped:
+			token.WriteRune(c)
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '(' || c == ')':
+			flush()
+			tokens = append(tokens, string(c))
+		case unicode.IsSpace(c):
+			flush()
+		default:
+			token.WriteRune(c)
+		}
+	}
+
+	if escaped {
+		return nil, fmt.Errorf("illegal escape at end of expression")
+	}
+	flush()
+
+	return tokens, nil
+}
+
+// tagParser is a recursive descent parser for Cucumber tag expressions
+type tagParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *tagParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *tagParser) parseOr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+
+	for p.peek() == "or" {
+		p.pos++
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *tagParser) parseAnd() error {
+	if err := p.parseNot(); err != nil {
+		return err
+	}
+
+	for p.peek() == "and" {
+		p.pos++
+		if err := p.parseNot(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *tagParser) parseNot() error {
+	if p.peek() == "not" {
+		p.pos++
+		return p.parseNot()
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *tagParser) parsePrimary() error {
+	token := p.peek()
+	switch token {
+	case "":
+		return fmt.Errorf("unexpected end of expression")
+	case "(":
+		p.pos++
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+		if p.peek() != ")" {
+			return fmt.Errorf("missing closing parenthesis")
+		}
+		p.pos++
+		return nil
+	case ")", "and", "or":
+		return fmt.Errorf("unexpected '%s'", token)
+	}
+
+	if !strings.HasPrefix(token, "@") {
+		return fmt.Errorf("tag '%s' must start with '@'", token)
+	}
+
+	p.pos++
+	return nil
+}
@@ -144,7 +144,7 @@ func (o *uninstallCmdOptions) uninstall(cmd *cobra.Command, _ []string) error {
 	namespaces = append(namespaces, o.Namespace)
 
 	for _, namespace := range namespaces {
-		if err = o.uninstallNamespaceResources(o.Context, c, namespace); err != nil {
+		if err = o.uninstallNamespaceResources(o.Context, c, cmd.OutOrStdout(), namespace); err != nil {
 			return err
 		}
 
@@ -266,9 +266,9 @@ func (o *uninstallCmdOptions) uninstallNamespaceRoles(ctx context.Context, c cli
 	return nil
 }
 
-func (o *uninstallCmdOptions) uninstallNamespaceResources(ctx context.Context, c client.Client, namespace string) error {
+func (o *uninstallCmdOptions) uninstallNamespaceResources(ctx context.Context, c client.Client, writer io.Writer, namespace string) error {
 	if !o.SkipTests {
-		if err := o.uninstallTests(ctx, c, namespace); err != nil {
+		if err := o.uninstallTests(ctx, c, writer, namespace); err != nil {
 			return err
 		}
 		fmt.Printf("YAKS Tests removed from namespace %s\n", namespace)
@@ -413,8 +413,8 @@ func (o *uninstallCmdOptions) uninstallServiceAccounts(ctx context.Context, c cl
 	return nil
 }
 
-func (o *uninstallCmdOptions) uninstallTests(ctx context.Context, c client.Client, namespace string) error {
-	return deleteAllTests(ctx, c, namespace, o.Verbose)
+func (o *uninstallCmdOptions) uninstallTests(ctx context.Context, c client.Client, writer io.Writer, namespace string) error {
+	return deleteAllTests(ctx, c, writer, namespace, o.Verbose)
 }
 
 func (o *uninstallCmdOptions) uninstallConfigMaps(ctx context.Context, c client.Client, namespace string) error {
@@ -440,8 +440,8 @@ func (o *uninstallCmdOptions) uninstallConfigMaps(ctx context.Context, c client.
 }
 
 func createActionNotAuthorizedError() error {
-	fmt.Println("Current user is not authorized to remove cluster-wide objects like custom resource definitions or cluster roles")
-	msg := `login as cluster-admin and execute "yaks uninstall" or use flags "--skip-crd --skip-cluster-roles --skip-cluster-role-bindings"`
+	msg := "current user is not authorized to remove cluster-wide objects like custom resource definitions or cluster roles - " +
+		`login as cluster-admin and execute "yaks uninstall" or use flags "--skip-crd --skip-cluster-roles --skip-cluster-role-bindings"`
 	return errors.New(msg)
 }
 
@@ -18,10 +18,13 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
+	"sync"
+	"time"
 
-	"github.com/citrusframework/yaks/pkg/client"
 	snap "github.com/container-tools/snap/pkg/api"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -63,7 +66,7 @@ func (o *uploadCmdOptions) validateArgs(_ *cobra.Command, args []string) error {
 }
 
 func (o *uploadCmdOptions) run(cmd *cobra.Command, args []string) error {
-	artifact, err := uploadLocalArtifact(o.RootCmdOptions, args[0], o.Namespace)
+	artifact, err := uploadLocalArtifact(o.Context, o.RootCmdOptions, args[0], o.Namespace)
 	if err != nil {
 		return err
 	}
@@ -75,19 +78,129 @@ func (o *uploadCmdOptions) run(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func uploadLocalArtifact(opts *RootCmdOptions, path string, namespace string) (string, error) {
-	config, err := client.GetOutOfClusterConfig(opts.KubeConfig)
+func uploadLocalArtifact(ctx context.Context, opts *RootCmdOptions, path string, namespace string) (string, error) {
+	s3, err := newSnap(opts, namespace)
 	if err != nil {
 		return "", err
 	}
+	return s3.Deploy(ctx, path)
+}
+
+// installSnap makes sure the artifact storage is installed in given namespace
+func installSnap(ctx context.Context, opts *RootCmdOptions, namespace string) error {
+	s3, err := newSnap(opts, namespace)
+	if err != nil {
+		return err
+	}
+	return s3.Install(ctx)
+}
+
+func newSnap(opts *RootCmdOptions, namespace string) (*snap.Snap, error) {
+	c, err := opts.GetCmdClient()
+	if err != nil {
+		return nil, err
+	}
+	config := c.GetConfig()
 
 	bucket := "yaks"
 	options := snap.SnapOptions{
 		Bucket: bucket,
 	}
-	s3, err := snap.NewSnap(config, namespace, false, options)
-	if err != nil {
-		return "", err
+	return snap.NewSnap(config, namespace, false, options)
+}
+
+// uploadAll runs given number of uploads with at most the given number of workers at the same time. The results keep the
+// order of the uploads. After the first failure no more uploads are started, the errors are returned once all started uploads
+// have finished.
+func uploadAll(count int, workers int, upload func(i int) (string, error)) ([]string, error) {
+	results := make([]string, count)
+	errs := make([]error, count)
+
+	var lock sync.Mutex
+	failed := false
+	hasFailed := func() bool {
+		lock.Lock()
+		defer lock.Unlock()
+		return failed
+	}
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, workers)
+	for i := 0; i < count; i++ {
+		slots <- struct{}{}
+		if hasFailed() {
+			<-slots
+			break
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			if results[i], errs[i] = upload(i); errs[i] != nil {
+				lock.Lock()
+				failed = true
+				lock.Unlock()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	messages := make([]string, 0)
+	for _, err := range errs {
+		if err != nil {
+			messages = append(messages, err.Error())
+		}
+	}
+	if len(messages) > 0 {
+		return nil, errors.New(strings.Join(messages, "; "))
+	}
+
+	return results, nil
+}
+
+// uploadBackoff is the wait time before the first retry of a failed upload, the wait time doubles with each retry
+var uploadBackoff = 2 * time.Second
+
+const maxUploadBackoff = 30 * time.Second
+
+// retryUpload runs given upload and retries failed attempts with exponential backoff. Each attempt is bound by the timeout
+// unless the timeout is zero. Retries stop as soon as the parent context is done.
+func retryUpload(ctx context.Context, path string, timeout time.Duration, retries int,
+	upload func(ctx context.Context) (string, error), onRetry func(attempt int, err error, wait time.Duration)) (string, error) {
+	wait := uploadBackoff
+	for attempt := 1; ; attempt++ {
+		attemptCtx := ctx
+		var cancel context.CancelFunc
+		if timeout > 0 {
+			attemptCtx, cancel = context.WithTimeout(ctx, timeout)
+		}
+		artifact, err := upload(attemptCtx)
+		if cancel != nil {
+			cancel()
+		}
+		if err == nil {
+			return artifact, nil
+		}
+
+		if attemptCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+			err = fmt.Errorf("upload timed out after %s", timeout)
+		}
+		if attempt > retries || ctx.Err() != nil {
+			return "", fmt.Errorf("failed to upload artifact '%s' after %d attempt(s): %v", path, attempt, err)
+		}
+
+		if onRetry != nil {
+			onRetry(attempt, err, wait)
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return "", fmt.Errorf("failed to upload artifact '%s' after %d attempt(s): %v", path, attempt, ctx.Err())
+		}
+		if wait *= 2; wait > maxUploadBackoff {
+			wait = maxUploadBackoff
+		}
 	}
-	return s3.Deploy(opts.Context, path)
 }
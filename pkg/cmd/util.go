@@ -19,6 +19,8 @@ package cmd
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/base64"
 	"encoding/csv"
 	"fmt"
 	"io/ioutil"
@@ -28,7 +30,9 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
 	"github.com/citrusframework/yaks/pkg/cmd/config"
@@ -46,8 +50,24 @@ import (
 const (
 	OperatorWatchNamespaceEnv = "WATCH_NAMESPACE"
 	offlineCommandLabel       = "yaks.citrusframework.org/cmd.offline"
+	// CLIVersionAnnotation records the version of the CLI that created the test
+	CLIVersionAnnotation = "yaks.citrusframework.org/cli-version"
+	// SourceHashAnnotation records the content hash of the test source
+	SourceHashAnnotation = "yaks.citrusframework.org/source-hash"
 )
 
+// imageReference matches container image references such as "registry.example.com:5000/org/image:1.0" with optional digest
+var imageReference = regexp.MustCompile(`^(?:[a-zA-Z0-9.-]+(?::[0-9]+)?/)?[a-z0-9]+(?:[._-]+[a-z0-9]+)*(?:/[a-z0-9]+(?:[._-]+[a-z0-9]+)*)*(?::\w[\w.-]{0,127})?(?:@sha256:[a-f0-9]{64})?$`)
+
+// validateImage checks the format of given container image reference
+func validateImage(image string) error {
+	if !imageReference.MatchString(image) {
+		return fmt.Errorf("'%s' is not a valid image reference, expected [registry/]name[:tag][@digest]", image)
+	}
+
+	return nil
+}
+
 func bindPFlagsHierarchy(cmd *cobra.Command) error {
 	for _, c := range cmd.Commands() {
 		if err := bindPFlags(c); err != nil {
@@ -185,7 +205,16 @@ func cmdOnly(cmd *cobra.Command, options interface{}) *cobra.Command {
 }
 
 func isOfflineCommand(cmd *cobra.Command) bool {
-	return cmd.Annotations[offlineCommandLabel] == "true"
+	if cmd.Annotations[offlineCommandLabel] == "true" {
+		return true
+	}
+
+	// commands dumping resources instead of creating them do not need cluster access
+	if dump := cmd.Flags().Lookup("dump"); dump != nil && dump.Value.String() != "" {
+		return true
+	}
+
+	return false
 }
 
 func isRemoteFile(fileName string) bool {
@@ -225,6 +254,55 @@ func resolvePath(runConfig *config.RunConfig, resource string) string {
 	return path.Join(runConfig.BaseDir, resource)
 }
 
+const (
+	// ExitCodeTestFailure signals that the tests have been run and some of them failed
+	ExitCodeTestFailure = 1
+	// ExitCodeError signals that tests could not be run, e.g. because the cluster is not reachable
+	ExitCodeError = 2
+)
+
+// ExitError terminates the command with a specific exit code
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// testSourceError marks errors caused by the test sources, e.g. an invalid feature file. Such tests are reported as
+// failures instead of tests that could not be run, because running them again does not help.
+type testSourceError struct {
+	err error
+}
+
+func (e *testSourceError) Error() string {
+	return e.err.Error()
+}
+
+func (e *testSourceError) Unwrap() error {
+	return e.err
+}
+
+// exitCode tells whether the tests could not be run or whether some of them failed, errors preventing a test from
+// running take precedence over test failures
+func exitCode(results *v1alpha1.TestResults, setupFailed bool) int {
+	if setupFailed {
+		return ExitCodeError
+	}
+
+	if hasErrors(results) {
+		return ExitCodeTestFailure
+	}
+
+	return 0
+}
+
 func hasErrors(results *v1alpha1.TestResults) bool {
 	for _, suite := range results.Suites {
 		if len(suite.Errors) > 0 || suite.Summary.Errors > 0 || suite.Summary.Failed > 0 {
@@ -235,18 +313,36 @@ func hasErrors(results *v1alpha1.TestResults) bool {
 	return false
 }
 
+// remoteClient fetches remote files, it honors the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment settings
+var remoteClient = newHTTPClient(false)
+
+// newHTTPClient creates a client for remote files, optionally skipping the verification of server certificates
+func newHTTPClient(insecureSkipTLSVerify bool) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	if insecureSkipTLSVerify {
+		/* #nosec */
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	return &http.Client{Transport: transport}
+}
+
 func loadData(fileName string) (string, error) {
 	var content []byte
 	var err error
 
 	if isRemoteFile(fileName) {
-		/* #nosec */
-		resp, err := http.Get(fileName)
+		resp, err := remoteClient.Get(fileName)
 		if err != nil {
 			return "", err
 		}
 		defer resp.Body.Close()
 
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("failed to fetch %s: %s", fileName, resp.Status)
+		}
+
 		content, err = ioutil.ReadAll(resp.Body)
 		if err != nil {
 			return "", err
@@ -261,6 +357,27 @@ func loadData(fileName string) (string, error) {
 	return string(content), nil
 }
 
+// newResource creates a test resource from given content, binary content is base64 encoded
+func newResource(name string, content string) v1alpha1.ResourceSpec {
+	if isBinary(content) {
+		return v1alpha1.ResourceSpec{
+			Name:     name,
+			Content:  base64.StdEncoding.EncodeToString([]byte(content)),
+			Encoding: v1alpha1.ResourceEncodingBase64,
+		}
+	}
+
+	return v1alpha1.ResourceSpec{
+		Name:    name,
+		Content: content,
+	}
+}
+
+// isBinary treats content that is not valid UTF-8 or holds NUL characters as binary
+func isBinary(content string) bool {
+	return !utf8.ValidString(content) || strings.ContainsRune(content, 0)
+}
+
 func hasServiceAccount(ctx context.Context, c ctrl.Client, namespace string, serviceAccount string) (bool, error) {
 	sa := corev1.ServiceAccount{}
 	saKey := ctrl.ObjectKey{
@@ -19,27 +19,177 @@ package cmd
 
 import (
 	"fmt"
+	"io"
+	"strings"
 
+	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
+	"github.com/citrusframework/yaks/pkg/client"
 	"github.com/citrusframework/yaks/pkg/util/defaults"
 	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // VersionVariant may be overridden at build time
 var VersionVariant = ""
 
-func newCmdVersion() *cobra.Command {
-	return &cobra.Command{
-		Use:   "version",
-		Short: "Display version information",
-		Run: func(_ *cobra.Command, _ []string) {
-			if VersionVariant != "" {
-				fmt.Printf("YAKS %s %s\n", VersionVariant, defaults.Version)
-			} else {
-				fmt.Printf("YAKS %s\n", defaults.Version)
-			}
-		},
+func newCmdVersion(rootCmdOptions *RootCmdOptions) (*cobra.Command, *versionCmdOptions) {
+	options := versionCmdOptions{
+		RootCmdOptions: rootCmdOptions,
+	}
+
+	cmd := cobra.Command{
+		Use:     "version",
+		Short:   "Display version information",
+		Long:    `Display the version of the CLI. With --server also the versions of the operators and the Test custom resource definition installed on the cluster are displayed.`,
+		PreRunE: decode(&options),
+		RunE:    options.run,
 		Annotations: map[string]string{
 			offlineCommandLabel: "true",
 		},
 	}
-}
\ No newline at end of file
+
+	cmd.Flags().Bool("server", false, "Also print the versions of the operators and the Test custom resource definition installed on the cluster")
+
+	return &cmd, &options
+}
+
+type versionCmdOptions struct {
+	*RootCmdOptions
+	Server bool `mapstructure:"server"`
+}
+
+func (o *versionCmdOptions) run(cmd *cobra.Command, _ []string) error {
+	out := cmd.OutOrStdout()
+	if VersionVariant != "" {
+		fmt.Fprintf(out, "YAKS %s %s\n", VersionVariant, defaults.Version)
+	} else {
+		fmt.Fprintf(out, "YAKS %s\n", defaults.Version)
+	}
+
+	if !o.Server {
+		return nil
+	}
+
+	// the server versions are informational, an unreachable cluster does not fail the command
+	c, err := o.GetCmdClient()
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "WARN: Unable to get server versions - %v\n", err)
+		return nil
+	}
+
+	o.printOperatorVersions(c, out, cmd.ErrOrStderr())
+	o.printCRDVersion(c, out, cmd.ErrOrStderr())
+	return nil
+}
+
+// printOperatorVersions prints version and image of the operators registered by a YAKS instance
+func (o *versionCmdOptions) printOperatorVersions(c client.Client, out io.Writer, errOut io.Writer) {
+	instances := v1alpha1.InstanceList{}
+	err := c.List(o.Context, &instances)
+	if k8serrors.IsForbidden(err) {
+		// fall back to the current namespace when not allowed to list instances cluster wide
+		var namespace string
+		if namespace, err = o.currentNamespace(c); err == nil {
+			err = c.List(o.Context, &instances, ctrl.InNamespace(namespace))
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(errOut, "WARN: Unable to get operator versions - %v\n", err)
+		return
+	}
+
+	if len(instances.Items) == 0 {
+		fmt.Fprintln(out, "Operator: not installed")
+		return
+	}
+
+	for _, instance := range instances.Items {
+		mode := "namespaced"
+		if instance.Spec.Operator.Global {
+			mode = "global"
+		}
+
+		image := "unknown"
+		deployments := appsv1.DeploymentList{}
+		if err := c.List(o.Context, &deployments, ctrl.InNamespace(instance.Namespace), ctrl.MatchingLabels{"yaks.citrusframework.org/component": "operator"}); err == nil {
+			if images := operatorImages(deployments); len(images) > 0 {
+				image = strings.Join(images, ", ")
+			}
+		}
+
+		fmt.Fprintf(out, "Operator: %s (%s in namespace %s, image %s)\n", instance.Status.Version, mode, instance.Namespace, image)
+		if !compatibleVersions(defaults.Version, instance.Status.Version) {
+			fmt.Fprintf(errOut, "WARN: CLI version %s does not match operator version %s in namespace %s\n",
+				defaults.Version, instance.Status.Version, instance.Namespace)
+		}
+	}
+}
+
+// printCRDVersion prints the versions of the Test custom resource definition
+func (o *versionCmdOptions) printCRDVersion(c client.Client, out io.Writer, errOut io.Writer) {
+	if err := apiextensionsv1.AddToScheme(c.GetScheme()); err != nil {
+		fmt.Fprintf(errOut, "WARN: Unable to get CRD version - %v\n", err)
+		return
+	}
+
+	name := "tests." + v1alpha1.SchemeGroupVersion.Group
+	crd := apiextensionsv1.CustomResourceDefinition{}
+	if err := c.Get(o.Context, ctrl.ObjectKey{Name: name}, &crd); k8serrors.IsNotFound(err) {
+		fmt.Fprintf(out, "CRD %s: not installed\n", name)
+		return
+	} else if err != nil {
+		fmt.Fprintf(errOut, "WARN: Unable to get CRD version - %v\n", err)
+		return
+	}
+
+	fmt.Fprintf(out, "CRD %s: stored versions %s\n", name, strings.Join(crd.Status.StoredVersions, ", "))
+	if !servesVersion(crd, v1alpha1.SchemeGroupVersion.Version) {
+		fmt.Fprintf(errOut, "WARN: CRD %s does not serve version %s used by the CLI - please update the cluster setup with 'yaks install --cluster-setup'\n",
+			name, v1alpha1.SchemeGroupVersion.Version)
+	}
+}
+
+func (o *versionCmdOptions) currentNamespace(c client.Client) (string, error) {
+	if o.Namespace != "" {
+		return o.Namespace, nil
+	}
+
+	return c.GetCurrentNamespace(o.KubeConfig)
+}
+
+func operatorImages(deployments appsv1.DeploymentList) []string {
+	images := make([]string, 0)
+	for _, deployment := range deployments.Items {
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			images = append(images, container.Image)
+		}
+	}
+
+	return images
+}
+
+func servesVersion(crd apiextensionsv1.CustomResourceDefinition, version string) bool {
+	for _, v := range crd.Spec.Versions {
+		if v.Name == version && v.Served {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compatibleVersions checks that both versions share the same major and minor version, e.g. "0.7.0-SNAPSHOT" and "0.7.1"
+func compatibleVersions(client string, server string) bool {
+	minor := func(version string) string {
+		parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+		if len(parts) < 2 {
+			return version
+		}
+		return parts[0] + "." + parts[1]
+	}
+
+	return minor(client) == minor(server)
+}
@@ -30,6 +30,8 @@ const (
 	RoleKnative            = "knative"
 	RoleCamelK             = "camelk"
 	RoleStrimzi            = "strimzi"
+
+	DefaultOperatorImagePullPolicy = "IfNotPresent"
 )
 
 func GetTestBaseImage() string {
@@ -43,3 +45,8 @@ func GetTestBaseImage() string {
 func getDefaultTestBaseImage() string {
 	return defaults.ImageName + ":" + defaults.Version
 }
+
+// GetDefaultOperatorImage returns the operator image matching the release version
+func GetDefaultOperatorImage() string {
+	return defaults.ImageName + ":" + defaults.Version
+}
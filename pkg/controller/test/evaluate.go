@@ -86,6 +86,8 @@ func (action *evaluateAction) Handle(ctx context.Context, test *v1alpha1.Test) (
 		return nil, err
 	}
 
+	action.L.ForSuite(&test.Status.Results).Info("Test finished", "phase", test.Status.Phase)
+
 	return test, nil
 }
 
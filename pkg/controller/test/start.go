@@ -19,11 +19,14 @@ package test
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/xml"
 	"fmt"
 	"github.com/citrusframework/yaks/pkg/util/envvar"
 	"github.com/citrusframework/yaks/pkg/util/openshift"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"strings"
+	"time"
 
 	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
 	"github.com/citrusframework/yaks/pkg/config"
@@ -35,9 +38,20 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// MavenServerSettingsFile is the name of the generated Maven settings holding the server credentials
+	MavenServerSettingsFile = "yaks-maven-settings.xml"
+
+	// SeleniumPort is the port of the Selenium server in the test pod
+	SeleniumPort = 4444
+	// SeleniumStatusPath is the status endpoint used to check the Selenium readiness
+	SeleniumStatusPath = "/wd/hub/status"
+)
+
 // NewStartAction creates a new start action
 func NewStartAction() Action {
 	return &startAction{}
@@ -64,7 +78,14 @@ func (action *startAction) Handle(ctx context.Context, test *v1alpha1.Test) (*v1
 		return nil, err
 	}
 
-	configMap := action.newTestConfigMap(ctx, test)
+	action.L.Debug("Starting test", "env", test.Spec.Env)
+
+	configMap, err := action.newTestConfigMap(ctx, test)
+	if err != nil {
+		test.Status.Phase = v1alpha1.TestPhaseError
+		test.Status.Errors = err.Error()
+		return nil, err
+	}
 	job, err := action.newTestJob(ctx, test, configMap)
 	if err != nil {
 		test.Status.Phase = v1alpha1.TestPhaseError
@@ -128,7 +149,7 @@ func (action *startAction) newTestJob(ctx context.Context, test *v1alpha1.Test,
 						{
 							Name:                     "test",
 							Image:                    config.GetTestBaseImage(),
-							Command:                  getMavenArgLine(),
+							Command:                  getMavenArgLine(test),
 							TerminationMessagePolicy: "FallbackToLogsOnError",
 							TerminationMessagePath:   "/dev/termination-log",
 							ImagePullPolicy:          v1.PullIfNotPresent,
@@ -164,10 +185,16 @@ func (action *startAction) newTestJob(ctx context.Context, test *v1alpha1.Test,
 						{
 							Name: "tests",
 							VolumeSource: v1.VolumeSource{
-								ConfigMap: &v1.ConfigMapVolumeSource{
-									LocalObjectReference: v1.LocalObjectReference{
-										Name: configMap.Name,
-									},
+								Projected: &v1.ProjectedVolumeSource{
+									Sources: append([]v1.VolumeProjection{
+										{
+											ConfigMap: &v1.ConfigMapProjection{
+												LocalObjectReference: v1.LocalObjectReference{
+													Name: configMap.Name,
+												},
+											},
+										},
+									}, resourceRefProjections(test)...),
 								},
 							},
 						},
@@ -177,6 +204,11 @@ func (action *startAction) newTestJob(ctx context.Context, test *v1alpha1.Test,
 		},
 	}
 
+	if runID, ok := test.Labels[v1alpha1.TestRunIdLabel]; ok {
+		job.Labels[v1alpha1.TestRunIdLabel] = runID
+		job.Spec.Template.Labels[v1alpha1.TestRunIdLabel] = runID
+	}
+
 	for _, value := range test.Spec.Env {
 		pair := strings.SplitN(value, "=", 2)
 		if len(pair) == 2 {
@@ -225,13 +257,93 @@ func (action *startAction) newTestJob(ctx context.Context, test *v1alpha1.Test,
 		return nil, err
 	}
 
-	action.addSelenium(test, &job)
+	action.addMavenServers(test, &job)
+	if err := action.addSelenium(test, &job); err != nil {
+		return nil, err
+	}
 	action.addKubeDock(test, &job)
+	startSeleniumFirst(test, &job)
 
 	return &job, nil
 }
 
-func getMavenArgLine() []string {
+// resourceRefProjections mounts the entries of referenced ConfigMaps and Secrets next to the test sources
+func resourceRefProjections(test *v1alpha1.Test) []v1.VolumeProjection {
+	projections := make([]v1.VolumeProjection, 0, len(test.Spec.ResourceRefs))
+	for _, ref := range test.Spec.ResourceRefs {
+		var items []v1.KeyToPath
+		if ref.Key != "" {
+			items = []v1.KeyToPath{{Key: ref.Key, Path: ref.Key}}
+		}
+
+		switch ref.Kind {
+		case v1alpha1.ResourceRefKindConfigMap:
+			projections = append(projections, v1.VolumeProjection{
+				ConfigMap: &v1.ConfigMapProjection{
+					LocalObjectReference: v1.LocalObjectReference{Name: ref.Name},
+					Items:                items,
+				},
+			})
+		case v1alpha1.ResourceRefKindSecret:
+			projections = append(projections, v1.VolumeProjection{
+				Secret: &v1.SecretProjection{
+					LocalObjectReference: v1.LocalObjectReference{Name: ref.Name},
+					Items:                items,
+				},
+			})
+		}
+	}
+
+	return projections
+}
+
+// addMavenServers binds the Maven server credentials from the referenced secrets as environment variables so the
+// generated Maven settings never hold credentials in plain text.
+func (action *startAction) addMavenServers(test *v1alpha1.Test, job *batchv1.Job) {
+	for i, server := range test.Spec.Maven.Servers {
+		job.Spec.Template.Spec.Containers[0].Env = append(job.Spec.Template.Spec.Containers[0].Env,
+			mavenServerEnvVar(mavenServerEnv(i, "USERNAME"), server.Secret, "username"),
+			mavenServerEnvVar(mavenServerEnv(i, "PASSWORD"), server.Secret, "password"))
+	}
+}
+
+func mavenServerEnvVar(name string, secret string, key string) v1.EnvVar {
+	return v1.EnvVar{
+		Name: name,
+		ValueFrom: &v1.EnvVarSource{
+			SecretKeyRef: &v1.SecretKeySelector{
+				LocalObjectReference: v1.LocalObjectReference{
+					Name: secret,
+				},
+				Key: key,
+			},
+		},
+	}
+}
+
+func mavenServerEnv(index int, entry string) string {
+	return fmt.Sprintf("YAKS_MAVEN_SERVER_%d_%s", index, entry)
+}
+
+// mavenServerSettings creates Maven global settings declaring the test servers. Credentials are resolved from
+// environment variables at runtime.
+func mavenServerSettings(servers []v1alpha1.MavenServerSpec) string {
+	var settings strings.Builder
+	settings.WriteString("<settings>\n  <servers>\n")
+	for i, server := range servers {
+		settings.WriteString("    <server>\n")
+		settings.WriteString("      <id>")
+		_ = xml.EscapeText(&settings, []byte(server.ID))
+		settings.WriteString("</id>\n")
+		settings.WriteString(fmt.Sprintf("      <username>${env.%s}</username>\n", mavenServerEnv(i, "USERNAME")))
+		settings.WriteString(fmt.Sprintf("      <password>${env.%s}</password>\n", mavenServerEnv(i, "PASSWORD")))
+		settings.WriteString("    </server>\n")
+	}
+	settings.WriteString("  </servers>\n</settings>\n")
+	return settings.String()
+}
+
+func getMavenArgLine(test *v1alpha1.Test) []string {
 	argLine := make([]string, 0)
 
 	// add base flags
@@ -243,6 +355,11 @@ func getMavenArgLine() []string {
 	// add settings file
 	argLine = append(argLine, "-s", "/deployments/artifacts/settings.xml")
 
+	// add global settings holding the Maven server credentials
+	if len(test.Spec.Maven.Servers) > 0 {
+		argLine = append(argLine, "-gs", "/etc/yaks/tests/"+MavenServerSettingsFile)
+	}
+
 	// add test goal
 	argLine = append(argLine, "verify")
 
@@ -252,7 +369,7 @@ func getMavenArgLine() []string {
 	return argLine
 }
 
-func (action *startAction) newTestConfigMap(ctx context.Context, test *v1alpha1.Test) *v1.ConfigMap {
+func (action *startAction) newTestConfigMap(ctx context.Context, test *v1alpha1.Test) (*v1.ConfigMap, error) {
 	controller := true
 	blockOwnerDeletion := true
 
@@ -263,10 +380,23 @@ func (action *startAction) newTestConfigMap(ctx context.Context, test *v1alpha1.
 		sources[test.Spec.Settings.Name] = test.Spec.Settings.Content
 	}
 
+	binaries := make(map[string][]byte)
 	for _, testResource := range test.Spec.Resources {
+		if testResource.Encoding == v1alpha1.ResourceEncodingBase64 {
+			data, err := base64.StdEncoding.DecodeString(testResource.Content)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode binary resource '%s': %v", testResource.Name, err)
+			}
+			binaries[testResource.Name] = data
+			continue
+		}
 		sources[testResource.Name] = testResource.Content
 	}
 
+	if len(test.Spec.Maven.Servers) > 0 {
+		sources[MavenServerSettingsFile] = mavenServerSettings(test.Spec.Maven.Servers)
+	}
+
 	cm := v1.ConfigMap{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "ConfigMap",
@@ -293,7 +423,10 @@ func (action *startAction) newTestConfigMap(ctx context.Context, test *v1alpha1.
 		},
 		Data: sources,
 	}
-	return &cm
+	if len(binaries) > 0 {
+		cm.BinaryData = binaries
+	}
+	return &cm, nil
 }
 
 func (action *startAction) ensureServiceAccountRoles(ctx context.Context, namespace string) error {
@@ -476,7 +609,7 @@ func (action *startAction) applyOperatorClusterRoles(ctx context.Context, namesp
 	return nil
 }
 
-func (action *startAction) addSelenium(test *v1alpha1.Test, job *batchv1.Job) {
+func (action *startAction) addSelenium(test *v1alpha1.Test, job *batchv1.Job) error {
 	if test.Spec.Selenium.Image != "" {
 		shareProcessNamespace := true
 		job.Spec.Template.Spec.ShareProcessNamespace = &shareProcessNamespace
@@ -487,7 +620,7 @@ func (action *startAction) addSelenium(test *v1alpha1.Test, job *batchv1.Job) {
 			RunAsUser: &uid,
 		}
 
-		job.Spec.Template.Spec.Containers = append(job.Spec.Template.Spec.Containers, v1.Container{
+		selenium := v1.Container{
 			Name:            "selenium",
 			Image:           test.Spec.Selenium.Image,
 			ImagePullPolicy: v1.PullIfNotPresent,
@@ -497,7 +630,17 @@ func (action *startAction) addSelenium(test *v1alpha1.Test, job *batchv1.Job) {
 					MountPath: "/dev/shm",
 				},
 			},
-		})
+		}
+
+		if test.Spec.Selenium.ReadyTimeout != "" {
+			timeout, err := time.ParseDuration(test.Spec.Selenium.ReadyTimeout)
+			if err != nil || timeout <= 0 {
+				return fmt.Errorf("invalid selenium ready timeout '%s'", test.Spec.Selenium.ReadyTimeout)
+			}
+			addSeleniumReadiness(&selenium, timeout)
+		}
+
+		job.Spec.Template.Spec.Containers = append(job.Spec.Template.Spec.Containers, selenium)
 
 		job.Spec.Template.Spec.Volumes = append(job.Spec.Template.Spec.Volumes, v1.Volume{
 			Name: "dshm",
@@ -518,6 +661,57 @@ func (action *startAction) addSelenium(test *v1alpha1.Test, job *batchv1.Job) {
 		// Add selenium profile that will shutdown the selenium container when test is finished
 		job.Spec.Template.Spec.Containers[0].Command = append(job.Spec.Template.Spec.Containers[0].Command, "-Pselenium")
 	}
+
+	return nil
+}
+
+// addSeleniumReadiness adds a readiness probe on the Selenium status endpoint and a post start hook that blocks until
+// Selenium accepts sessions. The hook polls the status endpoint with curl, so the Selenium image must provide curl as the
+// official Selenium images do. The hook fails when curl is missing or when Selenium is not ready within given timeout.
+func addSeleniumReadiness(selenium *v1.Container, timeout time.Duration) {
+	selenium.ReadinessProbe = &v1.Probe{
+		Handler: v1.Handler{
+			HTTPGet: &v1.HTTPGetAction{
+				Path: SeleniumStatusPath,
+				Port: intstr.FromInt(SeleniumPort),
+			},
+		},
+		PeriodSeconds: 2,
+	}
+
+	seconds := int(timeout.Round(time.Second).Seconds())
+	if seconds < 1 {
+		seconds = 1
+	}
+	selenium.Lifecycle = &v1.Lifecycle{
+		PostStart: &v1.Handler{
+			Exec: &v1.ExecAction{
+				Command: []string{"sh", "-c", fmt.Sprintf("command -v curl >/dev/null || "+
+					"{ echo 'Selenium readiness check requires curl in the Selenium image' >&2; exit 1; }; "+
+					"for i in $(seq %d); do "+
+					"curl -sf http://localhost:%d%s | grep -q '\"ready\": *true' && exit 0; sleep 1; done; "+
+					"echo 'Selenium not ready after %s' >&2; exit 1", seconds, SeleniumPort, SeleniumStatusPath, timeout)},
+			},
+		},
+	}
+}
+
+// startSeleniumFirst moves the Selenium container in front of the test container when readiness gating is enabled.
+// Containers are started in order and the next container waits for the post start hook, so the test starts
+// as soon as Selenium is ready.
+func startSeleniumFirst(test *v1alpha1.Test, job *batchv1.Job) {
+	if test.Spec.Selenium.Image == "" || test.Spec.Selenium.ReadyTimeout == "" {
+		return
+	}
+
+	containers := job.Spec.Template.Spec.Containers
+	for i, c := range containers {
+		if c.Name == "selenium" {
+			reordered := append([]v1.Container{c}, containers[:i]...)
+			job.Spec.Template.Spec.Containers = append(reordered, containers[i+1:]...)
+			return
+		}
+	}
 }
 
 func (action *startAction) addKubeDock(test *v1alpha1.Test, job *batchv1.Job) {
@@ -535,7 +729,7 @@ func (action *startAction) addKubeDock(test *v1alpha1.Test, job *batchv1.Job) {
 			Name:            "kubedock",
 			Image:           test.Spec.KubeDock.Image,
 			ImagePullPolicy: v1.PullIfNotPresent,
-			Args:            []string{"server", "--reverse-proxy"},
+			Args:            append([]string{"server", "--reverse-proxy"}, test.Spec.KubeDock.Args...),
 		})
 
 		job.Spec.Template.Spec.Containers[0].Env = append(job.Spec.Template.Spec.Containers[0].Env,
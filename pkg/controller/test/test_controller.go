@@ -21,11 +21,13 @@ import (
 	"context"
 	"github.com/citrusframework/yaks/pkg/util/digest"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -58,9 +60,10 @@ func Add(mgr manager.Manager) error {
 // newReconciler returns a new reconcile.Reconciler
 func newReconciler(mgr manager.Manager, c client.Client, cfg *rest.Config) reconcile.Reconciler {
 	return &ReconcileIntegrationTest{
-		client: c,
-		scheme: mgr.GetScheme(),
-		config: cfg,
+		client:   c,
+		scheme:   mgr.GetScheme(),
+		config:   cfg,
+		recorder: mgr.GetEventRecorderFor("yaks-test-controller"),
 	}
 }
 
@@ -124,9 +127,10 @@ var _ reconcile.Reconciler = &ReconcileIntegrationTest{}
 type ReconcileIntegrationTest struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
-	client client.Client
-	scheme *runtime.Scheme
-	config *rest.Config
+	client   client.Client
+	scheme   *runtime.Scheme
+	config   *rest.Config
+	recorder record.EventRecorder
 }
 
 // Reconcile reads that state of the cluster for a Test object and makes changes based on the state read
@@ -206,6 +210,13 @@ func (r *ReconcileIntegrationTest) Reconcile(ctx context.Context, request reconc
 						"phase-from", target.Status.Phase,
 						"phase-to", newTarget.Status.Phase,
 					)
+
+					eventType := corev1.EventTypeNormal
+					if newTarget.Status.Phase == v1alpha1.TestPhaseError || newTarget.Status.Phase == v1alpha1.TestPhaseFailed {
+						eventType = corev1.EventTypeWarning
+					}
+					r.recorder.AnnotatedEventf(newTarget, RunIDAnnotations(newTarget), eventType, "TestPhaseChanged",
+						"Test phase changed from %s to %s", target.Status.Phase, newTarget.Status.Phase)
 				}
 			}
 
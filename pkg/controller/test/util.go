@@ -27,6 +27,18 @@ func TestJobNameFor(test *v1alpha1.Test) string {
 	return fmt.Sprintf("test-%s-%s", test.Name, test.Status.TestID)
 }
 
+// RunIDAnnotations returns the run id of the YAKS run that created the test as event annotations, so events can be
+// correlated with the logs and reports of the run
+func RunIDAnnotations(test *v1alpha1.Test) map[string]string {
+	if runID, ok := test.Labels[v1alpha1.TestRunIdLabel]; ok {
+		return map[string]string{
+			v1alpha1.TestRunIdLabel: runID,
+		}
+	}
+
+	return nil
+}
+
 // TestResourceNameFor returns the name to use for generic testing resources
 func TestResourceNameFor(test *v1alpha1.Test) string {
 	return fmt.Sprintf("test-%s", test.Name)
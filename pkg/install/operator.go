@@ -34,10 +34,14 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// WatchNamespaceLabel marks the operator roles in additional watched namespaces with the namespace of the operator
+const WatchNamespaceLabel = "yaks.citrusframework.org/operator-namespace"
+
 // OperatorConfiguration --
 type OperatorConfiguration struct {
 	CustomImage           string
@@ -45,6 +49,32 @@ type OperatorConfiguration struct {
 	Namespace             string
 	Global                bool
 	ClusterType           string
+	// Resources holds the resource requests and limits of the operator container, e.g. "limits.memory=512Mi"
+	Resources []string
+	// WatchNamespaces are watched by a namespaced operator in addition to the operator namespace
+	WatchNamespaces []string
+}
+
+// defaultOperatorResources are applied to the operator container unless overwritten in the configuration
+var defaultOperatorResources = []string{
+	"requests.cpu=100m",
+	"requests.memory=128Mi",
+	"limits.cpu=500m",
+	"limits.memory=512Mi",
+}
+
+func (cfg OperatorConfiguration) image() string {
+	if cfg.CustomImage != "" {
+		return cfg.CustomImage
+	}
+	return config.GetDefaultOperatorImage()
+}
+
+func (cfg OperatorConfiguration) imagePullPolicy() string {
+	if cfg.CustomImagePullPolicy != "" {
+		return cfg.CustomImagePullPolicy
+	}
+	return config.DefaultOperatorImagePullPolicy
 }
 
 // Operator installs the operator resources in the given namespace
@@ -54,9 +84,14 @@ func Operator(ctx context.Context, c client.Client, cfg OperatorConfiguration, f
 
 // OperatorOrCollect installs the operator resources or adds them to the collector if present
 func OperatorOrCollect(ctx context.Context, c client.Client, cfg OperatorConfiguration, collection *kubernetes.Collection, force bool) error {
-	customizer := customizer(cfg)
+	resources, err := OperatorResources(cfg.Resources)
+	if err != nil {
+		return err
+	}
+	customizer := customizer(cfg, resources)
 
-	if isOpenShift, err := openshift.IsOpenShiftClusterType(c, cfg.ClusterType); err != nil {
+	isOpenShift, err := openshift.IsOpenShiftClusterType(c, cfg.ClusterType)
+	if err != nil {
 		return err
 	} else if isOpenShift {
 		if err := installOpenShift(ctx, c, cfg.Namespace, customizer, collection, force); err != nil {
@@ -68,6 +103,15 @@ func OperatorOrCollect(ctx context.Context, c client.Client, cfg OperatorConfigu
 		}
 	}
 
+	// Grant the operator access to the additional watched namespaces
+	if !cfg.Global {
+		for _, namespace := range cfg.WatchNamespaces {
+			if err := installWatchNamespaceRole(ctx, c, namespace, isOpenShift, watchNamespaceCustomizer(cfg, namespace, customizer), collection, force); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Make sure that instance CR installed in operator namespace can be used by others
 	if err := InstallInstanceViewerRole(ctx, c, cfg.Namespace, customizer, collection, force); err != nil {
 		return err
@@ -110,20 +154,59 @@ func OperatorOrCollect(ctx context.Context, c client.Client, cfg OperatorConfigu
 	return nil
 }
 
-func customizer(cfg OperatorConfiguration) ResourceCustomizer {
+// OperatorResources creates the resource requirements of the operator container from given settings in the form
+// "requests.cpu=100m" or "limits.memory=512Mi". Settings that are not given use the default values.
+func OperatorResources(settings []string) (corev1.ResourceRequirements, error) {
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{},
+		Limits:   corev1.ResourceList{},
+	}
+
+	for _, setting := range append(append([]string{}, defaultOperatorResources...), settings...) {
+		pair := strings.SplitN(setting, "=", 2)
+		if len(pair) != 2 {
+			return resources, fmt.Errorf("invalid operator resource setting '%s', expected format is e.g. limits.memory=512Mi", setting)
+		}
+
+		quantity, err := resource.ParseQuantity(strings.TrimSpace(pair[1]))
+		if err != nil {
+			return resources, fmt.Errorf("invalid operator resource setting '%s': %v", setting, err)
+		}
+
+		switch strings.TrimSpace(pair[0]) {
+		case "requests.cpu":
+			resources.Requests[corev1.ResourceCPU] = quantity
+		case "requests.memory":
+			resources.Requests[corev1.ResourceMemory] = quantity
+		case "limits.cpu":
+			resources.Limits[corev1.ResourceCPU] = quantity
+		case "limits.memory":
+			resources.Limits[corev1.ResourceMemory] = quantity
+		default:
+			return resources, fmt.Errorf("invalid operator resource setting '%s', should be one of: requests.cpu|requests.memory|limits.cpu|limits.memory", setting)
+		}
+	}
+
+	return resources, nil
+}
+
+func customizer(cfg OperatorConfiguration, resources corev1.ResourceRequirements) ResourceCustomizer {
 	return func(o ctrl.Object) ctrl.Object {
-		if cfg.CustomImage != "" {
-			if d, ok := o.(*appsv1.Deployment); ok {
-				if d.Labels["yaks.citrusframework.org/component"] == "operator" {
-					d.Spec.Template.Spec.Containers[0].Image = cfg.CustomImage
-				}
+		if d, ok := o.(*appsv1.Deployment); ok {
+			if d.Labels["yaks.citrusframework.org/component"] == "operator" {
+				// Do not rely on the image literal in the embedded deployment resource
+				d.Spec.Template.Spec.Containers[0].Image = cfg.image()
+				d.Spec.Template.Spec.Containers[0].ImagePullPolicy = corev1.PullPolicy(cfg.imagePullPolicy())
+				d.Spec.Template.Spec.Containers[0].Resources = resources
 			}
 		}
 
-		if cfg.CustomImagePullPolicy != "" {
+		if !cfg.Global && len(cfg.WatchNamespaces) > 0 {
 			if d, ok := o.(*appsv1.Deployment); ok {
 				if d.Labels["yaks.citrusframework.org/component"] == "operator" {
-					d.Spec.Template.Spec.Containers[0].ImagePullPolicy = corev1.PullPolicy(cfg.CustomImagePullPolicy)
+					// Make the operator watch the additional namespaces
+					namespaces := append([]string{cfg.Namespace}, cfg.WatchNamespaces...)
+					envvar.SetVal(&d.Spec.Template.Spec.Containers[0].Env, "WATCH_NAMESPACE", strings.Join(namespaces, ","))
 				}
 			}
 		}
@@ -174,6 +257,76 @@ func customizer(cfg OperatorConfiguration) ResourceCustomizer {
 	}
 }
 
+// watchNamespaceCustomizer binds the operator role in an additional watched namespace to the operator service account
+func watchNamespaceCustomizer(cfg OperatorConfiguration, namespace string, customizer ResourceCustomizer) ResourceCustomizer {
+	return func(o ctrl.Object) ctrl.Object {
+		o = customizer(o)
+		o.SetNamespace(namespace)
+
+		labels := o.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[WatchNamespaceLabel] = cfg.Namespace
+		o.SetLabels(labels)
+
+		if rb, ok := o.(*v1.RoleBinding); ok {
+			for i := range rb.Subjects {
+				if rb.Subjects[i].Kind == v1.ServiceAccountKind {
+					rb.Subjects[i].Namespace = cfg.Namespace
+				}
+			}
+		}
+		return o
+	}
+}
+
+// DeleteWatchNamespaceRoles removes the roles and role bindings that grant the operator in given namespace access to
+// the additional watched namespaces
+func DeleteWatchNamespaceRoles(ctx context.Context, c client.Client, operatorNamespace string, namespaces []string) error {
+	selector := metav1.ListOptions{LabelSelector: WatchNamespaceLabel + "=" + operatorNamespace}
+	for _, namespace := range namespaces {
+		if err := c.RbacV1().RoleBindings(namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, selector); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		if err := c.RbacV1().Roles(namespace).DeleteCollection(ctx, metav1.DeleteOptions{}, selector); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WatchedNamespaces looks up the additional namespaces the operator in given namespace has been granted access to
+func WatchedNamespaces(ctx context.Context, c client.Client, operatorNamespace string) ([]string, error) {
+	bindings, err := c.RbacV1().RoleBindings(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: WatchNamespaceLabel + "=" + operatorNamespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := make([]string, 0, len(bindings.Items))
+	for _, binding := range bindings.Items {
+		namespaces = append(namespaces, binding.Namespace)
+	}
+	return namespaces, nil
+}
+
+func installWatchNamespaceRole(ctx context.Context, c client.Client, namespace string, isOpenShift bool, customizer ResourceCustomizer, collection *kubernetes.Collection, force bool) error {
+	if isOpenShift {
+		return ResourcesOrCollect(ctx, c, namespace, collection, force, customizer,
+			"/rbac/operator-role-openshift.yaml",
+			"/rbac/operator-role-binding-openshift.yaml",
+		)
+	}
+
+	return ResourcesOrCollect(ctx, c, namespace, collection, force, customizer,
+		"/rbac/operator-role-kubernetes.yaml",
+		"/rbac/operator-role-binding-kubernetes.yaml",
+	)
+}
+
 func installOpenShift(ctx context.Context, c client.Client, namespace string, customizer ResourceCustomizer, collection *kubernetes.Collection, force bool) error {
 	return ResourcesOrCollect(ctx, c, namespace, collection, force, customizer,
 		"/manager/operator-service-account.yaml",
@@ -0,0 +1,90 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package install
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOperatorResources(t *testing.T) {
+	resources, err := OperatorResources([]string{"limits.memory=1Gi", "requests.cpu = 200m"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, resource.MustParse("200m"), resources.Requests[corev1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("128Mi"), resources.Requests[corev1.ResourceMemory])
+	assert.Equal(t, resource.MustParse("500m"), resources.Limits[corev1.ResourceCPU])
+	assert.Equal(t, resource.MustParse("1Gi"), resources.Limits[corev1.ResourceMemory])
+}
+
+func TestInvalidOperatorResources(t *testing.T) {
+	_, err := OperatorResources([]string{"limits.disk=1Gi"})
+	assert.EqualError(t, err, "invalid operator resource setting 'limits.disk=1Gi', should be one of: requests.cpu|requests.memory|limits.cpu|limits.memory")
+
+	_, err = OperatorResources([]string{"limits.memory"})
+	assert.NotNil(t, err)
+
+	_, err = OperatorResources([]string{"limits.memory=lots"})
+	assert.NotNil(t, err)
+}
+
+func TestWatchNamespaces(t *testing.T) {
+	cfg := OperatorConfiguration{
+		Namespace:       "yaks-test",
+		WatchNamespaces: []string{"dependency"},
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"yaks.citrusframework.org/component": "operator"},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Env: []corev1.EnvVar{{
+							Name:      "WATCH_NAMESPACE",
+							ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}},
+						}},
+					}},
+				},
+			},
+		},
+	}
+	customizer(cfg, corev1.ResourceRequirements{})(deployment)
+
+	env := deployment.Spec.Template.Spec.Containers[0].Env
+	assert.Equal(t, "yaks-test,dependency", env[0].Value)
+	assert.Nil(t, env[0].ValueFrom)
+
+	binding := &rbacv1.RoleBinding{
+		Subjects: []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: "yaks-operator"}},
+	}
+	watchNamespaceCustomizer(cfg, "dependency", IdentityResourceCustomizer)(binding)
+
+	assert.Equal(t, "dependency", binding.Namespace)
+	assert.Equal(t, "yaks-test", binding.Subjects[0].Namespace)
+	assert.Equal(t, "yaks-test", binding.Labels[WatchNamespaceLabel])
+}
@@ -51,14 +51,14 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 3523,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x5d\x73\xdb\xb6\x12\x7d\xe7\xaf\xd8\xb1\x1e\x92\xcc\x58\x54\x7c\xef\x7d\xb8\xa3\x3e\xa9\x8a\xdd\x6a\x92\xca\x1e\x53\x49\x26\x8f\x10\xb1\xa2\x36\x06\x01\x16\x1f\x52\xdc\x4e\xff\x7b\x67\x01\x52\x26\x25\x39\xcd\xb4\x29\x9e\x44\x60\xb1\xe7\x60\xcf\x7e\x68\x04\xe3\xef\xb7\xb2\x11\xbc\xa3\x12\xb5\x43\x09\xde\x80\xdf\x22\xcc\x1a\x51\x6e\x11\x0a\xb3\xf1\x7b\x61\x11\x6e\x4c\xd0\x52\x78\x32\x1a\x5e\xce\x8a\x9b\x57\x10\xb4\x44\x0b\x46\x23\x18\x0b\xb5\xb1\x98\x8d\xa0\x34\xda\x5b\x5a\x07\x6f\x2c\xa8\xe4\x10\x44\x65\x11\x6b\xd4\xde\xe5\x00\x05\x62\xf4\xbe\xbc\x5d\x2d\xe6\xd7\xb0\x21\x85\x20\xc9\xa5\x4b\x28\x61\x4f\x7e\x9b\x8d\xc0\x6f\xc9\xc1\xde\xd8\x07\xd8\x18\x0b\x42\x4a\x62\x60\xa1\x80\xf4\xc6\xd8\x3a\xd1\xb0\x58\x09\x2b\x49\x57\x50\x9a\xe6\xd1\x52\xb5\xf5\x60\xf6\x1a\xad\xdb\x52\x93\x67\x23\x58\xf1\x33\x8a\x9b\x8e\x89\x4b\x6e\x23\xa6\x37\xf0\xc9\x84\xf6\x0d\xbd\xe7\xb6\x51\xb8\x84\x0f\x68\x1d\x83\xfc\x27\x7f\x9d\x8d\xe0\x25\x9b\x5c\xb4\x87\x17\xaf\x7e\x80\x47\x13\xa0\x16\x8f\xa0\x8d\x87\xe0\xb0\xe7\x19\xbf\x94\xd8\x78\x20\x0d\xa5\xa9\x1b\x45\x42\x97\xf8\xf4\xac\x03\x42\x0e\x91\x00\xfb\x30\x6b\x2f\x48\x83\x88\xcf\x00\xb3\xe9\x9b\x81\xf0\xd9\x28\x1b\x41\x5c\x5b\xef\x9b\xe9\x64\xb2\xdf\xef\x73\x11\xe9\xe6\xc6\x56\x93\xee\x75\x93\x77\x8b\xf9\xf5\xb2\xb8\x1e\x47\xca\xd9\x08\xde\x6b\x85\xce\x81\xc5\x5f\x03\x59\x94\xb0\x7e\x04\xd1\x34\x8a\x4a\xb1\x56\x08\x4a\xec\x59\xb8\xa8\x4e\x14\x9d\x34\xec\x2d\x79\xd2\xd5\x25\xb8\x56\xf5\x6c\x34\x50\xe7\x29\x5c\x1d\x3d\x72\x03\x03\xa3\x41\x68\xb8\x98\x15\xb0\x28\x2e\xe0\xc7\x59\xb1\x28\x2e\xb3\x11\x7c\x5c\xac\x7e\xbe\x7d\xbf\x82\x8f\xb3\xfb\xfb\xd9\x72\xb5\xb8\x2e\xe0\xf6\x1e\xe6\xb7\xcb\x37\x8b\xd5\xe2\x76\x59\xc0\xed\x0d\xcc\x96\x9f\xe0\xed\x62\xf9\xe6\x12\x90\xfc\x16\x2d\xe0\x97\xc6\x32\x7f\x63\x81\x38\x90\x28\x59\xd3\x2e\x81\x3a\x02\x9c\x1f\xfc\xed\x1a\x2c\x69\x43\x25\x28\xa1\xab\x20\x2a\x84\xca\xec\xd0\x6a\x4e\x8f\x06\x6d\x4d\x8e\xe5\x74\x20\xb4\xcc\x46\xa0\xa8\x26\x1f\xb3\xc8\x9d\x3e\x8a\x61\xbe\x67\x6d\x65\xa2\xa1\x36\x9d\xa6\x20\x1a\xc2\x2f\x1e\x75\x64\x93\x3f\xfc\xdf\xe5\x64\x26\xbb\xab\xec\x81\xb4\x9c\xc2\x3c\x38\x6f\xea\x7b\x74\x26\xd8\x12\xdf\xe0\x86\x74\xcc\xfc\xac\x46\x2f\xa4\xf0\x62\x9a\x01\x28\xb1\x46\xe5\xf8\x17\xb0\xa0\x53\x78\x14\x0f\x2e\x03\x10\x5a\x9b\xf6\x51\xe9\x30\x56\xa3\x51\x0a\xed\xb8\x42\x9d\x3f\x84\x35\xae\x03\x29\x89\x36\x82\x76\x94\x76\xaf\xf3\xff\xe5\x57\x19\x40\x69\x31\x5e\x5f\x51\x8d\xce\x8b\xba\x99\x82\x0e\x4a\x65\x00\x5a\xd4\x38\x05\xd2\xce\x73\x3a\xbb\x9c\x11\xf3\x92\xbc\x0d\x6e\x63\x45\x8d\x5c\xaa\x9c\x8c\x19\xcb\xc0\xe0\x95\x35\xa1\x65\x76\xd6\x2e\xb9\xec\x78\x0a\x8f\x95\xb1\xd4\x7d\x8f\xbb\x17\xf1\x4f\x8f\x8e\x73\x32\x7e\xa5\x20\x2d\x5a\x1a\x71\x4b\x91\xf3\x6f\x07\xdb\xef\xc8\xf9\x78\xd4\xa8\x60\x85\xea\xd1\x8e\xbb\x8e\x74\x15\x94\xb0\x4f\xfb\x19\x80\x2b\x4d\x83\x53\x58\x32\xa7\x46\x94\x28\x33\x80\x36\x3e\x91\xd3\xb8\xd7\x83\xee\x2c\x69\x8f\x76\x6e\x54\xa8\xf5\x81\xb1\x44\x57\x5a\x6a\x7c\x8c\xe8\xca\x06\x04\xda\xc0\xa7\xd9\xdb\xe2\x00\xc3\xb5\x52\x29\xb3\x16\x2a\x4b\xe5\xfc\xd9\x19\x7d\x27\xfc\x76\x0a\x39\x87\x2d\x37\x0d\x5a\xe1\x8d\xcd\x07\x56\x29\xf4\x3f\xf5\xb7\xfc\x23\x93\x5d\x1b\xa3\x50\xe8\xb3\xf8\x5b\x4c\xd8\x9d\x4b\x68\x8c\x8c\x9e\xfe\x0a\xba\x31\x72\x80\x7b\x77\xf8\x4e\xa0\x5c\xea\xad\x18\xcf\x61\xb6\x71\x3b\x83\xe4\x85\x0f\x2e\x1f\x9e\x27\x98\x0f\x83\xbd\x13\xa8\x64\xb4\xbb\x12\xaa\xd9\x8a\xab\x24\x63\xb9\xc5\x5a\x4c\xdb\x1b\xa6\x41\x3d\xbb\x5b\x7c\xf8\x6f\x31\xd8\x86\x21\xc5\x45\x4f\x09\xae\xf7\x64\x7c\x68\x21\x9c\x74\xfd\xa4\x48\xab\xb1\x1c\x1c\x7f\x48\xce\xb4\x7a\x25\xdd\xdb\x3d\xc2\x7b\xc1\x94\xda\x39\x22\xb9\x96\x31\xe1\xb6\x11\x40\xd9\xbe\x22\xf5\x7c\xe2\x56\xcd\x2d\x0f\x75\xaa\xe2\x81\x63\x60\x23\xa1\xc1\xac\x3f\x63\xe9\x73\x28\xd0\xb2\x1b\x70\x5b\x13\x94\xe4\x52\xdf\xa1\xf5\x60\xb1\x34\x95\xa6\xdf\x0e\xbe\x5d\x37\xcf\x95\xe0\x4a\x3a\xf2\x19\x33\x99\xe7\xea\x4e\xa8\x80\x97\xdc\x1d\xe3\x48\xb2\xc8\x28\x10\x74\xcf\x5f\x34\x71\x39\xfc\x62\x2c\xc6\x39\x3c\x8d\x03\xc9\x4d\x27\x93\x8a\x7c\xd7\xca\x4a\x53\xd7\x41\x93\x7f\x9c\xf4\xfe\x0b\xb8\x89\xc4\x1d\xaa\x89\xa3\x6a\x2c\x6c\xb9\x25\x8f\xa5\x0f\x16\x27\xa2\xa1\x71\xa4\xae\x63\xdb\xca\x6b\x39\xb2\x6d\xf3\x73\x2f\x06\x5c\x4f\x72\x22\xad\xd8\x11\xbe\xa2\x00\xb7\x06\x96\x5b\xb4\x57\xd3\x2b\x9e\x02\xcd\x5b\x1c\x9d\xfb\xeb\x62\x05\x1d\x74\x14\xe3\x38\xfa\x31\xee\x4f\x17\xdd\x93\x04\x1c\x30\xd2\x9b\x38\x44\xf8\x5f\x80\x35\x75\xf4\x89\x5a\x36\x86\xb4\x8f\x1f\xa5\x22\xd4\xc7\xe1\x77\x61\x5d\x93\x4f\x23\x1a\x9d\x67\xad\x72\x98\xc7\x3e\x0e\x6b\x84\xd0\x48\xe1\x51\xe6\xb0\xd0\x30\x17\x35\xaa\xb9\xe0\x3f\x0e\xff\xb2\x00\x1c\x69\x37\xe6\xc0\x7e\x9b\x04\xfd\xd1\x74\x6c\x9c\xa2\xd6\x3b\xe8\xe6\xc3\x33\x7a\x75\x15\x5a\x34\x58\x72\xe5\xed\x48\xb6\x35\xc3\xcd\x03\x63\x0d\x3c\x53\xa7\xf0\x6c\xad\x42\x6a\x10\xb1\xc1\x1d\xef\x1f\xe1\xdf\xb6\x66\x8c\x3f\x1e\x9f\xd8\x3e\xef\x9f\x57\x6a\xdb\xe7\x4e\xce\xb5\xec\xe1\xd2\xdd\xe4\xf9\xda\xf5\x93\xd0\x1f\x78\x19\xf9\x37\xee\x75\x7f\x0c\x4f\xaf\x8e\x87\x83\xea\xd8\xdf\x89\xaa\xcf\xcb\x1d\x5b\xfe\xb7\x08\x1e\x0d\x07\x5d\xd2\xac\x1d\x17\x98\xfc\xa7\xd2\xef\xce\xf5\xe9\xaf\x06\xe7\xec\x63\x4e\x36\x13\xbb\x29\x78\x1b\x12\x15\xe7\x8d\x15\x15\xf6\x77\xc2\xfa\xd0\xce\x3a\xfc\x36\x24\xf0\xfb\x1f\xd9\x9f\x01\x00\x00\xff\xff\xbf\x81\xd6\x32\xc3\x0d\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4b\x6f\xdb\x46\x17\xdd\xf3\x57\x1c\x58\x8b\x24\x80\x45\x25\xdf\xd7\x45\xc1\xae\x54\xc5\x6e\x85\xa4\xb2\x61\x2a\x09\xb2\x1c\x91\x57\xd4\xc4\xc3\x99\xc9\x3c\xa4\xb8\x45\xff\x7b\x71\x87\xa4\x2d\xfa\x91\x04\x68\x4a\x7a\x61\xde\xb9\x73\x1f\xe7\xdc\x87\x26\x98\xfe\xb8\x27\x9b\xe0\xad\xac\x48\x7b\xaa\x11\x0c\xc2\x8e\x30\xb7\xa2\xda\x11\x4a\xb3\x0d\x07\xe1\x08\xe7\x26\xea\x5a\x04\x69\x34\x9e\xcf\xcb\xf3\x17\x88\xba\x26\x07\xa3\x09\xc6\xa1\x35\x8e\xb2\x09\x2a\xa3\x83\x93\x9b\x18\x8c\x83\xea\x0c\x42\x34\x8e\xa8\x25\x1d\x7c\x0e\x94\x44\xc9\xfa\xea\x62\xbd\x5c\x9c\x61\x2b\x15\xa1\x96\xbe\xbb\x44\x35\x0e\x32\xec\xb2\x09\xc2\x4e\x7a\x1c\x8c\xbb\xc6\xd6\x38\x88\xba\x96\xec\x58\x28\x48\xbd\x35\xae\xed\xc2\x70\xd4\x08\x57\x4b\xdd\xa0\x32\xf6\xc6\xc9\x66\x17\x60\x0e\x9a\x9c\xdf\x49\x9b\x67\x13\xac\x39\x8d\xf2\x7c\x88\xc4\x77\x66\x93\xcf\x60\xf0\xd1\xc4\x3e\x87\xa3\x74\x7b\x14\x4e\xf1\x9e\x9c\x67\x27\xff\xcb\x5f\x66\x13\x3c\x67\x95\x93\xfe\xf0\xe4\xc5\x2f\xb8\x31\x11\xad\xb8\x81\x36\x01\xd1\xd3\x91\x65\xfa\x52\x91\x0d\x90\x1a\x95\x69\xad\x92\x42\x57\x74\x97\xd6\xad\x87\x1c\x29\x00\xb6\x61\x36\x41\x48\x0d\x91\xd2\x80\xd9\x1e\xab\x41\x84\x6c\x92\x4d\x90\x9e\x5d\x08\xb6\x98\xcd\x0e\x87\x43\x2e\x12\x3b\xb9\x71\xcd\x6c\xc8\x6e\xf6\x76\xb9\x38\x5b\x95\x67\xd3\x14\x72\x36\xc1\x3b\xad\xc8\x7b\x38\xfa\x1c\xa5\xa3\x1a\x9b\x1b\x08\x6b\x95\xac\xc4\x46\x11\x94\x38\x30\x71\x89\x9d\x44\xba\xd4\x38\x38\x19\xa4\x6e\x4e\xe1\x7b\xd6\xb3\xc9\x88\x9d\x3b\xb8\x86\xf0\xa4\x1f\x29\x18\x0d\xa1\x71\x32\x2f\xb1\x2c\x4f\xf0\xeb\xbc\x5c\x96\xa7\xd9\x04\x1f\x96\xeb\xdf\x2f\xde\xad\xf1\x61\x7e\x75\x35\x5f\xad\x97\x67\x25\x2e\xae\xb0\xb8\x58\xbd\x5e\xae\x97\x17\xab\x12\x17\xe7\x98\xaf\x3e\xe2\xcd\x72\xf5\xfa\x14\x24\xc3\x8e\x1c\xe8\x8b\x75\x1c\xbf\x71\x90\x0c\x24\xd5\xcc\xe9\x50\x40\x43\x00\x5c\x1f\xfc\xed\x2d\x55\x72\x2b\x2b\x28\xa1\x9b\x28\x1a\x42\x63\xf6\xe4\x34\x97\x87\x25\xd7\x4a\xcf\x74\x7a\x08\x5d\x67\x13\x28\xd9\xca\x90\xaa\xc8\x3f\x4c\x8a\xdd\x0c\x8d\xf1\x03\x9e\x2c\x13\x56\xf6\xe5\x54\x40\x58\x49\x5f\x02\xe9\x14\x4d\x7e\xfd\xb3\xcf\xa5\x99\xed\x5f\x65\xd7\x52\xd7\x05\x16\xd1\x07\xd3\x5e\x91\x37\xd1\x55\xf4\x9a\xb6\x52\xa7\xca\xcf\x5a\x0a\xa2\x16\x41\x14\x19\xa0\xc4\x86\x94\xe7\xff\xc0\x84\x16\xb8\x11\xd7\x3e\x03\x84\xd6\xa6\x4f\xaa\x3b\x4c\xdd\x68\x94\x22\x37\x6d\x48\xe7\xd7\x71\x43\x9b\x28\x55\x4d\x2e\x39\x1d\x42\xda\xbf\xcc\x7f\xca\x5f\x65\x40\xe5\x28\x5d\x5f\xcb\x96\x7c\x10\xad\x2d\xa0\xa3\x52\x19\xa0\x45\x4b\x05\xa4\xf6\x81\xcb\xd9\xe7\xec\x31\xaf\x64\x70\xd1\x6f\x9d\x68\x89\x5b\x95\x8b\x31\x63\x1a\xd8\x79\xe3\x4c\xec\x23\x7b\x54\xaf\x33\xd9\x27\x51\x89\x40\x8d\x71\x72\xf8\x9e\x0e\x19\xf1\xbf\x81\x3c\xd7\x64\x52\xec\x40\x5a\xf6\x61\x24\x91\x92\x3e\xbc\x19\x89\xdf\x4a\x1f\xd2\x91\x55\xd1\x09\x75\x14\x76\x92\x7a\xa9\x9b\xa8\x84\xbb\x93\x67\x80\xaf\x8c\xa5\x02\x2b\xd1\x92\xb7\xa2\xa2\x3a\x03\x7a\x7c\x52\x4c\xd3\xa3\x19\x74\xe9\xa4\x0e\xe4\x16\x46\xc5\x76\x40\x7a\x8a\x9a\x7c\xe5\xa4\x65\xf8\x0a\xac\x5d\x24\xc8\x2d\x3e\xce\xdf\x94\xb7\x6e\x20\x3d\x1a\x65\x36\x82\x01\xe5\xf7\x93\x37\xfa\x52\x84\x5d\x81\x9c\x61\xcb\x8d\x25\x27\x82\x71\xf9\x48\x8b\x71\x2a\xf0\xdb\xb1\x28\xdc\x70\xb0\x1b\x63\x14\x09\xfd\xa8\xff\x1d\x75\xbe\x07\x93\xb0\xa6\x4e\x88\x7f\xcb\xb5\x35\x75\xaf\xc2\xda\x05\x2e\x6f\xbf\x3b\xa7\x3c\xa9\x75\xf3\x55\x9f\x3d\x6e\x8f\x78\x0a\x22\x44\x9f\x8f\xcf\x3b\x37\xef\x47\xb2\x07\xae\x3a\xa5\xfd\x2b\xa1\xec\x4e\x70\xa5\x32\x63\x3b\x6a\x53\x3b\xf0\x97\xb1\xa4\xe7\x97\xcb\xf7\xff\x2f\x47\x62\x8c\x43\x1c\x2a\x84\x99\xe0\x99\xd1\x29\xdf\x8e\x10\x2e\xba\xe3\xa2\xe8\x5e\xeb\x18\xc4\x70\x5b\x9c\xdd\xdf\x51\x4b\x1f\x49\xef\xf9\x7b\xc6\x21\xf5\x7b\xa4\xe6\x5e\xa6\xce\x6f\x8f\x00\xd5\x7d\x16\xdd\xcc\x97\x3c\xaa\x79\xe4\x91\xee\xba\x78\x64\x18\xac\x24\x34\xcc\xe6\x13\x55\x21\x47\x49\x8e\xcd\xc0\xef\x4c\x54\x35\x2f\xde\x3d\xb9\x00\x47\x95\x69\xb4\xfc\xf3\xd6\xb6\x1f\xf6\xb9\x12\xdc\x49\xf7\x6c\xa6\x4a\xd6\x42\x61\x2f\x54\xa4\x53\x9e\x8e\x69\xad\x39\x62\x2f\x88\xfa\xc8\x5e\x52\xf1\x39\xfe\x30\x8e\xd2\x1e\x2e\xd2\x42\xf2\xc5\x6c\xd6\xc8\x30\x8c\xb2\xca\xb4\x6d\xd4\x32\xdc\xcc\x8e\x7e\x0b\xf8\x59\x4d\x7b\x52\x33\x2f\x9b\xa9\x70\xd5\x4e\x06\xaa\x42\x74\x34\x13\x56\x4e\x53\xe8\x9a\x13\xf6\x79\x5b\x4f\x5c\x3f\xfc\xfc\xb3\x51\xac\x0f\x6a\xa2\xfb\x4b\x13\xe1\x2b\x0c\xf0\x68\x60\xba\x45\x7f\xb5\x4b\xf4\x0e\x68\x16\x31\x3a\x57\x67\xe5\x1a\x83\xeb\xb4\xcd\x47\x46\xd1\xe3\x7e\x77\xd1\xdf\x51\xc0\x80\x49\xbd\x4d\x4b\x84\x7f\x05\x38\xd3\x26\x9a\x49\xd7\xd6\x48\x1d\xd2\x47\xa5\x24\xe9\xfb\xf0\xfb\xb8\x69\x65\x60\xde\x3f\x47\xf2\x81\xb9\xca\xb1\x48\x73\x1c\x1b\x42\xb4\xb5\x08\x54\xe7\x58\x6a\x2c\x44\x4b\x6a\x21\x3c\xfd\xe7\x04\x30\xd2\x7e\xca\xc0\x7e\x1f\x05\xc7\xab\xe9\xee\x61\x2b\x45\x8f\xda\xd1\xc1\xb0\x1f\x9e\xe0\x6b\xe8\xd0\xd2\x52\x05\xeb\xcc\x5e\xd6\x7d\xcf\xf8\x20\x02\xa5\x1e\x78\xa2\x4f\x9f\xee\xd5\x7e\x40\xa4\x01\x77\x5f\x7e\xcf\xff\x45\xaf\xc6\xfe\xa7\xd3\x07\xba\x4f\xdb\xe7\xb7\x1b\xdb\x8f\x9d\x3c\x36\xb2\xc7\x8f\x1e\x36\xcf\xd7\xae\x3f\x80\x7e\x78\xad\xb9\xd7\x04\xdf\x75\x6f\xf8\x61\xf8\xf0\xea\x74\xbc\xa8\xbe\xc9\xea\x93\x07\xcc\x5a\xf4\xdf\x43\x78\x52\x1c\x4d\x49\xb3\xf1\x3c\xe3\xea\x7f\x4b\x7d\x3f\x6d\x8b\x27\x92\x79\x04\x9c\x47\x93\x79\x20\xec\xa2\x2b\x10\x5c\xec\xb6\x85\x0f\xc6\x89\x86\x8e\x25\x71\x73\x3b\xce\x06\xff\x3e\x88\x10\x7d\x81\xbf\xfe\xce\xfe\x19\x00\xbf\x81\xd6\x32\xc3\x0d\x00\x00"),
 		},
 		"/crd/bases/yaks.citrusframework.org_tests.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "yaks.citrusframework.org_tests.yaml",
 			modTime:          time.Time{},
-			uncompressedSize: 6335,
+			uncompressedSize: 8956,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\xcd\x6f\xdb\xb8\x12\xbf\xeb\xaf\x18\xc4\x87\xb6\x40\x2c\x37\xef\xbd\xc3\x83\xf7\xe4\x75\x12\xac\xd1\xd6\x09\x22\xb7\x45\x8f\xb4\x34\x96\xa7\xa6\x48\x2d\x3f\xec\x7a\x17\xfb\xbf\x2f\x48\x4a\x8e\xfc\xa9\x38\x49\x81\xf2\x64\x8f\x66\xe6\x37\x5f\x9c\x19\x76\xa0\xfb\x7a\x27\xea\xc0\x47\x4a\x51\x68\xcc\xc0\x48\x30\x73\x84\x41\xc9\xd2\x39\x42\x22\x67\x66\xc5\x14\xc2\xad\xb4\x22\x63\x86\xa4\x80\xb7\x83\xe4\xf6\x1d\x58\x91\xa1\x02\x29\x10\xa4\x82\x42\x2a\x8c\x3a\x90\x4a\x61\x14\x4d\xad\x91\x0a\x78\x50\x08\x2c\x57\x88\x05\x0a\xa3\x63\x80\x04\xd1\x6b\x1f\xdf\x4d\x46\xc3\x1b\x98\x11\x47\xc8\x48\x07\x21\xcc\x60\x45\x66\x1e\x75\xc0\xcc\x49\xc3\x4a\xaa\x05\xcc\xa4\x02\x96\x65\xe4\x80\x19\x07\x12\x33\xa9\x8a\x60\x86\xc2\x9c\xa9\x8c\x44\x0e\xa9\x2c\xd7\x8a\xf2\xb9\x01\xb9\x12\xa8\xf4\x9c\xca\x38\xea\xc0\xc4\xb9\x91\xdc\xd6\x96\xe8\xa0\xd6\x63\x1a\x09\xdf\xa4\xad\x7c\x68\xb8\x5b\x45\xe1\x12\xbe\xa0\xd2\x0e\xe4\x3f\xf1\xfb\xa8\x03\x6f\x1d\xcb\x45\xf5\xf1\xe2\xdd\x6f\xb0\x96\x16\x0a\xb6\x06\x21\x0d\x58\x8d\x0d\xcd\xf8\x23\xc5\xd2\x00\x09\x48\x65\x51\x72\x62\x22\xc5\x47\xb7\x36\x08\x31\x78\x03\x9c\x0e\x39\x35\x8c\x04\x30\xef\x06\xc8\x59\x93\x0d\x98\x89\x3a\x51\x07\xfc\x99\x1b\x53\xf6\x7b\xbd\xd5\x6a\x15\x33\x6f\x6e\x2c\x55\xde\xab\xbd\xeb\x7d\x1c\x0d\x6f\xc6\xc9\x4d\xd7\x9b\x1c\x75\xe0\xb3\xe0\xa8\x35\x28\xfc\xd3\x92\xc2\x0c\xa6\x6b\x60\x65\xc9\x29\x65\x53\x8e\xc0\xd9\xca\x25\xce\x67\xc7\x27\x9d\x04\xac\x14\x19\x12\xf9\x25\xe8\x2a\xeb\x51\x67\x2b\x3b\x8f\xe1\xaa\xcd\x23\xbd\xc5\x20\x05\x30\x01\x17\x83\x04\x46\xc9\x05\xfc\x3e\x48\x46\xc9\x65\xd4\x81\xaf\xa3\xc9\x1f\x77\x9f\x27\xf0\x75\xf0\xf0\x30\x18\x4f\x46\x37\x09\xdc\x3d\xc0\xf0\x6e\x7c\x3d\x9a\x8c\xee\xc6\x09\xdc\xdd\xc2\x60\xfc\x0d\x3e\x8c\xc6\xd7\x97\x80\x64\xe6\xa8\x00\x7f\x94\xca\xd9\x2f\x15\x90\x0b\x24\x66\x2e\xa7\x75\x01\xd5\x06\xb8\xfa\x70\xff\x75\x89\x29\xcd\x28\x05\xce\x44\x6e\x59\x8e\x90\xcb\x25\x2a\xe1\xca\xa3\x44\x55\x90\x76\xe9\xd4\xc0\x44\x16\x75\x80\x53\x41\xc6\x57\x91\xde\x77\xca\xc1\xbc\xe6\xdd\x8a\x58\x49\x55\x39\xf5\x81\x95\x84\x3f\x0c\x0a\x6f\x4d\xbc\xf8\xbf\x8e\x49\xf6\x96\x57\xd1\x82\x44\xd6\x87\xa1\xd5\x46\x16\x0f\xa8\xa5\x55\x29\x5e\xe3\x8c\x84\xaf\xfc\xa8\x40\xc3\x32\x66\x58\x3f\x02\xe0\x6c\x8a\x5c\xbb\x5f\xe0\x12\xda\x87\x35\x5b\xe8\x08\x80\x09\x21\x2b\xa7\xc2\x47\x7f\x1b\x25\xe7\xa8\xba\x39\x8a\x78\x61\xa7\x38\xb5\xc4\x33\x54\x1e\xb4\x36\x69\xf9\x3e\xfe\x5f\x7c\x15\x01\xa4\x0a\xbd\xf8\x84\x0a\xd4\x86\x15\x65\x1f\x84\xe5\x3c\x02\x10\xac\xc0\x3e\x18\xd4\x46\xc7\x0e\x2d\x4e\xc9\x28\xab\x67\x8a\x15\xe8\xae\xa9\x2b\xc4\xc8\xa5\xc0\x01\xe7\x4a\xda\xca\xaa\x83\x7c\x41\x5d\x6d\x23\x33\x98\x4b\x45\xf5\xff\x6e\xed\x8d\xfb\xe9\x00\x49\xe4\xfe\x5f\x08\xd0\x04\xb5\xf1\x7f\x39\x69\xf3\x61\x43\xfa\x48\x15\xb9\xe4\x56\x31\x5e\x99\xea\x29\x9a\x44\x6e\x39\x53\x81\x16\x01\xe8\x54\x96\xd8\x87\xb1\xb3\xa1\x64\x29\x66\x11\x40\x15\x0b\x6f\x43\xb7\xd1\x6f\xee\x15\x09\x83\x6a\x28\xb9\x2d\xc4\xc6\xc2\x0c\x75\xaa\xa8\x34\x3e\x7a\xae\xc9\x38\xcd\x50\xce\x99\xc6\x28\xdc\xd4\xef\x5a\x8a\x7b\x66\xe6\x7d\x88\xb5\x61\xc6\xea\xb8\xf9\x35\x44\xf3\xbe\x41\x31\x6b\x67\x92\xbb\x47\x95\xb7\x87\x40\xa4\x61\x1c\x58\x21\xad\x30\xbe\x4b\x6c\x5c\x3c\x84\xa7\x50\x5b\x6e\x74\xac\x6d\x51\x30\xb5\x8e\xbd\xf4\x16\xfe\xa4\x41\x69\xc3\xbf\x67\xda\x8f\x86\xb3\x20\x4b\x2f\xb4\xed\x73\x93\xd4\x06\x7a\xcb\x88\x9f\x0d\x3a\xf3\x42\x5b\xa0\xb7\x4d\x52\x1b\x68\xb2\xa0\xb2\x3c\x1b\x55\x07\xa9\x2d\xd8\x64\x8b\xd6\x9a\x61\x57\x42\xa8\x94\x54\x90\xa1\x61\xc4\x8f\x83\x7b\x2e\xbd\x85\x75\xd3\x24\xed\x41\x05\x9e\xe5\x15\xe3\xe5\x9c\x5d\x85\x5b\x91\xce\xb1\x60\xfd\x4a\x42\x96\x28\x06\xf7\xa3\x2f\xff\x4d\xb6\xc8\x70\xc0\x44\xd2\xbe\x55\x06\xc6\x4d\xf7\xf5\xd1\x82\xc1\xfd\x68\x23\x59\x2a\x59\xa2\x32\x9b\x7b\x1d\x4e\xa3\x13\x36\xa8\x3b\x38\x6f\x9c\x29\xd5\xf8\xcd\x5c\x0b\xc4\x80\x59\x5d\x52\xcc\x2a\xeb\xc3\xa8\x24\x37\xe1\xdc\xa4\x40\x11\x9a\xdf\x96\x62\x70\x4c\x4c\x80\x9c\x7e\xc7\xd4\xc4\x90\xa0\x72\x6a\x40\xcf\xa5\xe5\x99\xeb\x90\x4b\x54\x06\x14\xa6\x32\x17\xf4\xd7\x46\xb7\xae\xd7\x20\xce\x4c\xdd\x72\x1e\x8f\x6f\x0a\x6e\x1d\x59\x32\x6e\xf1\xd2\x0d\x15\x3f\xc9\x15\x3a\x14\xb0\xa2\xa1\xcf\xb3\xe8\x18\x3e\x49\x85\x7e\x7d\xe9\xfb\x39\xae\xfb\xbd\x5e\x4e\xa6\x9e\x00\xa9\x2c\x0a\x2b\xc8\xac\x7b\x8d\x15\x4a\xf7\x32\x5c\x22\xef\x69\xca\xbb\x4c\xa5\x73\x32\x98\x1a\xab\xb0\xc7\x4a\xea\x7a\xd3\x85\xef\xf6\x71\x91\x75\x54\x35\x33\xf4\x9b\x2d\x5b\xf7\x6a\x21\x1c\xdf\x4c\x4f\x64\xc0\x75\x56\x97\x6a\x56\x89\x06\x2f\x1e\x03\xed\x48\x2e\x3a\x0f\x37\xc9\x04\x6a\x68\x9f\x8c\xdd\xe8\xfb\xb8\x3f\x0a\xea\xc7\x14\xb8\x80\x91\x98\xf9\xd9\xeb\x96\x27\x25\x0b\xaf\x13\x45\x56\x4a\x12\xc6\xff\x49\x39\xa1\xd8\x0d\xbf\xb6\xd3\x82\x4c\xd8\x6c\x7c\xe1\x19\x19\xc3\xd0\x8f\x3f\x98\x22\xd8\x32\x63\x06\xb3\x18\x46\x02\x86\xac\x40\x3e\x64\x6e\xdf\xfa\xc9\x09\x70\x91\xd6\x5d\x17\xd8\xa7\xa5\xa0\x39\xd1\x77\x99\x43\xd4\x1a\x1f\xea\xd1\x7a\x24\x5f\xee\x66\x26\x25\xa6\x5b\xd7\x25\x43\xed\xd7\x3e\xd7\x35\xd0\x5d\x83\xc9\x6e\x21\x1f\xbe\xa3\x10\x36\x87\x19\xe5\xbb\xd4\x1d\xd4\x04\x8d\x2b\x04\xed\x90\xf7\x38\x8f\xeb\xae\xf4\x1b\x14\xe6\xd0\xa7\xa3\x01\xab\x8f\xef\x66\xe7\x0b\x1e\x89\xac\x3b\x28\x96\xfb\x0a\xc9\x60\x71\xd0\xf6\x27\xa0\x30\xa5\xd8\x7a\xe7\x9b\xdb\xbe\x32\x99\x2e\x5a\x82\xfa\xc1\x4e\xf1\x5a\xa6\x8b\x67\x04\x95\x0a\x96\xbf\x72\x64\x36\x5d\xe5\x8c\xf8\x6c\xb9\x53\xaf\xb2\x07\xdd\x69\x73\xa8\xa5\x4e\x5a\x2b\xe5\x54\xad\xb4\x0a\x9f\x88\xca\xa9\x34\x6b\x4c\x15\x1e\x30\xf8\x04\x9a\x46\x8e\x82\x6c\xd1\x7a\xdd\x02\xdb\xaf\x51\x19\x21\xad\x6d\x26\x1f\xcf\xfd\xcf\xeb\x0f\xf5\x03\xf0\x97\x68\x2e\xc7\xfa\xb9\xdf\xe4\xda\x3a\xba\x67\xda\xea\xe9\x72\xaa\xdd\xf4\x7c\x5e\x53\xcf\x28\x47\x7d\x5e\x69\x86\x55\xf3\x2c\x11\xff\xd0\x69\xa9\x0b\x67\x74\xf3\xf9\xf3\x24\xc5\xd5\xce\xbd\xaf\xfa\x74\x29\x1d\x73\x01\x4e\xf5\xb0\x16\x53\x9a\x0c\x87\x9a\x00\xf8\x25\x85\x0c\x8e\x9f\x57\x4e\x4e\xda\x3f\x2c\x0e\xcb\xb6\x75\xcd\xd3\x4e\x3f\xa2\xbb\x35\x36\x47\x75\x84\x2b\xbc\xa4\x5e\xa6\x23\x3c\x01\x5f\xa8\x03\x45\x46\xe2\xc0\x32\x72\x8e\x92\xea\x81\xf6\x32\x25\xfe\x0d\xfd\x32\x15\x56\x84\xeb\xfc\x22\x4b\x4e\x8e\x26\x08\x8f\xb1\x67\x14\x7c\x7b\x59\x01\xa4\x9c\x69\x7d\x6a\xaa\xc2\x53\x6e\x0e\xd4\x25\xfa\x09\xb5\x3e\xda\xaa\xcf\x55\x36\x71\x9c\x2f\xd5\xf4\x0a\xbe\xb5\xa4\xe7\x74\xe3\x38\x21\xec\xf2\x3a\xba\x3e\xab\x19\x2f\x0f\x3d\xb6\x4f\xca\x1c\xc4\xdf\x23\x86\x29\xd4\x07\xa3\x6c\xe8\xe1\xda\x48\xe5\x12\xd9\xa0\xd8\xe9\xde\xf6\x58\x8d\x3d\xf8\xfb\x9f\xe8\xdf\x00\x00\x00\xff\xff\x06\x7a\x70\x11\xbf\x18\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x19\xcb\x72\xe3\x36\xf2\xce\xaf\xe8\xb2\x0e\x49\xaa\x6c\x2a\x93\xdd\xc3\x16\xf7\xa4\xf5\xa3\x56\x35\x33\xb6\xcb\x54\x92\xca\x11\x22\x5b\x14\x62\x10\x60\xd0\x80\x35\xda\xad\xfd\xf7\xad\x06\x49\x99\x92\x45\xea\x61\xe7\x10\xd1\x07\x13\x68\xf4\xfb\x85\xe6\x08\xae\x3e\xee\x17\x8d\xe0\x8b\xcc\x50\x13\xe6\xe0\x0c\xb8\x25\xc2\xa4\x12\xd9\x12\x21\x35\x0b\xb7\x12\x16\xe1\xce\x78\x9d\x0b\x27\x8d\x86\xef\x27\xe9\xdd\x0f\xe0\x75\x8e\x16\x8c\x46\x30\x16\x4a\x63\x31\x1a\x41\x66\xb4\xb3\x72\xee\x9d\xb1\xa0\x6a\x84\x20\x0a\x8b\x58\xa2\x76\x14\x03\xa4\x88\x01\xfb\xfd\xc3\x6c\x7a\x7d\x0b\x0b\xa9\x10\x72\x49\xf5\x21\xcc\x61\x25\xdd\x32\x1a\x81\x5b\x4a\x82\x95\xb1\xcf\xb0\x30\x16\x44\x9e\x4b\x26\x2c\x14\x48\xbd\x30\xb6\xac\xd9\xb0\x58\x08\x9b\x4b\x5d\x40\x66\xaa\xb5\x95\xc5\xd2\x81\x59\x69\xb4\xb4\x94\x55\x1c\x8d\x60\xc6\x62\xa4\x77\x2d\x27\x54\xa3\x0d\x34\x9d\x81\xdf\x8c\x6f\x64\xe8\x88\xdb\x68\xe1\x12\x7e\x41\x4b\x4c\xe4\xa7\xf8\xc7\x68\x04\xdf\x33\xc8\x45\xb3\x79\xf1\xc3\x3f\x61\x6d\x3c\x94\x62\x0d\xda\x38\xf0\x84\x1d\xcc\xf8\x2d\xc3\xca\x81\xd4\x90\x99\xb2\x52\x52\xe8\x0c\x5f\xc5\xda\x50\x88\x21\x30\xc0\x38\xcc\xdc\x09\xa9\x41\x04\x31\xc0\x2c\xba\x60\x20\x5c\x34\x8a\x46\x10\x7e\x4b\xe7\xaa\x64\x3c\x5e\xad\x56\xb1\x08\xd6\x89\x8d\x2d\xc6\xad\x74\xe3\x2f\xd3\xeb\xdb\xfb\xf4\xf6\x2a\xb0\x1c\x8d\xe0\x67\xad\x90\x08\x2c\xfe\xe1\xa5\xc5\x1c\xe6\x6b\x10\x55\xa5\x64\x26\xe6\x0a\x41\x89\x15\x1b\x2e\x58\x27\x18\x5d\x6a\x58\x59\xe9\xa4\x2e\x2e\x81\x1a\xab\x47\xa3\x2d\xeb\xbc\xaa\xab\x65\x4f\xd2\x16\x80\xd1\x20\x34\x5c\x4c\x52\x98\xa6\x17\xf0\xaf\x49\x3a\x4d\x2f\xa3\x11\xfc\x3a\x9d\xfd\xfb\xe1\xe7\x19\xfc\x3a\x79\x7a\x9a\xdc\xcf\xa6\xb7\x29\x3c\x3c\xc1\xf5\xc3\xfd\xcd\x74\x36\x7d\xb8\x4f\xe1\xe1\x0e\x26\xf7\xbf\xc1\xe7\xe9\xfd\xcd\x25\xa0\x74\x4b\xb4\x80\xdf\x2a\xcb\xfc\x1b\x0b\x92\x15\x89\x39\xdb\xb4\x75\xa0\x96\x01\xf6\x0f\x7e\xa7\x0a\x33\xb9\x90\x19\x28\xa1\x0b\x2f\x0a\x84\xc2\xbc\xa0\xd5\xec\x1e\x15\xda\x52\x12\x9b\x93\x40\xe8\x3c\x1a\x81\x92\xa5\x74\xc1\x8b\xe8\xad\x50\x4c\xa6\x0d\x8c\x0f\xf8\x45\x91\xa8\x64\xe3\x4e\x09\x88\x4a\xe2\x37\x87\x3a\x70\x13\x3f\xff\x83\x62\x69\xc6\x2f\x9f\xa2\x67\xa9\xf3\x04\xae\x3d\x39\x53\x3e\x21\x19\x6f\x33\xbc\xc1\x85\xd4\xc1\xf3\xa3\x12\x9d\xc8\x85\x13\x49\x04\xa0\xc4\x1c\x15\xf1\x7f\xc0\x06\x4d\x60\x2d\x9e\x29\x02\x10\x5a\x9b\x46\xa8\x7a\x33\x44\xa3\x51\x0a\xed\x55\x81\x3a\x7e\xf6\x73\x9c\x7b\xa9\x72\xb4\x81\x68\xcb\xd2\xcb\x8f\xf1\xdf\xe3\x4f\x11\x40\x66\x31\x1c\x9f\xc9\x12\xc9\x89\xb2\x4a\x40\x7b\xa5\x22\x00\x2d\x4a\x4c\xc0\x21\x39\x8a\x99\x5a\x9c\x49\x67\x3d\x2d\xac\x28\x91\xc3\x94\x1d\x31\x62\x13\x30\xe1\xc2\x1a\xdf\x70\xb5\x17\xae\x46\xd7\x08\x90\x09\x87\x85\xb1\xb2\x7d\xbf\x6a\xa5\xe1\x7f\x99\xa0\xd4\x45\x00\xac\x15\x34\x43\x72\xe1\x55\x49\x72\x9f\x37\x4b\x5f\x64\xb3\x5c\x29\x6f\x85\x6a\x58\x0d\x2b\x24\x75\xe1\x95\xb0\xf5\x5a\x04\x40\x99\xa9\x30\x81\x7b\x51\x22\x55\x22\xc3\x3c\x02\x68\x74\x11\x78\xb8\xea\xe4\x9b\x47\x2b\xb5\x43\x7b\x6d\x94\x2f\x5b\xad\x5e\x41\x8e\x94\x59\x59\xb1\xaa\x92\x90\x64\x18\x33\x54\x4b\x41\x18\x48\x02\xfc\x4e\x46\x3f\x0a\xb7\x4c\x20\x26\x27\x9c\xa7\xb8\xbb\xcb\xe2\x27\xf0\xd8\x59\x71\x6b\x66\x89\xd3\xa0\x2e\x7a\x89\x18\x27\x14\x88\xd2\x78\xed\x42\x96\xd8\x88\xb8\x8f\x9e\x45\xf2\xca\x51\x4c\xbe\x2c\x85\x5d\xc7\xe1\x74\x03\x5d\xd3\x9f\x75\x56\x0e\xd1\x7f\x14\x14\x4a\xc3\x49\x24\xab\x70\x68\x5b\xe6\xee\xd2\x21\xa2\x77\x42\xaa\x93\x89\x2e\xc2\xa1\x06\xbc\x16\xf4\xae\xbb\x74\x88\x68\xfa\x2c\xab\xea\x64\xaa\x54\x9f\x6a\xe0\x6b\xb2\xe9\xd6\xda\x21\xba\xec\xd8\x80\xd6\x1a\x0b\x39\x3a\x21\x55\x3f\xf1\x00\xd5\x6e\xd7\xb4\x6e\xbb\x4b\x6f\x48\xd5\x30\x2f\x9f\x84\xaa\x96\x82\x03\x9d\x83\x60\x89\x65\xc8\x26\xfc\x66\x2a\xd4\x93\xc7\xe9\x2f\x7f\x4b\xb7\x96\x61\x0f\x8b\x92\xab\x28\x42\x0d\xb8\xc9\xbe\x1c\x00\x04\x93\xc7\xe9\xe6\x64\x65\x4d\x85\xd6\x6d\xe2\xba\xfe\xeb\x64\xc2\xce\xea\x0e\x9d\xef\x98\x95\xa6\xfc\xe6\x9c\x02\xb1\xa6\xd9\x04\x29\xe6\x0d\xf7\x21\x08\xb8\xa2\x5b\xe4\x4a\x81\xba\x4e\x7e\x5b\x88\x81\x81\x84\x06\x33\xff\x1d\x33\x17\x43\x8a\x96\xd1\x00\x2d\x8d\x57\x39\xf7\x2b\x2f\x68\x1d\x58\xcc\x4c\xa1\xe5\x7f\x36\xb8\xa9\x6d\x83\x94\x68\xd2\x46\xf7\x09\x49\x41\x0b\x05\x2f\x42\x79\xbc\xe4\xa2\x12\xba\x01\x8b\x4c\x05\xbc\xee\xe0\x0b\x20\x14\xc3\x57\x63\x31\xb4\x2f\x49\xa8\xe3\x94\x8c\xc7\x85\x74\x6d\x05\xc8\x4c\x59\x7a\x2d\xdd\x7a\xdc\x69\xa1\x68\x9c\xe3\x0b\xaa\x31\xc9\xe2\x4a\xd8\x6c\x29\x1d\x66\xce\x5b\x1c\x8b\x4a\x5e\x05\xd6\x35\x0b\x4c\x71\x99\x8f\x6c\x53\x33\xe8\xbb\x2d\x5e\xdf\xf8\x42\xfd\x17\x92\xe9\x80\x05\x38\xb3\x82\x24\x10\xcd\xd1\x5a\xd0\x57\x45\xf3\x12\x6b\xe7\xe9\x36\x9d\x41\x4b\x3a\x34\x41\x5b\x48\xa1\xd1\xfb\xeb\x41\x7a\x35\x01\x2b\x4c\xea\x45\xa8\xbd\xdc\x3c\x59\x53\x06\x33\xa3\xce\x2b\x23\xb5\x0b\x2f\x99\x92\xa8\x77\xd5\x4f\x7e\x5e\x4a\xc7\x76\xff\xc3\x07\xc7\x73\x26\x86\xeb\x50\xfe\x60\x8e\xe0\xab\x5c\x38\xcc\x63\x98\x6a\xb8\x16\x25\xaa\x6b\x41\xf8\xa7\x1b\x80\x35\x4d\x57\xac\xd8\xe3\x4c\xd0\xad\xe8\xaf\x3f\xc6\x92\x34\x5a\xeb\x6c\xb4\xa5\xb5\xc7\x5e\x1c\x99\x69\x85\xd9\x56\xb8\xe4\x48\xa1\xed\xe3\x94\x85\x1c\x06\x9b\xda\x39\x1c\xa3\x4d\xe7\xb0\x90\xc5\xee\xea\x0e\xd5\x14\x1d\x77\x8b\xc4\x94\xdf\x40\xf6\xe3\x6e\x3b\x13\xd4\x6e\xdf\x56\xaf\xc2\xda\x27\x64\xb3\xd3\x0f\xf6\x68\x96\xff\x50\xbf\xbc\xe5\x44\x3a\x2c\xf7\xf2\x7e\x04\x15\x61\xad\x58\xef\xec\x71\xf7\x95\x9b\xec\xf9\x80\x52\x3f\xfb\x39\xde\x98\xec\xf9\x0c\xa5\x0a\x5b\xec\x5d\xdf\xa1\x30\xb1\x05\x01\xdf\xe8\x44\x9e\xbf\x5e\xf7\x84\x2d\x7c\xb8\xa4\xb5\x17\x90\x96\xdf\xbd\x08\x01\x28\x24\xd2\xbd\x9b\xbd\x9a\x3b\xa8\xbd\x61\x0d\xf2\x23\x4b\x51\x7c\xb0\xf5\x4b\xf1\x82\xfa\x80\x55\xbe\x32\xcc\x19\x26\xa9\xb5\x44\xc9\xe9\x7a\xda\x43\x3e\xe0\x62\x26\xc0\xe2\x02\x2d\xea\xac\x89\xf4\xcc\x62\xce\x99\x48\x6c\x9a\x86\xb7\x0f\x97\xc1\x1a\x0f\x27\x63\x43\xd2\x19\xbb\x8e\x43\xf7\x4a\x98\x59\x74\xb0\x34\x2a\xaf\xf1\x79\x42\xcb\x51\xc6\x75\xad\x17\x21\x77\x77\x2b\x63\x73\x40\x4e\x97\x48\x71\x0f\xe4\xb0\x82\x1a\x4d\xec\x14\xa3\x13\x0c\xdb\x3e\xb5\x10\xef\x44\x33\xe0\x25\x87\x9d\x73\xe0\x70\x5b\x21\x9f\x70\xb1\x47\x0b\xbd\x7e\xb0\xe5\x03\xed\xa5\xf0\x09\x17\xbb\x3e\x20\xe0\xda\xe8\x85\x2c\xbe\x8a\x0a\x8c\x85\x34\xa8\x62\x0f\x3e\x80\xd5\xd2\x10\xb6\x16\x0b\x49\x20\x5c\x27\x30\x07\x41\xa1\xe5\xdd\x14\x73\x8a\x61\xa2\x54\x0b\xba\x17\x59\xf7\xf8\x6a\x89\x1a\xb4\x81\x67\x5c\x73\xdf\x50\xc8\x17\xd4\xd1\xe9\xce\xf0\x8c\xeb\xfd\x1b\x47\x98\xef\x6d\x4f\x73\xc2\xe1\xfe\xa2\x72\xf0\xf0\xa0\xd3\xf4\x3b\xcc\x46\xd1\xef\x75\x88\xbd\x69\xe9\xb0\xaa\x07\x4b\xf0\x41\xa1\xb9\x68\x66\x86\x87\x6f\x7f\x21\x95\xf7\xe5\x88\x01\x6a\x84\x0a\xb5\xf4\x65\x12\x0d\xda\x22\x6d\xc0\xce\x28\x11\xe7\x56\x34\xf6\x20\x91\xaf\x79\x56\x63\x7c\x8f\x19\x77\xfc\xe5\x15\x1c\x72\x54\x62\x4d\x9b\xbb\x1b\x90\x13\xd6\x81\xd7\x4e\xaa\x8d\x30\x7b\x51\x02\x88\x8c\x07\x9c\x04\x84\xf5\x48\xed\x12\x30\x2e\x62\xb8\xf8\xa9\xbc\x38\x5d\x8a\x01\x4b\xd6\xde\x9d\x44\x83\x42\xa5\xfd\x21\xf0\xe7\x75\xa0\xed\x88\xf1\xac\xc3\xfd\x6e\x7f\x9e\xa2\x7a\x36\xb8\xeb\xf7\x3b\x92\x6f\x69\x8e\x6f\x03\x69\x00\xda\xba\x35\x98\x79\xe8\x59\xce\xbb\x36\xe4\xb2\x40\xda\xa3\xd3\x01\xc9\xea\x61\xc6\x49\x47\xc2\x28\xed\x80\x5f\xb0\x74\xdd\x01\xdb\x51\x88\x9b\xa9\x4e\x72\xa2\x2b\xf5\x89\x30\x98\xca\x0f\xb0\x72\x28\x95\xf1\xc3\x43\xa7\x27\x14\xb4\x3b\x4c\xd9\xab\x90\x74\x03\x0c\x0e\x95\x22\x58\x2d\xd7\xaf\xe1\xbf\x14\x14\x3e\x65\xcc\x91\xfb\x43\xaf\x07\xb8\xe9\x65\x37\xa4\x10\x4e\x47\xfb\xb9\xa9\xbf\xdb\x24\xc0\x77\xf3\x2b\x27\x4b\x3c\x8b\x86\x97\x0e\xef\xcf\x8b\x20\x9e\x1b\x84\x59\xe8\xfe\xb3\xc3\x36\x3e\x64\xe7\x57\xea\x3c\x1b\x2a\x7a\x6e\x46\x00\xf5\x78\xf2\x7d\x38\xb8\xf3\x7e\x37\x0e\xd4\x43\xc5\xfb\x38\x24\xcd\xd4\xf3\x9d\x48\x1c\x56\x03\x4a\xdd\x76\x62\x87\x55\x5a\x8f\x5c\x3b\xd7\x95\x6b\x9f\xf9\x72\x8e\x16\xc8\x61\x15\xf2\x96\x24\x27\xb3\xfe\xbb\x50\x73\x1b\xda\x33\xd8\x3b\xc5\x1d\x8e\x31\xe7\xb1\x4a\x38\xc6\xac\x27\xe1\x3a\x64\xde\x53\x90\x1d\x34\xf3\x29\xc8\x1c\x7f\x75\xf8\x18\x54\xfc\xe1\x8e\xc7\x5d\x1f\xc2\x59\x6f\x7d\x3d\x9a\xf5\x63\xe8\x1c\xc1\xf2\x61\x34\x07\x58\xa5\x35\x39\x2c\x1f\xbc\x3b\x2b\x47\x72\x50\x9c\x53\xcb\x8e\x89\x97\x4c\x09\xa2\xa1\xb6\xff\x08\xfe\x3a\xa9\xf8\x2b\x12\x89\xe2\x83\x90\xcd\xd6\xd5\xfb\x31\x7d\x80\x6c\x07\xfd\x70\xa8\x27\x18\x38\xcc\x76\x9d\xde\x24\xd1\x09\x2c\x35\xdf\x5b\x4e\x38\xb3\x97\xfe\x9b\xc5\xba\xc1\x4c\xc0\x59\x5f\x37\x01\xe4\x8c\x65\x43\x76\x56\xfc\xfc\xcd\xfd\x98\x9c\x70\x9e\x12\xf8\xef\xff\xa2\xff\x0f\x00\x17\x70\xb8\x2e\xfc\x22\x00\x00"),
 		},
 		"/default": &vfsgen۰DirInfo{
 			name:    "default",
@@ -77,7 +77,7 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 1031,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xda\x40\x10\xbd\xef\xaf\x78\xc2\x97\x44\x22\xd0\xf6\x48\x4f\x6e\x02\xaa\xd5\x08\xa4\x98\x34\xca\x71\xb0\x07\x7b\x14\x7b\xd7\x9d\x5d\xe3\xf0\xef\xab\x35\xd0\x24\xea\x35\x7b\x43\x33\xbc\x8f\x79\xcf\x09\x6e\x3e\xef\x99\x04\xf7\x52\xb0\xf5\x5c\x22\x38\x84\x9a\x91\x76\x54\xd4\x8c\xdc\xed\xc3\x40\xca\x58\xb9\xde\x96\x14\xc4\x59\x5c\xa5\xf9\xea\x1a\xbd\x2d\x59\xe1\x2c\xc3\x29\x5a\xa7\x6c\x12\x14\xce\x06\x95\x5d\x1f\x9c\xa2\x39\x01\x82\x2a\x65\x6e\xd9\x06\x3f\x03\x72\xe6\x11\x7d\xbd\xd9\x66\xb7\x4b\xec\xa5\x61\x94\xe2\x4f\x7f\xe2\x12\x83\x84\xda\x24\x08\xb5\x78\x0c\x4e\x5f\xb0\x77\x0a\x2a\x4b\x89\xc4\xd4\x40\xec\xde\x69\x7b\x92\xa1\x5c\x91\x96\x62\x2b\x14\xae\x3b\xaa\x54\x75\x80\x1b\x2c\xab\xaf\xa5\x9b\x99\x04\xdb\x68\x23\x5f\x5d\x94\xf8\x13\xec\xc8\x19\x1c\x9e\x5d\x7f\xf6\xf0\xce\xee\xf9\x0a\x53\xfc\x66\xf5\x91\xe4\xdb\xec\x8b\x49\x70\x15\x57\x26\xe7\xe1\xe4\xfa\x3b\x8e\xae\x47\x4b\x47\x58\x17\xd0\x7b\x7e\x87\xcc\xaf\x05\x77\x01\x62\x51\xb8\xb6\x6b\x84\x6c\xc1\x6f\xb6\xfe\x31\xcc\x30\x0a\x88\x18\x6e\x17\x48\x2c\x68\xb4\x01\xb7\x7f\xbf\x06\x0a\x26\x31\x09\xc6\x57\x87\xd0\x2d\xe6\xf3\x61\x18\x66\x34\xca\x9d\x39\xad\xe6\x17\x77\xf3\xfb\xec\x76\xb9\xce\x97\x37\xa3\x64\x93\xe0\xd1\x36\xec\x3d\x94\xff\xf4\xa2\x5c\x62\x77\x04\x75\x5d\x23\x05\xed\x1a\x46\x43\x43\x0c\x6e\x4c\x67\x0c\x5d\x2c\x06\x95\x20\xb6\x9a\xc2\x9f\x53\x37\xc9\x87\x74\xde\xce\x75\x91\x27\xfe\xc3\x82\xb3\x20\x8b\x49\x9a\x23\xcb\x27\xf8\x91\xe6\x59\x3e\x35\x09\x9e\xb2\xed\xcf\xcd\xe3\x16\x4f\xe9\xc3\x43\xba\xde\x66\xcb\x1c\x9b\x07\xdc\x6e\xd6\x77\xd9\x36\xdb\xac\x73\x6c\x56\x48\xd7\xcf\xf8\x95\xad\xef\xa6\x60\x09\x35\x2b\xf8\xb5\xd3\xa8\xdf\x29\x24\x1e\x92\xcb\x98\xe9\xa5\x40\x17\x01\xb1\x1f\xf1\xb7\xef\xb8\x90\xbd\x14\x68\xc8\x56\x3d\x55\x8c\xca\x1d\x58\x6d\xac\x47\xc7\xda\x8a\x8f\x71\x7a\x90\x2d\x4d\x82\x46\x5a\x09\x63\x8b\xfc\xff\xa6\x22\xcd\x67\x7e\x5b\x86\x3a\x39\xd7\x69\x81\xc3\x57\xf3\x22\xb6\x5c\x20\x67\x3d\x48\xc1\x69\x51\xb8\xde\x06\xd3\x72\xa0\x92\x02\x2d\x0c\x60\xa9\xe5\x05\x8e\xf4\xe2\x6f\x0e\xc2\x03\xab\x01\x1a\xda\x71\xe3\xe3\x14\x31\xc5\x05\x26\x71\x3e\x31\x7f\x03\x00\x00\xff\xff\xf6\xdc\xe5\x24\x07\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xda\x40\x10\xbd\xef\xaf\x78\xc2\x97\x44\x22\xd0\xf6\x48\x4f\x6e\x02\xaa\xd5\xc8\x48\x31\x69\x94\xe3\xe0\x1d\xec\x11\xf6\xae\xbb\xbb\xc6\xe1\xdf\x57\x6b\xa0\x21\xea\x35\x73\x43\x3b\xbc\x8f\x79\xcf\x09\xee\x3e\x6f\x54\x82\x47\x29\xd9\x78\xd6\x08\x16\xa1\x66\xa4\x1d\x95\x35\xa3\xb0\xbb\x30\x90\x63\xac\x6c\x6f\x34\x05\xb1\x06\x37\x69\xb1\xba\x45\x6f\x34\x3b\x58\xc3\xb0\x0e\xad\x75\xac\x12\x94\xd6\x04\x27\xdb\x3e\x58\x87\xe6\x04\x08\xaa\x1c\x73\xcb\x26\xf8\x19\x50\x30\x8f\xe8\xf9\x7a\x93\xdd\x2f\xb1\x93\x86\xa1\xc5\x9f\xfe\xc4\x1a\x83\x84\x5a\x25\x08\xb5\x78\x0c\xd6\xed\xb1\xb3\x0e\xa4\xb5\x44\x62\x6a\x20\x66\x67\x5d\x7b\x92\xe1\xb8\x22\xa7\xc5\x54\x28\x6d\x77\x74\x52\xd5\x01\x76\x30\xec\x7c\x2d\xdd\x4c\x25\xd8\x44\x1b\xc5\xea\xa2\xc4\x9f\x60\x47\xce\x60\xf1\x6a\xfb\xb3\x87\x2b\xbb\xe7\x2b\x4c\xf1\x9b\x9d\x8f\x24\xdf\x66\x5f\x54\x82\x9b\xb8\x32\x39\x3f\x4e\x6e\xbf\xe3\x68\x7b\xb4\x74\x84\xb1\x01\xbd\xe7\x2b\x64\x7e\x2b\xb9\x0b\x10\x83\xd2\xb6\x5d\x23\x64\x4a\x7e\xb7\xf5\x8f\x61\x86\x51\x40\xc4\xb0\xdb\x40\x62\x40\xa3\x0d\xd8\xdd\xf5\x1a\x28\xa8\x44\x25\x18\xa7\x0e\xa1\x5b\xcc\xe7\xc3\x30\xcc\x68\x4c\x67\x66\x5d\x35\xbf\xb8\x9b\x3f\x66\xf7\xcb\xbc\x58\xde\x8d\x92\x55\x82\x67\xd3\xb0\xf7\x70\xfc\xa7\x17\xc7\x1a\xdb\x23\xa8\xeb\x1a\x29\x69\xdb\x30\x1a\x1a\x62\x70\x63\x3a\x63\xe8\x62\x30\x38\x09\x62\xaa\x29\xfc\x39\x75\x95\x7c\x48\xe7\xfd\x5c\x17\x79\xe2\x3f\x2c\x58\x03\x32\x98\xa4\x05\xb2\x62\x82\x1f\x69\x91\x15\x53\x95\xe0\x25\xdb\xfc\x5c\x3f\x6f\xf0\x92\x3e\x3d\xa5\xf9\x26\x5b\x16\x58\x3f\xe1\x7e\x9d\x3f\x64\x9b\x6c\x9d\x17\x58\xaf\x90\xe6\xaf\xf8\x95\xe5\x0f\x53\xb0\x84\x9a\x1d\xf8\xad\x73\x51\xbf\x75\x90\x78\x48\xd6\x31\xd3\x4b\x81\x2e\x02\x62\x3f\xe2\x6f\xdf\x71\x29\x3b\x29\xd1\x90\xa9\x7a\xaa\x18\x95\x3d\xb0\x33\xb1\x1e\x1d\xbb\x56\x7c\x8c\xd3\x83\x8c\x56\x09\x1a\x69\x25\x8c\x2d\xf2\xff\x9b\x8a\x34\x97\x0f\xe3\x13\x46\x29\xea\xe4\x5c\xa7\x05\x0e\x5f\xd5\x5e\x8c\x5e\xa0\x60\x77\x90\x92\xd3\xb2\xb4\xbd\x09\xaa\xe5\x40\x9a\x02\x2d\x14\x60\xa8\xe5\x05\x8e\xb4\xf7\x77\x07\xe1\x81\x9d\x02\x1a\xda\x72\xe3\xe3\x2b\x62\x8a\x0b\x4c\x8e\xb4\xf7\x13\xf5\x77\x00\xf6\xdc\xe5\x24\x07\x04\x00\x00"),
 		},
 		"/infrastructure/rbac": &vfsgen۰DirInfo{
 			name:    "rbac",
@@ -88,70 +88,70 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 1203,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x6f\xfa\x46\x10\xc5\xef\xfb\x29\x9e\xf0\xe5\x1f\x09\x4c\xdb\x53\x45\x4f\x4e\x02\xad\xd5\x08\x24\x4c\x1a\xe5\xb8\xac\x07\x7b\x8a\xbd\xe3\xee\xae\x71\xe8\xa7\xaf\xd6\x40\x93\xa8\x55\x7b\xc9\xde\x2c\x8f\xdf\xfc\xde\xbe\xe7\x04\xb3\xaf\x3b\x2a\xc1\x13\x1b\xb2\x9e\x4a\x04\x41\xa8\x09\x59\xa7\x4d\x4d\x28\xe4\x10\x06\xed\x08\x2b\xe9\x6d\xa9\x03\x8b\xc5\xb7\xac\x58\xdd\xa1\xb7\x25\x39\x88\x25\x88\x43\x2b\x8e\x54\x02\x23\x36\x38\xde\xf7\x41\x1c\x9a\x8b\x20\x74\xe5\x88\x5a\xb2\xc1\xa7\x40\x41\x34\xaa\xaf\x37\xbb\xfc\x61\x89\x03\x37\x84\x92\xfd\xe5\x23\x2a\x31\x70\xa8\x55\x82\x50\xb3\xc7\x20\xee\x88\x83\x38\xe8\xb2\xe4\xb8\x58\x37\x60\x7b\x10\xd7\x5e\x30\x1c\x55\xda\x95\x6c\x2b\x18\xe9\xce\x8e\xab\x3a\x40\x06\x4b\xce\xd7\xdc\xa5\x2a\xc1\x2e\xda\x28\x56\x37\x12\x7f\x91\x1d\x77\x06\xc1\xab\xf4\x57\x0f\x1f\xec\x5e\x6f\x61\x8a\xdf\xc8\xf9\xb8\xe4\x87\xf4\x3b\x95\xe0\x5b\x1c\x99\x5c\x5f\x4e\xee\x7e\xc2\x59\x7a\xb4\xfa\x0c\x2b\x01\xbd\xa7\x0f\xca\xf4\x66\xa8\x0b\x60\x0b\x23\x6d\xd7\xb0\xb6\x86\xde\x6d\xfd\xbd\x21\xc5\x08\x10\x35\x64\x1f\x34\x5b\xe8\xd1\x06\xe4\xf0\x71\x0c\x3a\xa8\x44\x25\x18\x4f\x1d\x42\xb7\x98\xcf\x87\x61\x48\xf5\x88\x9b\x8a\xab\xe6\x37\x77\xf3\xa7\xfc\x61\xb9\x2e\x96\xb3\x11\x59\x25\x78\xb6\x0d\x79\x0f\x47\x7f\xf4\xec\xa8\xc4\xfe\x0c\xdd\x75\x0d\x1b\xbd\x6f\x08\x8d\x1e\x62\x70\x63\x3a\x63\xe8\x6c\x31\x38\x0e\x6c\xab\x29\xfc\x35\x75\x95\x7c\x4a\xe7\xfd\xba\x6e\x78\xec\x3f\x0d\x88\x85\xb6\x98\x64\x05\xf2\x62\x82\xfb\xac\xc8\x8b\xa9\x4a\xf0\x92\xef\x7e\xd9\x3c\xef\xf0\x92\x6d\xb7\xd9\x7a\x97\x2f\x0b\x6c\xb6\x78\xd8\xac\x1f\xf3\x5d\xbe\x59\x17\xd8\xac\x90\xad\x5f\xf1\x6b\xbe\x7e\x9c\x82\x38\xd4\xe4\x40\x6f\x9d\x8b\xfc\xe2\xc0\xf1\x22\xa9\x8c\x99\xde\x0a\x74\x03\x88\xfd\x88\xcf\xbe\x23\xc3\x07\x36\x68\xb4\xad\x7a\x5d\x11\x2a\x39\x91\xb3\xb1\x1e\x1d\xb9\x96\x7d\x8c\xd3\x43\xdb\x52\x25\x68\xb8\xe5\x30\xb6\xc8\xff\xd3\x54\x5c\xf3\x95\xff\x96\x3a\xb2\x2d\x17\xd8\x4a\x43\xf7\x6c\x63\x61\x95\xee\xf8\x5a\xb0\x05\xdc\x5e\x9b\x54\xf7\xa1\x16\xc7\x7f\x8e\x4c\xe9\xf1\x47\x9f\xb2\xcc\x4f\xdf\xab\x96\x82\x2e\x75\xd0\x0b\x05\x58\xdd\xd2\x02\x67\x7d\xf4\xb3\x13\xd3\x40\x6e\x66\x74\x4b\xcd\xec\xa8\x80\x46\xef\xa9\xf1\x71\x0a\x31\xe4\x05\x26\x71\x6e\xa2\x7c\xbf\xff\x9d\x4c\xf0\x0b\x35\xc3\x05\xa3\x20\x77\x62\x43\x99\x31\xd2\xdb\xf0\x6f\xb2\xca\x49\x43\x5b\x3a\x44\xb5\x77\xf4\xff\x01\xd0\x1d\xff\xec\xa4\xef\xfe\xc3\x8f\xfa\x2b\x00\x00\xff\xff\x74\xd4\x85\x60\xb3\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x6f\xfa\x46\x10\xc5\xef\xfb\x29\x9e\xf0\xe5\x1f\x09\x4c\xdb\x53\x45\x4f\x4e\x02\xad\xd5\x08\x24\x4c\x1a\xe5\xb8\xac\x07\x7b\x8a\xbd\xe3\xee\xae\x71\xe8\xa7\xaf\xd6\x40\x93\xa8\x55\x7b\xc9\xde\x2c\x8f\xdf\xfc\xde\xbe\xe7\x04\xb3\xaf\x3b\x2a\xc1\x13\x1b\xb2\x9e\x4a\x04\x41\xa8\x09\x59\xa7\x4d\x4d\x28\xe4\x10\x06\xed\x08\x2b\xe9\x6d\xa9\x03\x8b\xc5\xb7\xac\x58\xdd\xa1\xb7\x25\x39\x88\x25\x88\x43\x2b\x8e\x54\x02\x23\x36\x38\xde\xf7\x41\x1c\x9a\x8b\x20\x74\xe5\x88\x5a\xb2\xc1\xa7\x40\x41\x34\xaa\xaf\x37\xbb\xfc\x61\x89\x03\x37\x84\x92\xfd\xe5\x23\x2a\x31\x70\xa8\x55\x82\x50\xb3\xc7\x20\xee\x88\x83\x38\xe8\xb2\xe4\xb8\x58\x37\x60\x7b\x10\xd7\x5e\x30\x1c\x55\xda\x95\x6c\x2b\x18\xe9\xce\x8e\xab\x3a\x40\x06\x4b\xce\xd7\xdc\xa5\x2a\xc1\x2e\xda\x28\x56\x37\x12\x7f\x91\x1d\x77\x06\xc1\xab\xf4\x57\x0f\x1f\xec\x5e\x6f\x61\x8a\xdf\xc8\xf9\xb8\xe4\x87\xf4\x3b\x95\xe0\x5b\x1c\x99\x5c\x5f\x4e\xee\x7e\xc2\x59\x7a\xb4\xfa\x0c\x2b\x01\xbd\xa7\x0f\xca\xf4\x66\xa8\x0b\x60\x0b\x23\x6d\xd7\xb0\xb6\x86\xde\x6d\xfd\xbd\x21\xc5\x08\x10\x35\x64\x1f\x34\x5b\xe8\xd1\x06\xe4\xf0\x71\x0c\x3a\xa8\x44\x25\x18\x4f\x1d\x42\xb7\x98\xcf\x87\x61\x48\xf5\x98\x4e\x2a\xae\x9a\xdf\xdc\xcd\x9f\xf2\x87\xe5\xba\x58\xce\x46\x64\x95\xe0\xd9\x36\xe4\x3d\x1c\xfd\xd1\xb3\xa3\x12\xfb\x33\x74\xd7\x35\x6c\xf4\xbe\x21\x34\x7a\x88\xc1\x8d\xe9\x8c\xa1\xb3\xc5\xe0\x38\xb0\xad\xa6\xf0\xd7\xd4\x55\xf2\x29\x9d\xf7\xeb\xba\xe1\xb1\xff\x34\x20\x16\xda\x62\x92\x15\xc8\x8b\x09\xee\xb3\x22\x2f\xa6\x2a\xc1\x4b\xbe\xfb\x65\xf3\xbc\xc3\x4b\xb6\xdd\x66\xeb\x5d\xbe\x2c\xb0\xd9\xe2\x61\xb3\x7e\xcc\x77\xf9\x66\x5d\x60\xb3\x42\xb6\x7e\xc5\xaf\xf9\xfa\x71\x0a\xe2\x50\x93\x03\xbd\x75\x2e\xf2\x8b\x03\xc7\x8b\xa4\x32\x66\x7a\x2b\xd0\x0d\x20\xf6\x23\x3e\xfb\x8e\x0c\x1f\xd8\xa0\xd1\xb6\xea\x75\x45\xa8\xe4\x44\xce\xc6\x7a\x74\xe4\x5a\xf6\x31\x4e\x0f\x6d\x4b\x95\xa0\xe1\x96\xc3\xd8\x22\xff\x4f\x53\x71\xcd\xed\xc7\xf8\x82\xa3\xd4\x91\x6d\xb9\xc0\x56\x1a\xba\x67\x1b\x0b\xab\x74\xc7\xd7\x82\x2d\xe0\xf6\xda\xa4\xba\x0f\xb5\x38\xfe\x73\x64\x4a\x8f\x3f\xfa\x94\x65\x7e\xfa\x5e\xb5\x14\x74\xa9\x83\x5e\x28\xc0\xea\x96\x16\x38\xeb\xa3\x9f\x9d\x98\x06\x72\x33\xa3\x5b\x6a\x66\x47\x05\x34\x7a\x4f\x8d\x8f\x53\x88\x21\x2f\x30\x89\x73\x13\xe5\xfb\xfd\xef\x64\x82\x5f\xa8\x19\x2e\x18\x05\xb9\x13\x1b\xca\x8c\x91\xde\x86\x7f\x93\x55\x4e\x1a\xda\xd2\x21\xaa\xbd\xa3\xff\x0f\x80\xee\xf8\x67\x27\x7d\xf7\x1f\x7e\xd4\x5f\x03\x00\x74\xd4\x85\x60\xb3\x04\x00\x00"),
 		},
 		"/infrastructure/rbac/viewer-role-binding-knative.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "viewer-role-binding-knative.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1203,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x6f\xda\x4a\x14\x85\xf7\xf3\x2b\x8e\xf0\x26\x91\xc0\xbc\xf7\x56\x4f\x74\xe5\x24\xd0\x5a\x8d\x40\xc2\xa4\x51\x96\x83\x7d\xb1\x6f\xb1\xe7\xba\x33\x63\x1c\xfa\xeb\xab\x31\xd0\x24\x6a\xd5\x6e\x32\x3b\xcb\xd7\xe7\x7e\x67\xce\x71\x84\xc9\xfb\x1d\x15\xe1\x9e\x73\x32\x8e\x0a\x78\x81\xaf\x08\x49\xab\xf3\x8a\x90\xc9\xce\xf7\xda\x12\x16\xd2\x99\x42\x7b\x16\x83\xab\x24\x5b\x5c\xa3\x33\x05\x59\x88\x21\x88\x45\x23\x96\x54\x84\x5c\x8c\xb7\xbc\xed\xbc\x58\xd4\x27\x41\xe8\xd2\x12\x35\x64\xbc\x8b\x81\x8c\x68\x50\x5f\xae\x36\xe9\xed\x1c\x3b\xae\x09\x05\xbb\xd3\x47\x54\xa0\x67\x5f\xa9\x08\xbe\x62\x87\x5e\xec\x1e\x3b\xb1\xd0\x45\xc1\x61\xb1\xae\xc1\x66\x27\xb6\x39\x61\x58\x2a\xb5\x2d\xd8\x94\xc8\xa5\x3d\x5a\x2e\x2b\x0f\xe9\x0d\x59\x57\x71\x1b\xab\x08\x9b\x60\x23\x5b\x5c\x48\xdc\x49\x76\xd8\xe9\x05\x4f\xd2\x9d\x3d\xbc\xb2\x7b\xbe\x85\x31\xbe\x90\x75\x61\xc9\x7f\xf1\x3f\x2a\xc2\x55\x18\x19\x9d\x5f\x8e\xae\x3f\xe0\x28\x1d\x1a\x7d\x84\x11\x8f\xce\xd1\x2b\x65\x7a\xce\xa9\xf5\x60\x83\x5c\x9a\xb6\x66\x6d\x72\x7a\xb1\xf5\x73\x43\x8c\x01\x20\x68\xc8\xd6\x6b\x36\xd0\x83\x0d\xc8\xee\xf5\x18\xb4\x57\x91\x8a\x30\x9c\xca\xfb\x76\x36\x9d\xf6\x7d\x1f\xeb\x01\x37\x16\x5b\x4e\x2f\xee\xa6\xf7\xe9\xed\x7c\x99\xcd\x27\x03\xb2\x8a\xf0\x60\x6a\x72\x0e\x96\xbe\x75\x6c\xa9\xc0\xf6\x08\xdd\xb6\x35\xe7\x7a\x5b\x13\x6a\xdd\x87\xe0\x86\x74\x86\xd0\xd9\xa0\xb7\xec\xd9\x94\x63\xb8\x73\xea\x2a\x7a\x93\xce\xcb\x75\x5d\xf0\xd8\xbd\x19\x10\x03\x6d\x30\x4a\x32\xa4\xd9\x08\x37\x49\x96\x66\x63\x15\xe1\x31\xdd\x7c\x5a\x3d\x6c\xf0\x98\xac\xd7\xc9\x72\x93\xce\x33\xac\xd6\xb8\x5d\x2d\xef\xd2\x4d\xba\x5a\x66\x58\x2d\x90\x2c\x9f\xf0\x39\x5d\xde\x8d\x41\xec\x2b\xb2\xa0\xe7\xd6\x06\x7e\xb1\xe0\x70\x91\x54\x84\x4c\x2f\x05\xba\x00\x84\x7e\x84\x67\xd7\x52\xce\x3b\xce\x51\x6b\x53\x76\xba\x24\x94\x72\x20\x6b\x42\x3d\x5a\xb2\x0d\xbb\x10\xa7\x83\x36\x85\x8a\x50\x73\xc3\x7e\x68\x91\xfb\xd5\x54\x58\xf3\x9e\xff\x96\xda\xb3\x29\x66\x58\x4b\x4d\x37\x6c\x42\x61\x95\x6e\xf9\x5c\xb0\x19\xec\x56\xe7\xb1\xee\x7c\x25\x96\xbf\x0f\x4c\xf1\xfe\x7f\x17\xb3\x4c\x0f\xff\xaa\x86\xbc\x2e\xb4\xd7\x33\x05\x18\xdd\xd0\x0c\x47\xbd\x77\x93\x03\x53\x4f\x76\xb2\x37\xda\xf3\x81\x14\x50\xeb\x2d\xd5\x2e\x4c\x21\x84\x3c\xc3\x28\xcc\x8d\x94\xeb\xb6\x5f\x29\xf7\x6e\xa6\x26\x38\x61\x64\x64\x0f\x9c\x53\x92\xe7\xd2\x19\xff\x3b\x59\x65\xa5\xa6\x35\xed\x82\xda\x0b\xfa\x5f\x00\x74\xcb\x1f\xad\x74\xed\x1f\xfc\xa8\x1f\x01\x00\x00\xff\xff\x27\xb9\x0c\x22\xb3\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x6f\xda\x4a\x14\x85\xf7\xf3\x2b\x8e\xf0\x26\x91\xc0\xbc\xf7\x56\x4f\x74\xe5\x24\xd0\x5a\x8d\x40\xc2\xa4\x51\x96\x83\x7d\xb1\x6f\xb1\xe7\xba\x33\x63\x1c\xfa\xeb\xab\x31\xd0\x24\x6a\xd5\x6e\x32\x3b\xcb\xd7\xe7\x7e\x67\xce\x71\x84\xc9\xfb\x1d\x15\xe1\x9e\x73\x32\x8e\x0a\x78\x81\xaf\x08\x49\xab\xf3\x8a\x90\xc9\xce\xf7\xda\x12\x16\xd2\x99\x42\x7b\x16\x83\xab\x24\x5b\x5c\xa3\x33\x05\x59\x88\x21\x88\x45\x23\x96\x54\x84\x5c\x8c\xb7\xbc\xed\xbc\x58\xd4\x27\x41\xe8\xd2\x12\x35\x64\xbc\x8b\x81\x8c\x68\x50\x5f\xae\x36\xe9\xed\x1c\x3b\xae\x09\x05\xbb\xd3\x47\x54\xa0\x67\x5f\xa9\x08\xbe\x62\x87\x5e\xec\x1e\x3b\xb1\xd0\x45\xc1\x61\xb1\xae\xc1\x66\x27\xb6\x39\x61\x58\x2a\xb5\x2d\xd8\x94\xc8\xa5\x3d\x5a\x2e\x2b\x0f\xe9\x0d\x59\x57\x71\x1b\xab\x08\x9b\x60\x23\x5b\x5c\x48\xdc\x49\x76\xd8\xe9\x05\x4f\xd2\x9d\x3d\xbc\xb2\x7b\xbe\x85\x31\xbe\x90\x75\x61\xc9\x7f\xf1\x3f\x2a\xc2\x55\x18\x19\x9d\x5f\x8e\xae\x3f\xe0\x28\x1d\x1a\x7d\x84\x11\x8f\xce\xd1\x2b\x65\x7a\xce\xa9\xf5\x60\x83\x5c\x9a\xb6\x66\x6d\x72\x7a\xb1\xf5\x73\x43\x8c\x01\x20\x68\xc8\xd6\x6b\x36\xd0\x83\x0d\xc8\xee\xf5\x18\xb4\x57\x91\x8a\x30\x9c\xca\xfb\x76\x36\x9d\xf6\x7d\x1f\xeb\x21\x9d\x58\x6c\x39\xbd\xb8\x9b\xde\xa7\xb7\xf3\x65\x36\x9f\x0c\xc8\x2a\xc2\x83\xa9\xc9\x39\x58\xfa\xd6\xb1\xa5\x02\xdb\x23\x74\xdb\xd6\x9c\xeb\x6d\x4d\xa8\x75\x1f\x82\x1b\xd2\x19\x42\x67\x83\xde\xb2\x67\x53\x8e\xe1\xce\xa9\xab\xe8\x4d\x3a\x2f\xd7\x75\xc1\x63\xf7\x66\x40\x0c\xb4\xc1\x28\xc9\x90\x66\x23\xdc\x24\x59\x9a\x8d\x55\x84\xc7\x74\xf3\x69\xf5\xb0\xc1\x63\xb2\x5e\x27\xcb\x4d\x3a\xcf\xb0\x5a\xe3\x76\xb5\xbc\x4b\x37\xe9\x6a\x99\x61\xb5\x40\xb2\x7c\xc2\xe7\x74\x79\x37\x06\xb1\xaf\xc8\x82\x9e\x5b\x1b\xf8\xc5\x82\xc3\x45\x52\x11\x32\xbd\x14\xe8\x02\x10\xfa\x11\x9e\x5d\x4b\x39\xef\x38\x47\xad\x4d\xd9\xe9\x92\x50\xca\x81\xac\x09\xf5\x68\xc9\x36\xec\x42\x9c\x0e\xda\x14\x2a\x42\xcd\x0d\xfb\xa1\x45\xee\x57\x53\x61\xcd\xe5\xc7\x78\x87\xa3\xd4\x9e\x4d\x31\xc3\x5a\x6a\xba\x61\x13\x0a\xab\x74\xcb\xe7\x82\xcd\x60\xb7\x3a\x8f\x75\xe7\x2b\xb1\xfc\x7d\x60\x8a\xf7\xff\xbb\x98\x65\x7a\xf8\x57\x35\xe4\x75\xa1\xbd\x9e\x29\xc0\xe8\x86\x66\x38\xea\xbd\x9b\x1c\x98\x7a\xb2\x93\xbd\xd1\x9e\x0f\xa4\x80\x5a\x6f\xa9\x76\x61\x0a\x21\xe4\x19\x46\x61\x6e\xa4\x5c\xb7\xfd\x4a\xb9\x77\x33\x35\xc1\x09\x23\x23\x7b\xe0\x9c\x92\x3c\x97\xce\xf8\xdf\xc9\x2a\x2b\x35\xad\x69\x17\xd4\x5e\xd0\xff\x02\xa0\x5b\xfe\x68\xa5\x6b\xff\xe0\x47\xfd\x18\x00\x27\xb9\x0c\x22\xb3\x04\x00\x00"),
 		},
 		"/infrastructure/rbac/viewer-role-binding-kubernetes.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "viewer-role-binding-kubernetes.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1187,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xda\x4a\x14\xdd\xcf\xaf\x38\xc2\x9b\x44\x02\xf3\xde\x5b\x3d\xd1\x95\x93\x40\x6b\x35\x02\x09\x93\x46\x59\x0e\xf6\xc5\xbe\xc5\x9e\xeb\xce\x8c\x71\xe8\xaf\xaf\xc6\x40\x93\xa8\x1f\xab\xcc\x0e\xdd\xcb\xf9\xb8\xe7\x38\xc2\xe4\xfd\x9e\x8a\x70\xcf\x39\x19\x47\x05\xbc\xc0\x57\x84\xa4\xd5\x79\x45\xc8\x64\xe7\x7b\x6d\x09\x0b\xe9\x4c\xa1\x3d\x8b\xc1\x55\x92\x2d\xae\xd1\x99\x82\x2c\xc4\x10\xc4\xa2\x11\x4b\x2a\x42\x2e\xc6\x5b\xde\x76\x5e\x2c\xea\x13\x20\x74\x69\x89\x1a\x32\xde\xc5\x40\x46\x34\xa0\x2f\x57\x9b\xf4\x76\x8e\x1d\xd7\x84\x82\xdd\xe9\x4f\x54\xa0\x67\x5f\xa9\x08\xbe\x62\x87\x5e\xec\x1e\x3b\xb1\xd0\x45\xc1\x81\x58\xd7\x60\xb3\x13\xdb\x9c\x64\x58\x2a\xb5\x2d\xd8\x94\xc8\xa5\x3d\x5a\x2e\x2b\x0f\xe9\x0d\x59\x57\x71\x1b\xab\x08\x9b\x60\x23\x5b\x5c\x94\xb8\x13\xec\xc0\xe9\x05\x4f\xd2\x9d\x3d\xbc\xb2\x7b\xbe\xc2\x18\x5f\xc8\xba\x40\xf2\x5f\xfc\x8f\x8a\x70\x15\x56\x46\xe7\xe1\xe8\xfa\x03\x8e\xd2\xa1\xd1\x47\x18\xf1\xe8\x1c\xbd\x42\xa6\xe7\x9c\x5a\x0f\x36\xc8\xa5\x69\x6b\xd6\x26\xa7\x17\x5b\x3f\x19\x62\x0c\x02\x02\x86\x6c\xbd\x66\x03\x3d\xd8\x80\xec\x5e\xaf\x41\x7b\x15\xa9\x08\xc3\xab\xbc\x6f\x67\xd3\x69\xdf\xf7\xb1\x1e\xe4\xc6\x62\xcb\xe9\xc5\xdd\xf4\x3e\xbd\x9d\x2f\xb3\xf9\x64\x90\xac\x22\x3c\x98\x9a\x9c\x83\xa5\x6f\x1d\x5b\x2a\xb0\x3d\x42\xb7\x6d\xcd\xb9\xde\xd6\x84\x5a\xf7\x21\xb8\x21\x9d\x21\x74\x36\xe8\x2d\x7b\x36\xe5\x18\xee\x9c\xba\x8a\xde\xa4\xf3\x72\xae\x8b\x3c\x76\x6f\x16\xc4\x40\x1b\x8c\x92\x0c\x69\x36\xc2\x4d\x92\xa5\xd9\x58\x45\x78\x4c\x37\x9f\x56\x0f\x1b\x3c\x26\xeb\x75\xb2\xdc\xa4\xf3\x0c\xab\x35\x6e\x57\xcb\xbb\x74\x93\xae\x96\x19\x56\x0b\x24\xcb\x27\x7c\x4e\x97\x77\x63\x10\xfb\x8a\x2c\xe8\xb9\xb5\x41\xbf\x58\x70\x38\x24\x15\x21\xd3\x4b\x81\x2e\x02\x42\x3f\xc2\x6f\xd7\x52\xce\x3b\xce\x51\x6b\x53\x76\xba\x24\x94\x72\x20\x6b\x42\x3d\x5a\xb2\x0d\xbb\x10\xa7\x83\x36\x85\x8a\x50\x73\xc3\x7e\x68\x91\xfb\xd5\x54\xa0\x79\xcf\x6f\x4b\xed\xd9\x14\x33\xac\xa5\xa6\x1b\x36\xa1\xb0\x4a\xb7\x7c\x2e\xd8\x0c\x76\xab\xf3\x58\x77\xbe\x12\xcb\xdf\x07\x4d\xf1\xfe\x7f\x17\xb3\x4c\x0f\xff\xaa\x86\xbc\x2e\xb4\xd7\x33\x05\x18\xdd\xd0\x0c\x47\xbd\x77\x93\x03\x53\x4f\x56\x01\xb5\xde\x52\xed\xc2\x14\x21\xdc\x19\x46\x61\x3e\x52\xae\xdb\x7e\xa5\xdc\xbb\x99\x9a\xe0\x44\x9f\x91\x3d\x70\x4e\x49\x9e\x4b\x67\xfc\x6f\xe1\xac\xd4\xb4\xa6\x5d\x40\x7b\x91\xfc\x07\x62\xdd\xf2\x47\x2b\x5d\xfb\x17\xfd\xea\x47\x00\x00\x00\xff\xff\xde\x54\x66\x1e\xa3\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xda\x4a\x14\xdd\xcf\xaf\x38\xc2\x9b\x44\x02\xf3\xde\x5b\x3d\xd1\x95\x93\x40\x6b\x35\x02\x09\x93\x46\x59\x0e\xf6\xc5\xbe\xc5\x9e\xeb\xce\x8c\x71\xe8\xaf\xaf\xc6\x40\x93\xa8\x1f\xab\xcc\x0e\xdd\xcb\xf9\xb8\xe7\x38\xc2\xe4\xfd\x9e\x8a\x70\xcf\x39\x19\x47\x05\xbc\xc0\x57\x84\xa4\xd5\x79\x45\xc8\x64\xe7\x7b\x6d\x09\x0b\xe9\x4c\xa1\x3d\x8b\xc1\x55\x92\x2d\xae\xd1\x99\x82\x2c\xc4\x10\xc4\xa2\x11\x4b\x2a\x42\x2e\xc6\x5b\xde\x76\x5e\x2c\xea\x13\x20\x74\x69\x89\x1a\x32\xde\xc5\x40\x46\x34\xa0\x2f\x57\x9b\xf4\x76\x8e\x1d\xd7\x84\x82\xdd\xe9\x4f\x54\xa0\x67\x5f\xa9\x08\xbe\x62\x87\x5e\xec\x1e\x3b\xb1\xd0\x45\xc1\x81\x58\xd7\x60\xb3\x13\xdb\x9c\x64\x58\x2a\xb5\x2d\xd8\x94\xc8\xa5\x3d\x5a\x2e\x2b\x0f\xe9\x0d\x59\x57\x71\x1b\xab\x08\x9b\x60\x23\x5b\x5c\x94\xb8\x13\xec\xc0\xe9\x05\x4f\xd2\x9d\x3d\xbc\xb2\x7b\xbe\xc2\x18\x5f\xc8\xba\x40\xf2\x5f\xfc\x8f\x8a\x70\x15\x56\x46\xe7\xe1\xe8\xfa\x03\x8e\xd2\xa1\xd1\x47\x18\xf1\xe8\x1c\xbd\x42\xa6\xe7\x9c\x5a\x0f\x36\xc8\xa5\x69\x6b\xd6\x26\xa7\x17\x5b\x3f\x19\x62\x0c\x02\x02\x86\x6c\xbd\x66\x03\x3d\xd8\x80\xec\x5e\xaf\x41\x7b\x15\xa9\x08\xc3\xab\xbc\x6f\x67\xd3\x69\xdf\xf7\xb1\x1e\xd2\x89\xc5\x96\xd3\x8b\xbb\xe9\x7d\x7a\x3b\x5f\x66\xf3\xc9\x20\x59\x45\x78\x30\x35\x39\x07\x4b\xdf\x3a\xb6\x54\x60\x7b\x84\x6e\xdb\x9a\x73\xbd\xad\x09\xb5\xee\x43\x70\x43\x3a\x43\xe8\x6c\xd0\x5b\xf6\x6c\xca\x31\xdc\x39\x75\x15\xbd\x49\xe7\xe5\x5c\x17\x79\xec\xde\x2c\x88\x81\x36\x18\x25\x19\xd2\x6c\x84\x9b\x24\x4b\xb3\xb1\x8a\xf0\x98\x6e\x3e\xad\x1e\x36\x78\x4c\xd6\xeb\x64\xb9\x49\xe7\x19\x56\x6b\xdc\xae\x96\x77\xe9\x26\x5d\x2d\x33\xac\x16\x48\x96\x4f\xf8\x9c\x2e\xef\xc6\x20\xf6\x15\x59\xd0\x73\x6b\x83\x7e\xb1\xe0\x70\x48\x2a\x42\xa6\x97\x02\x5d\x04\x84\x7e\x84\xdf\xae\xa5\x9c\x77\x9c\xa3\xd6\xa6\xec\x74\x49\x28\xe5\x40\xd6\x84\x7a\xb4\x64\x1b\x76\x21\x4e\x07\x6d\x0a\x15\xa1\xe6\x86\xfd\xd0\x22\xf7\xab\xa9\x40\x73\xf9\x30\xde\xe1\x29\xb5\x67\x53\xcc\xb0\x96\x9a\x6e\xd8\x84\xc2\x2a\xdd\xf2\xb9\x60\x33\xd8\xad\xce\x63\xdd\xf9\x4a\x2c\x7f\x1f\x34\xc5\xfb\xff\x5d\xcc\x32\x3d\xfc\xab\x1a\xf2\xba\xd0\x5e\xcf\x14\x60\x74\x43\x33\x1c\xf5\xde\x4d\x0e\x4c\x3d\x59\x05\xd4\x7a\x4b\xb5\x0b\x53\x84\x70\x67\x18\x85\xf9\x48\xb9\x6e\xfb\x95\x72\xef\x66\x6a\x82\x13\x7d\x46\xf6\xc0\x39\x25\x79\x2e\x9d\xf1\xbf\x85\xb3\x52\xd3\x9a\x76\x01\xed\x45\xf2\x1f\x88\x75\xcb\x1f\xad\x74\xed\x5f\xf4\xab\x1f\x03\x00\xde\x54\x66\x1e\xa3\x04\x00\x00"),
 		},
 		"/infrastructure/rbac/viewer-role-binding-openshift.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "viewer-role-binding-openshift.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1187,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xda\x4a\x14\xdd\xcf\xaf\x38\xc2\x9b\x44\x02\xf3\xde\x5b\x3d\xd1\x95\x93\x40\x6b\x35\x02\x09\x93\x46\x59\x0e\xf6\xc5\xbe\xc5\x9e\xeb\xce\x8c\x71\xe8\xaf\xaf\xc6\x40\x93\xa8\x1f\xab\xcc\x0e\xdd\xcb\xf9\xb8\xe7\x38\xc2\xe4\xfd\x9e\x8a\x70\xcf\x39\x19\x47\x05\xbc\xc0\x57\x84\xa4\xd5\x79\x45\xc8\x64\xe7\x7b\x6d\x09\x0b\xe9\x4c\xa1\x3d\x8b\xc1\x55\x92\x2d\xae\xd1\x99\x82\x2c\xc4\x10\xc4\xa2\x11\x4b\x2a\x42\x2e\xc6\x5b\xde\x76\x5e\x2c\xea\x13\x20\x74\x69\x89\x1a\x32\xde\xc5\x40\x46\x34\xa0\x2f\x57\x9b\xf4\x76\x8e\x1d\xd7\x84\x82\xdd\xe9\x4f\x54\xa0\x67\x5f\xa9\x08\xbe\x62\x87\x5e\xec\x1e\x3b\xb1\xd0\x45\xc1\x81\x58\xd7\x60\xb3\x13\xdb\x9c\x64\x58\x2a\xb5\x2d\xd8\x94\xc8\xa5\x3d\x5a\x2e\x2b\x0f\xe9\x0d\x59\x57\x71\x1b\xab\x08\x9b\x60\x23\x5b\x5c\x94\xb8\x13\xec\xc0\xe9\x05\x4f\xd2\x9d\x3d\xbc\xb2\x7b\xbe\xc2\x18\x5f\xc8\xba\x40\xf2\x5f\xfc\x8f\x8a\x70\x15\x56\x46\xe7\xe1\xe8\xfa\x03\x8e\xd2\xa1\xd1\x47\x18\xf1\xe8\x1c\xbd\x42\xa6\xe7\x9c\x5a\x0f\x36\xc8\xa5\x69\x6b\xd6\x26\xa7\x17\x5b\x3f\x19\x62\x0c\x02\x02\x86\x6c\xbd\x66\x03\x3d\xd8\x80\xec\x5e\xaf\x41\x7b\x15\xa9\x08\xc3\xab\xbc\x6f\x67\xd3\x69\xdf\xf7\xb1\x1e\xe4\xc6\x62\xcb\xe9\xc5\xdd\xf4\x3e\xbd\x9d\x2f\xb3\xf9\x64\x90\xac\x22\x3c\x98\x9a\x9c\x83\xa5\x6f\x1d\x5b\x2a\xb0\x3d\x42\xb7\x6d\xcd\xb9\xde\xd6\x84\x5a\xf7\x21\xb8\x21\x9d\x21\x74\x36\xe8\x2d\x7b\x36\xe5\x18\xee\x9c\xba\x8a\xde\xa4\xf3\x72\xae\x8b\x3c\x76\x6f\x16\xc4\x40\x1b\x8c\x92\x0c\x69\x36\xc2\x4d\x92\xa5\xd9\x58\x45\x78\x4c\x37\x9f\x56\x0f\x1b\x3c\x26\xeb\x75\xb2\xdc\xa4\xf3\x0c\xab\x35\x6e\x57\xcb\xbb\x74\x93\xae\x96\x19\x56\x0b\x24\xcb\x27\x7c\x4e\x97\x77\x63\x10\xfb\x8a\x2c\xe8\xb9\xb5\x41\xbf\x58\x70\x38\x24\x15\x21\xd3\x4b\x81\x2e\x02\x42\x3f\xc2\x6f\xd7\x52\xce\x3b\xce\x51\x6b\x53\x76\xba\x24\x94\x72\x20\x6b\x42\x3d\x5a\xb2\x0d\xbb\x10\xa7\x83\x36\x85\x8a\x50\x73\xc3\x7e\x68\x91\xfb\xd5\x54\xa0\x79\xcf\x6f\x4b\xed\xd9\x14\x33\xac\xa5\xa6\x1b\x36\xa1\xb0\x4a\xb7\x7c\x2e\xd8\x0c\x76\xab\xf3\x58\x77\xbe\x12\xcb\xdf\x07\x4d\xf1\xfe\x7f\x17\xb3\x4c\x0f\xff\xaa\x86\xbc\x2e\xb4\xd7\x33\x05\x18\xdd\xd0\x0c\x47\xbd\x77\x93\x03\x53\x4f\x56\x01\xb5\xde\x52\xed\xc2\x14\x21\xdc\x19\x46\x61\x3e\x52\xae\xdb\x7e\xa5\xdc\xbb\x99\x9a\xe0\x44\x9f\x91\x3d\x70\x4e\x49\x9e\x4b\x67\xfc\x6f\xe1\xac\xd4\xb4\xa6\x5d\x40\x7b\x91\xfc\x07\x62\xdd\xf2\x47\x2b\x5d\xfb\x17\xfd\xea\x47\x00\x00\x00\xff\xff\xde\x54\x66\x1e\xa3\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xda\x4a\x14\xdd\xcf\xaf\x38\xc2\x9b\x44\x02\xf3\xde\x5b\x3d\xd1\x95\x93\x40\x6b\x35\x02\x09\x93\x46\x59\x0e\xf6\xc5\xbe\xc5\x9e\xeb\xce\x8c\x71\xe8\xaf\xaf\xc6\x40\x93\xa8\x1f\xab\xcc\x0e\xdd\xcb\xf9\xb8\xe7\x38\xc2\xe4\xfd\x9e\x8a\x70\xcf\x39\x19\x47\x05\xbc\xc0\x57\x84\xa4\xd5\x79\x45\xc8\x64\xe7\x7b\x6d\x09\x0b\xe9\x4c\xa1\x3d\x8b\xc1\x55\x92\x2d\xae\xd1\x99\x82\x2c\xc4\x10\xc4\xa2\x11\x4b\x2a\x42\x2e\xc6\x5b\xde\x76\x5e\x2c\xea\x13\x20\x74\x69\x89\x1a\x32\xde\xc5\x40\x46\x34\xa0\x2f\x57\x9b\xf4\x76\x8e\x1d\xd7\x84\x82\xdd\xe9\x4f\x54\xa0\x67\x5f\xa9\x08\xbe\x62\x87\x5e\xec\x1e\x3b\xb1\xd0\x45\xc1\x81\x58\xd7\x60\xb3\x13\xdb\x9c\x64\x58\x2a\xb5\x2d\xd8\x94\xc8\xa5\x3d\x5a\x2e\x2b\x0f\xe9\x0d\x59\x57\x71\x1b\xab\x08\x9b\x60\x23\x5b\x5c\x94\xb8\x13\xec\xc0\xe9\x05\x4f\xd2\x9d\x3d\xbc\xb2\x7b\xbe\xc2\x18\x5f\xc8\xba\x40\xf2\x5f\xfc\x8f\x8a\x70\x15\x56\x46\xe7\xe1\xe8\xfa\x03\x8e\xd2\xa1\xd1\x47\x18\xf1\xe8\x1c\xbd\x42\xa6\xe7\x9c\x5a\x0f\x36\xc8\xa5\x69\x6b\xd6\x26\xa7\x17\x5b\x3f\x19\x62\x0c\x02\x02\x86\x6c\xbd\x66\x03\x3d\xd8\x80\xec\x5e\xaf\x41\x7b\x15\xa9\x08\xc3\xab\xbc\x6f\x67\xd3\x69\xdf\xf7\xb1\x1e\xd2\x89\xc5\x96\xd3\x8b\xbb\xe9\x7d\x7a\x3b\x5f\x66\xf3\xc9\x20\x59\x45\x78\x30\x35\x39\x07\x4b\xdf\x3a\xb6\x54\x60\x7b\x84\x6e\xdb\x9a\x73\xbd\xad\x09\xb5\xee\x43\x70\x43\x3a\x43\xe8\x6c\xd0\x5b\xf6\x6c\xca\x31\xdc\x39\x75\x15\xbd\x49\xe7\xe5\x5c\x17\x79\xec\xde\x2c\x88\x81\x36\x18\x25\x19\xd2\x6c\x84\x9b\x24\x4b\xb3\xb1\x8a\xf0\x98\x6e\x3e\xad\x1e\x36\x78\x4c\xd6\xeb\x64\xb9\x49\xe7\x19\x56\x6b\xdc\xae\x96\x77\xe9\x26\x5d\x2d\x33\xac\x16\x48\x96\x4f\xf8\x9c\x2e\xef\xc6\x20\xf6\x15\x59\xd0\x73\x6b\x83\x7e\xb1\xe0\x70\x48\x2a\x42\xa6\x97\x02\x5d\x04\x84\x7e\x84\xdf\xae\xa5\x9c\x77\x9c\xa3\xd6\xa6\xec\x74\x49\x28\xe5\x40\xd6\x84\x7a\xb4\x64\x1b\x76\x21\x4e\x07\x6d\x0a\x15\xa1\xe6\x86\xfd\xd0\x22\xf7\xab\xa9\x40\x73\xf9\x30\xde\xe1\x29\xb5\x67\x53\xcc\xb0\x96\x9a\x6e\xd8\x84\xc2\x2a\xdd\xf2\xb9\x60\x33\xd8\xad\xce\x63\xdd\xf9\x4a\x2c\x7f\x1f\x34\xc5\xfb\xff\x5d\xcc\x32\x3d\xfc\xab\x1a\xf2\xba\xd0\x5e\xcf\x14\x60\x74\x43\x33\x1c\xf5\xde\x4d\x0e\x4c\x3d\x59\x05\xd4\x7a\x4b\xb5\x0b\x53\x84\x70\x67\x18\x85\xf9\x48\xb9\x6e\xfb\x95\x72\xef\x66\x6a\x82\x13\x7d\x46\xf6\xc0\x39\x25\x79\x2e\x9d\xf1\xbf\x85\xb3\x52\xd3\x9a\x76\x01\xed\x45\xf2\x1f\x88\x75\xcb\x1f\xad\x74\xed\x5f\xf4\xab\x1f\x03\x00\xde\x54\x66\x1e\xa3\x04\x00\x00"),
 		},
 		"/infrastructure/rbac/viewer-role-binding-strimzi.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "viewer-role-binding-strimzi.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1203,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x6f\xe2\x48\x10\x85\xef\xfd\x2b\x9e\xf0\x25\x91\xc0\xec\xee\x69\xc5\x9e\x9c\x04\x76\xac\x89\x40\xc2\x64\xa2\x1c\x1b\xbb\xb0\x6b\xb0\xbb\x3c\xdd\x6d\x1c\xf2\xeb\x47\x6d\x60\x92\x68\x46\x33\x97\xf4\x0d\x51\xbc\xfa\x5e\xbd\x47\x84\xc9\xc7\x3d\x15\xe1\x9e\x73\x32\x8e\x0a\x78\x81\xaf\x08\x49\xab\xf3\x8a\x90\xc9\xce\xf7\xda\x12\x16\xd2\x99\x42\x7b\x16\x83\xab\x24\x5b\x5c\xa3\x33\x05\x59\x88\x21\x88\x45\x23\x96\x54\x84\x5c\x8c\xb7\xbc\xed\xbc\x58\xd4\x27\x41\xe8\xd2\x12\x35\x64\xbc\x8b\x81\x8c\x68\x50\x5f\xae\x36\xe9\xed\x1c\x3b\xae\x09\x05\xbb\xd3\x8f\xa8\x40\xcf\xbe\x52\x11\x7c\xc5\x0e\xbd\xd8\x3d\x76\x62\xa1\x8b\x82\xc3\x62\x5d\x83\xcd\x4e\x6c\x73\xc2\xb0\x54\x6a\x5b\xb0\x29\x91\x4b\x7b\xb4\x5c\x56\x1e\xd2\x1b\xb2\xae\xe2\x36\x56\x11\x36\xc1\x46\xb6\xb8\x90\xb8\x93\xec\xb0\xd3\x0b\x9e\xa4\x3b\x7b\x78\x63\xf7\x7c\x85\x31\xbe\x90\x75\x61\xc9\x3f\xf1\x5f\x2a\xc2\x55\x18\x19\x9d\xbf\x1c\x5d\xff\x87\xa3\x74\x68\xf4\x11\x46\x3c\x3a\x47\x6f\x94\xe9\x39\xa7\xd6\x83\x0d\x72\x69\xda\x9a\xb5\xc9\xe9\xd5\xd6\x8f\x0d\x31\x06\x80\xa0\x21\x5b\xaf\xd9\x40\x0f\x36\x20\xbb\xb7\x63\xd0\x5e\x45\x2a\xc2\xf0\x2a\xef\xdb\xd9\x74\xda\xf7\x7d\xac\x07\xdc\x58\x6c\x39\xbd\xb8\x9b\xde\xa7\xb7\xf3\x65\x36\x9f\x0c\xc8\x2a\xc2\x83\xa9\xc9\x39\x58\xfa\xd6\xb1\xa5\x02\xdb\x23\x74\xdb\xd6\x9c\xeb\x6d\x4d\xa8\x75\x1f\x82\x1b\xd2\x19\x42\x67\x83\xde\xb2\x67\x53\x8e\xe1\xce\xa9\xab\xe8\x5d\x3a\xaf\xe7\xba\xe0\xb1\x7b\x37\x20\x06\xda\x60\x94\x64\x48\xb3\x11\x6e\x92\x2c\xcd\xc6\x2a\xc2\x63\xba\xf9\xb4\x7a\xd8\xe0\x31\x59\xaf\x93\xe5\x26\x9d\x67\x58\xad\x71\xbb\x5a\xde\xa5\x9b\x74\xb5\xcc\xb0\x5a\x20\x59\x3e\xe1\x73\xba\xbc\x1b\x83\xd8\x57\x64\x41\xcf\xad\x0d\xfc\x62\xc1\xe1\x90\x54\x84\x4c\x2f\x05\xba\x00\x84\x7e\x84\xcf\xae\xa5\x9c\x77\x9c\xa3\xd6\xa6\xec\x74\x49\x28\xe5\x40\xd6\x84\x7a\xb4\x64\x1b\x76\x21\x4e\x07\x6d\x0a\x15\xa1\xe6\x86\xfd\xd0\x22\xf7\xb3\xa9\xb0\xe6\x23\xff\x5b\x6a\xcf\xa6\x98\x61\x2d\x35\xdd\xb0\x09\x85\x55\xba\xe5\x73\xc1\x66\xb0\x5b\x9d\xc7\xba\xf3\x95\x58\x7e\x19\x98\xe2\xfd\xbf\x2e\x66\x99\x1e\xfe\x56\x0d\x79\x5d\x68\xaf\x67\x0a\x30\xba\xa1\x19\x8e\x7a\xef\x26\x07\xa6\x9e\xec\x24\x1c\xbe\x79\x61\x05\xd4\x7a\x4b\xb5\x0b\x53\x08\x21\xcf\x30\x0a\x73\x23\xe5\xba\xed\x57\xca\xbd\x9b\xa9\x09\x4e\x18\x19\xd9\x03\xe7\x94\xe4\xb9\x74\xc6\xff\x4a\x56\x59\xa9\x69\x4d\xbb\xa0\xf6\x8a\xfe\x07\x00\xdd\xf2\xff\x56\xba\xf6\x37\x7e\xd4\xf7\x00\x00\x00\xff\xff\x61\x5b\xb5\x96\xb3\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x6f\xda\x4a\x14\x85\xf7\xf3\x2b\x8e\xf0\x26\x91\xc0\xbc\xf7\x56\x4f\xbc\x95\x93\xc0\xab\xd5\x08\x24\x4c\x1a\x65\x39\xd8\x17\xfb\x16\x7b\xae\x3b\x33\xc6\x21\xbf\xbe\x1a\x03\x4d\xa2\x56\xed\x26\xb3\x43\x5c\x9f\xfb\x9d\x39\x67\x22\x4c\x3e\xee\xa8\x08\xf7\x9c\x93\x71\x54\xc0\x0b\x7c\x45\x48\x5a\x9d\x57\x84\x4c\x76\xbe\xd7\x96\xb0\x90\xce\x14\xda\xb3\x18\x5c\x25\xd9\xe2\x1a\x9d\x29\xc8\x42\x0c\x41\x2c\x1a\xb1\xa4\x22\xe4\x62\xbc\xe5\x6d\xe7\xc5\xa2\x3e\x09\x42\x97\x96\xa8\x21\xe3\x5d\x0c\x64\x44\x83\xfa\x72\xb5\x49\x6f\xe7\xd8\x71\x4d\x28\xd8\x9d\x3e\xa2\x02\x3d\xfb\x4a\x45\xf0\x15\x3b\xf4\x62\xf7\xd8\x89\x85\x2e\x0a\x0e\x8b\x75\x0d\x36\x3b\xb1\xcd\x09\xc3\x52\xa9\x6d\xc1\xa6\x44\x2e\xed\xd1\x72\x59\x79\x48\x6f\xc8\xba\x8a\xdb\x58\x45\xd8\x04\x1b\xd9\xe2\x42\xe2\x4e\xb2\xc3\x4e\x2f\x78\x92\xee\xec\xe1\x8d\xdd\xf3\x2d\x8c\xf1\x85\xac\x0b\x4b\xfe\x89\xff\x52\x11\xae\xc2\xc8\xe8\xfc\xe7\xe8\xfa\x3f\x1c\xa5\x43\xa3\x8f\x30\xe2\xd1\x39\x7a\xa3\x4c\xcf\x39\xb5\x1e\x6c\x90\x4b\xd3\xd6\xac\x4d\x4e\xaf\xb6\x7e\x6c\x88\x31\x00\x04\x0d\xd9\x7a\xcd\x06\x7a\xb0\x01\xd9\xbd\x1d\x83\xf6\x2a\x52\x11\x86\x53\x79\xdf\xce\xa6\xd3\xbe\xef\x63\x3d\xa4\x13\x8b\x2d\xa7\x17\x77\xd3\xfb\xf4\x76\xbe\xcc\xe6\x93\x01\x59\x45\x78\x30\x35\x39\x07\x4b\xdf\x3a\xb6\x54\x60\x7b\x84\x6e\xdb\x9a\x73\xbd\xad\x09\xb5\xee\x43\x70\x43\x3a\x43\xe8\x6c\xd0\x5b\xf6\x6c\xca\x31\xdc\x39\x75\x15\xbd\x4b\xe7\xf5\xba\x2e\x78\xec\xde\x0d\x88\x81\x36\x18\x25\x19\xd2\x6c\x84\x9b\x24\x4b\xb3\xb1\x8a\xf0\x98\x6e\x3e\xad\x1e\x36\x78\x4c\xd6\xeb\x64\xb9\x49\xe7\x19\x56\x6b\xdc\xae\x96\x77\xe9\x26\x5d\x2d\x33\xac\x16\x48\x96\x4f\xf8\x9c\x2e\xef\xc6\x20\xf6\x15\x59\xd0\x73\x6b\x03\xbf\x58\x70\xb8\x48\x2a\x42\xa6\x97\x02\x5d\x00\x42\x3f\xc2\x6f\xd7\x52\xce\x3b\xce\x51\x6b\x53\x76\xba\x24\x94\x72\x20\x6b\x42\x3d\x5a\xb2\x0d\xbb\x10\xa7\x83\x36\x85\x8a\x50\x73\xc3\x7e\x68\x91\xfb\xd9\x54\x58\x73\x79\x18\x1f\x70\x94\xda\xb3\x29\x66\x58\x4b\x4d\x37\x6c\x42\x61\x95\x6e\xf9\x5c\xb0\x19\xec\x56\xe7\xb1\xee\x7c\x25\x96\x5f\x06\xa6\x78\xff\xaf\x8b\x59\xa6\x87\xbf\x55\x43\x5e\x17\xda\xeb\x99\x02\x8c\x6e\x68\x86\xa3\xde\xbb\xc9\x81\xa9\x27\x3b\x09\xef\xa6\x79\x61\x05\xd4\x7a\x4b\xb5\x0b\x53\x08\x21\xcf\x30\x0a\x73\x23\xe5\xba\xed\x57\xca\xbd\x9b\xa9\x09\x4e\x18\x19\xd9\x03\xe7\x94\xe4\xb9\x74\xc6\xff\x4a\x56\x59\xa9\x69\x4d\xbb\xa0\xf6\x8a\xfe\x07\x00\xdd\xf2\xff\x56\xba\xf6\x37\x7e\xd4\xf7\x01\x00\x61\x5b\xb5\x96\xb3\x04\x00\x00"),
 		},
 		"/infrastructure/rbac/viewer-role-camel-k.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "viewer-role-camel-k.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1283,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xac\x4b\x02\xac\xe5\xb6\xa7\xc2\x3d\xb9\x9b\xdd\x56\x68\x60\x03\x2b\xa7\x41\x8e\x23\x69\x2c\x0d\x4c\x91\xea\x90\x5a\xc5\xfd\xfa\x82\xb2\x9c\xdd\x6d\xae\xe1\x89\x43\x3e\xce\xbc\x37\x6f\x98\x61\xfd\xe3\x96\xc9\xf0\x51\x6a\x76\x81\x1b\x44\x8f\xd8\x31\x76\x03\xd5\x1d\xa3\xf4\xa7\x38\x91\x32\x1e\xfd\xe8\x1a\x8a\xe2\x1d\xde\xed\xca\xc7\xf7\x18\x5d\xc3\x0a\xef\x18\x5e\xd1\x7b\x65\x93\xa1\xf6\x2e\xaa\x54\x63\xf4\x0a\x7b\x4d\x08\x6a\x95\xb9\x67\x17\x43\x0e\x94\xcc\x73\xf6\xfd\xe1\x58\xdc\x3f\xe0\x24\x96\xd1\x48\xb8\x3e\xe2\x06\x93\xc4\xce\x64\x88\x9d\x04\x4c\x5e\xcf\x38\x79\x05\x35\x8d\xa4\xc2\x64\x21\xee\xe4\xb5\xbf\xd2\x50\x6e\x49\x1b\x71\x2d\x6a\x3f\x5c\x54\xda\x2e\xc2\x4f\x8e\x35\x74\x32\xe4\x26\xc3\x31\xc9\x28\x1f\x6f\x4c\xc2\x35\xed\x5c\x33\x7a\x7c\xf1\xe3\xa2\xe1\x95\xdc\xa5\x0b\x77\xf8\x9b\x35\xa4\x22\xbf\xe4\x3f\x99\x0c\xef\x12\x64\xb5\x5c\xae\xde\xff\x86\x8b\x1f\xd1\xd3\x05\xce\x47\x8c\x81\x5f\x65\xe6\xaf\x35\x0f\x11\xe2\x50\xfb\x7e\xb0\x42\xae\xe6\x17\x59\xdf\x2a\xe4\x98\x09\xa4\x1c\xbe\x8a\x24\x0e\x34\xcb\x80\x3f\xbd\x86\x81\xa2\xc9\x4c\x86\x79\x75\x31\x0e\xdb\xcd\x66\x9a\xa6\x9c\x66\xba\xb9\xd7\x76\x73\x53\xb7\xf9\x58\xdc\x3f\xec\xcb\x87\xf5\x4c\xd9\x64\xf8\xe4\x2c\x87\x00\xe5\x7f\x46\x51\x6e\x50\x5d\x40\xc3\x60\xa5\xa6\xca\x32\x2c\x4d\xc9\xb8\xd9\x9d\xd9\x74\x71\x98\x54\xa2\xb8\xf6\x0e\x61\x71\xdd\x64\x6f\xdc\x79\x69\xd7\x8d\x9e\x84\x37\x00\xef\x40\x0e\xab\x5d\x89\xa2\x5c\xe1\xf7\x5d\x59\x94\x77\x26\xc3\xe7\xe2\xf8\xe7\xe1\xd3\x11\x9f\x77\x4f\x4f\xbb\xfd\xb1\x78\x28\x71\x78\xc2\xfd\x61\xff\xa1\x38\x16\x87\x7d\x89\xc3\x23\x76\xfb\x2f\xf8\xab\xd8\x7f\xb8\x03\x4b\xec\x58\xc1\x5f\x07\x4d\xfc\xbd\x42\x52\x23\xb9\x49\x9e\xde\x06\xe8\x46\x20\xcd\x47\x8a\xc3\xc0\xb5\x9c\xa4\x86\x25\xd7\x8e\xd4\x32\x5a\xff\xcc\xea\xd2\x78\x0c\xac\xbd\x84\x64\x67\x00\xb9\xc6\x64\xb0\xd2\x4b\x9c\xa7\x28\x7c\x2f\x2a\x95\xf9\x91\x7f\xcb\x9c\xc5\x35\x5b\x3c\x79\xcb\x86\x06\x59\x26\x6b\x0b\xad\xa8\xce\x69\x8c\x9d\x57\xf9\x77\x26\x93\x9f\x7f\x0d\xb9\xf8\xcd\xf3\xcf\xa6\xe7\x48\x0d\x45\xda\x1a\xc0\x51\xcf\x5b\x5c\xe8\x1c\xd6\xcf\xc2\x13\xeb\xba\xa6\x9e\xed\xfa\x6c\x00\x4b\x15\xdb\x90\x50\x48\xee\x6e\xb1\x4a\xb8\x95\xd1\xd1\x72\xd8\x9a\x35\x68\x90\x3f\xd4\x8f\xc3\x82\x59\x63\x7e\xfb\x6a\x80\x0c\xa0\x1c\xfc\xa8\x35\x7f\xc3\x88\x8b\xdc\xea\xb5\x41\xcb\xd1\x39\x3d\xe3\xf8\xbf\xb0\x12\x97\x7e\x60\x3a\x7d\x66\xad\x5e\x8a\x28\x53\xe4\x25\x68\x12\xf2\x6d\x50\x7b\x6b\xb9\x4e\xf9\x97\xe3\x96\xe3\xb2\xb3\x12\x6e\xdb\x81\x62\xdd\x2d\xfb\x71\x68\x5e\x32\x4e\xf3\xc5\x7f\x01\x00\x00\xff\xff\xa0\x43\xa6\x9f\x03\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x72\xdb\x36\x10\xbd\xe3\x2b\xde\x88\x97\x64\xc6\xa2\xdb\x9e\x3a\xea\x49\x75\xec\x96\xd3\x8c\x34\x63\x2a\xcd\xe4\xb8\x02\x57\xe4\x8e\x40\x80\x5d\x80\x66\xd4\xaf\xef\x80\xa6\x62\xbb\xb9\x06\x27\x00\x7c\xfb\xf6\x3d\xbc\x65\x81\xf5\x8f\x5b\xa6\xc0\x47\xb1\xec\x23\x37\x48\x01\xa9\x63\x6c\x07\xb2\x1d\xa3\x0e\xa7\x34\x91\x32\x1e\xc2\xe8\x1b\x4a\x12\x3c\xde\x6d\xeb\x87\xf7\x18\x7d\xc3\x8a\xe0\x19\x41\xd1\x07\x65\x53\xc0\x06\x9f\x54\x8e\x63\x0a\x0a\xf7\x4c\x08\x6a\x95\xb9\x67\x9f\x62\x09\xd4\xcc\x33\xfb\x6e\x7f\xa8\xee\xee\x71\x12\xc7\x68\x24\x3e\x17\x71\x83\x49\x52\x67\x0a\xa4\x4e\x22\xa6\xa0\x67\x9c\x82\x82\x9a\x46\x72\x63\x72\x10\x7f\x0a\xda\x3f\xcb\x50\x6e\x49\x1b\xf1\x2d\x6c\x18\x2e\x2a\x6d\x97\x10\x26\xcf\x1a\x3b\x19\x4a\x53\xe0\x90\x6d\xd4\x0f\x57\x25\xf1\x99\x76\xee\x99\x02\xbe\x84\x71\xf1\xf0\xca\xee\xf2\x0a\x37\xf8\x9b\x35\xe6\x26\xbf\x94\x3f\x99\x02\xef\x32\x64\xb5\x7c\x5c\xbd\xff\x0d\x97\x30\xa2\xa7\x0b\x7c\x48\x18\x23\xbf\x62\xe6\xaf\x96\x87\x04\xf1\xb0\xa1\x1f\x9c\x90\xb7\xfc\x62\xeb\x5b\x87\x12\xb3\x80\xcc\x11\x8e\x89\xc4\x83\x66\x1b\x08\xa7\xd7\x30\x50\x32\x85\x29\x30\xaf\x2e\xa5\x61\x73\x7b\x3b\x4d\x53\x49\x73\x3a\x65\xd0\xf6\xf6\xea\xee\xf6\x63\x75\x77\xbf\xab\xef\xd7\xb3\x64\x53\xe0\x93\x77\x1c\x23\x94\xff\x19\x45\xb9\xc1\xf1\x02\x1a\x06\x27\x96\x8e\x8e\xe1\x68\xca\xc1\xcd\xe9\xcc\xa1\x8b\xc7\xa4\x92\xc4\xb7\x37\x88\x4b\xea\xa6\x78\x93\xce\xcb\x73\x5d\xe5\x49\x7c\x03\x08\x1e\xe4\xb1\xda\xd6\xa8\xea\x15\x7e\xdf\xd6\x55\x7d\x63\x0a\x7c\xae\x0e\x7f\xee\x3f\x1d\xf0\x79\xfb\xf8\xb8\xdd\x1d\xaa\xfb\x1a\xfb\x47\xdc\xed\x77\x1f\xaa\x43\xb5\xdf\xd5\xd8\x3f\x60\xbb\xfb\x82\xbf\xaa\xdd\x87\x1b\xb0\xa4\x8e\x15\xfc\x75\xd0\xac\x3f\x28\x24\x3f\x24\x37\x39\xd3\xeb\x00\x5d\x05\xe4\xf9\xc8\xe7\x38\xb0\x95\x93\x58\x38\xf2\xed\x48\x2d\xa3\x0d\x4f\xac\x3e\x8f\xc7\xc0\xda\x4b\xcc\x71\x46\x90\x6f\x4c\x01\x27\xbd\xa4\x79\x8a\xe2\xf7\xa6\x72\x9b\xeb\x8f\xf1\x03\x96\x31\x67\xf1\xcd\x06\x8f\xc1\xb1\xa1\x41\x96\xc9\xda\x40\x8f\x64\x4b\x1a\x53\x17\x54\xfe\x9d\xc5\x94\xe7\x5f\x63\x29\xe1\xf6\xe9\x67\xd3\x73\xa2\x86\x12\x6d\x0c\xe0\xa9\xe7\x0d\x2e\x74\x8e\xeb\x27\xe1\x89\x75\x6d\xa9\x67\xb7\x3e\x1b\xc0\xd1\x91\x5d\xcc\x28\xe4\x74\x37\x58\x65\xdc\xca\xe8\xe8\x38\x6e\xcc\x1a\x34\xc8\x1f\x1a\xc6\x61\xc1\xac\x31\xd7\xbe\x1a\x20\x03\x28\xc7\x30\xaa\xe5\x6f\x18\xf1\x89\x5b\x9d\x35\xc5\xe5\xea\x9c\xcb\x38\xfd\xef\x78\x14\x9f\xff\xc0\x7c\xfb\xc4\x7a\x7c\x69\xa2\x4c\x89\x97\x43\x93\x0b\xdf\x1e\x6c\x70\x8e\x6d\xe6\x5f\xae\x5b\x4e\xcb\xce\x49\xbc\x6e\x07\x4a\xb6\x5b\xf6\xe3\xd0\xbc\x30\x4e\x94\x6c\x67\xfe\x1b\x00\xa0\x43\xa6\x9f\x03\x05\x00\x00"),
 		},
 		"/infrastructure/rbac/viewer-role-knative.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "viewer-role-knative.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1600,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x53\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xac\x4b\x52\xac\xe5\xb6\xa7\xc2\x3d\xb9\x9b\xdd\xd6\x68\x60\x03\x2b\xa7\x41\x8e\x14\x35\x96\x06\xa6\x48\x75\x48\x59\x71\xbf\xbe\x20\x2d\x37\xbb\x08\xd0\x5e\x16\x0d\x2f\x1a\x52\x4f\x6f\xde\xe3\x1b\x15\x58\xbe\xde\x52\x05\xde\xb3\x21\x17\xa8\x41\xf4\x88\x1d\x61\x33\x68\xd3\x11\x2a\x7f\x8c\x93\x16\xc2\xa3\x1f\x5d\xa3\x23\x7b\x87\x37\x9b\xea\xf1\x2d\x46\xd7\x90\xc0\x3b\x82\x17\xf4\x5e\x48\x15\x30\xde\x45\xe1\x7a\x8c\x5e\x60\xaf\x84\xd0\xad\x10\xf5\xe4\x62\x28\x81\x8a\x28\xb3\xef\xf6\x87\xed\xfd\x03\x8e\x6c\x09\x0d\x87\xeb\x47\xd4\x60\xe2\xd8\xa9\x02\xb1\xe3\x80\xc9\xcb\x09\x47\x2f\xd0\x4d\xc3\xa9\xb1\xb6\x60\x77\xf4\xd2\x5f\x65\x08\xb5\x5a\x1a\x76\x2d\x8c\x1f\x2e\xc2\x6d\x17\xe1\x27\x47\x12\x3a\x1e\x4a\x55\xe0\x90\x6c\x54\x8f\x37\x25\xe1\x4a\x9b\x7b\x46\x8f\x4f\x7e\x9c\x3d\x3c\xb3\x3b\xdf\xc2\x1d\xfe\x20\x09\xa9\xc9\x8f\xe5\xf7\xaa\xc0\x9b\x04\x59\xcc\x2f\x17\x6f\x7f\xc6\xc5\x8f\xe8\xf5\x05\xce\x47\x8c\x81\x9e\x31\xd3\x67\x43\x43\x04\x3b\x18\xdf\x0f\x96\xb5\x33\xf4\xc5\xd6\x3f\x1d\x4a\x64\x01\x89\xc3\xd7\x51\xb3\x83\xce\x36\xe0\x8f\xcf\x61\xd0\x51\x15\xaa\x40\x5e\x5d\x8c\xc3\x7a\xb5\x9a\xa6\xa9\xd4\x59\x6e\xe9\xa5\x5d\xdd\xdc\xad\xde\x6f\xef\x1f\x76\xd5\xc3\x32\x4b\x56\x05\x3e\x38\x4b\x21\x40\xe8\xcf\x91\x85\x1a\xd4\x17\xe8\x61\xb0\x6c\x74\x6d\x09\x56\x4f\x29\xb8\x9c\x4e\x0e\x9d\x1d\x26\xe1\xc8\xae\xbd\x43\x98\x53\x57\xc5\x8b\x74\xbe\x5c\xd7\x4d\x1e\x87\x17\x00\xef\xa0\x1d\x16\x9b\x0a\xdb\x6a\x81\x5f\x36\xd5\xb6\xba\x53\x05\x3e\x6e\x0f\xbf\xed\x3f\x1c\xf0\x71\xf3\xf4\xb4\xd9\x1d\xb6\x0f\x15\xf6\x4f\xb8\xdf\xef\xde\x6d\x0f\xdb\xfd\xae\xc2\xfe\x11\x9b\xdd\x27\xfc\xbe\xdd\xbd\xbb\x03\x71\xec\x48\x40\x9f\x07\x49\xfa\xbd\x80\xd3\x45\x52\x93\x32\xbd\x0d\xd0\x4d\x40\x9a\x8f\xb4\x0f\x03\x19\x3e\xb2\x81\xd5\xae\x1d\x75\x4b\x68\xfd\x99\xc4\xa5\xf1\x18\x48\x7a\x0e\x29\xce\x00\xed\x1a\x55\xc0\x72\xcf\x31\x4f\x51\xf8\xda\x54\x6a\xf3\x9a\xff\x96\x3a\xb1\x6b\xd6\x78\xf2\x96\x94\x1e\x78\x9e\xac\x35\xa4\xd6\xa6\xd4\x63\xec\xbc\xf0\x5f\x59\x4c\x79\xfa\x29\x94\xec\x57\xe7\x1f\x54\x4f\x51\x37\x3a\xea\xb5\x02\x9c\xee\x69\x8d\x8b\x3e\x85\xe5\x99\x69\x22\x59\x9e\x9c\x8e\x7c\x26\x05\x58\x5d\x93\x0d\x09\x85\x94\xee\x1a\x8b\x84\x5b\x28\x19\x2d\x85\xb5\x5a\x42\x0f\xfc\xab\xf8\x71\xc8\x98\x25\xe8\x4c\x2e\x65\x5c\xce\x14\x65\x43\x67\x05\x08\x05\x3f\x8a\xa1\x19\x55\x8b\x3f\x91\x84\x5c\x47\xe1\xb6\xbd\x6e\xce\x24\xf5\x8c\x30\x42\x3a\x52\x2e\x1b\xb2\xf4\xa2\x34\xde\x5a\x32\xc9\x50\x3e\x6c\x29\xe6\xa7\xe5\x70\x2d\x06\x1d\x4d\x97\xab\x71\x68\x6e\x2c\x53\x3e\xfc\x4a\x6f\x4f\x21\xe8\xf6\x3f\x05\x9b\x4e\x3b\x47\xf6\xaa\x98\x5d\x4f\xbd\x97\xcb\x8b\xc3\x30\xd6\xc1\x08\x0f\x39\xf4\x6f\xe3\x65\x56\xfc\xef\x4e\x16\xdf\x2d\xfe\x17\x75\x7f\x07\x00\x00\xff\xff\x7c\xa8\xc6\x67\x40\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x93\x41\x8f\xdb\x36\x10\x85\xef\xfc\x15\x0f\xd6\x25\x29\xd6\x72\xdb\x53\xe1\x9e\xdc\xcd\x6e\x6b\x34\xb0\x81\x95\xd3\x20\xc7\x31\x35\x96\x06\xa6\x48\x75\x48\x59\x71\x7f\x7d\x41\xd9\x6e\x76\x11\xa0\xbd\x2c\x1a\x5e\x4c\xd1\x4f\x33\xdf\xe3\x1b\x15\x98\xbf\xde\x32\x05\xde\x8b\x65\x1f\xb9\x46\x0a\x48\x2d\x63\xd5\x93\x6d\x19\x55\x38\xa4\x91\x94\xf1\x18\x06\x5f\x53\x92\xe0\xf1\x66\x55\x3d\xbe\xc5\xe0\x6b\x56\x04\xcf\x08\x8a\x2e\x28\x9b\x02\x36\xf8\xa4\xb2\x1f\x52\x50\xb8\x4b\x41\x50\xa3\xcc\x1d\xfb\x14\x4b\xa0\x62\x9e\xaa\x6f\xb6\xbb\xf5\xfd\x03\x0e\xe2\x18\xb5\xc4\xcb\x4b\x5c\x63\x94\xd4\x9a\x02\xa9\x95\x88\x31\xe8\x11\x87\xa0\xa0\xba\x96\xdc\x98\x1c\xc4\x1f\x82\x76\x17\x0c\xe5\x86\xb4\x16\xdf\xc0\x86\xfe\xac\xd2\xb4\x09\x61\xf4\xac\xb1\x95\xbe\x34\x05\x76\xd9\x46\xf5\x78\x23\x89\x97\xb2\x53\xcf\x14\xf0\x29\x0c\x57\x0f\xcf\xec\x5e\x6f\xe1\x0e\x7f\xb0\xc6\xdc\xe4\xc7\xf2\x7b\x53\xe0\x4d\x96\xcc\xae\x7f\xce\xde\xfe\x8c\x73\x18\xd0\xd1\x19\x3e\x24\x0c\x91\x9f\x55\xe6\xcf\x96\xfb\x04\xf1\xb0\xa1\xeb\x9d\x90\xb7\xfc\xc5\xd6\x3f\x1d\x4a\x4c\x00\xb9\x46\xd8\x27\x12\x0f\x9a\x6c\x20\x1c\x9e\xcb\x40\xc9\x14\xa6\xc0\xb4\xda\x94\xfa\xe5\x62\x31\x8e\x63\x49\x53\x3a\x65\xd0\x66\x71\x73\xb7\x78\xbf\xbe\x7f\xd8\x54\x0f\xf3\x09\xd9\x14\xf8\xe0\x1d\xc7\x08\xe5\x3f\x07\x51\xae\xb1\x3f\x83\xfa\xde\x89\xa5\xbd\x63\x38\x1a\x73\x70\x53\x3a\x53\xe8\xe2\x31\xaa\x24\xf1\xcd\x1d\xe2\x35\x75\x53\xbc\x48\xe7\xcb\x75\xdd\xf0\x24\xbe\x10\x04\x0f\xf2\x98\xad\x2a\xac\xab\x19\x7e\x59\x55\xeb\xea\xce\x14\xf8\xb8\xde\xfd\xb6\xfd\xb0\xc3\xc7\xd5\xd3\xd3\x6a\xb3\x5b\x3f\x54\xd8\x3e\xe1\x7e\xbb\x79\xb7\xde\xad\xb7\x9b\x0a\xdb\x47\xac\x36\x9f\xf0\xfb\x7a\xf3\xee\x0e\x2c\xa9\x65\x05\x7f\xee\x35\xf3\x07\x85\xe4\x8b\xe4\x3a\x67\x7a\x1b\xa0\x1b\x40\x9e\x8f\xfc\x1c\x7b\xb6\x72\x10\x0b\x47\xbe\x19\xa8\x61\x34\xe1\xc4\xea\xf3\x78\xf4\xac\x9d\xc4\x1c\x67\x04\xf9\xda\x14\x70\xd2\x49\x9a\xa6\x28\x7e\x6d\x2a\xb7\xb9\x7d\x18\xaf\xb0\x8c\x39\x8a\xaf\x97\x78\x0a\x8e\x0d\xf5\x72\x9d\xac\x25\x74\x4f\xb6\xa4\x21\xb5\x41\xe5\xaf\x09\xa6\x3c\xfe\x14\x4b\x09\x8b\xd3\x0f\xa6\xe3\x44\x35\x25\x5a\x1a\xc0\x53\xc7\x4b\x9c\xe9\x18\xe7\x27\xe1\x91\x75\x7e\xf4\x94\xe4\xc4\x06\x70\xb4\x67\x17\xb3\x0a\x39\xdd\x25\x66\x59\x37\x33\x3a\x38\x8e\x4b\x33\x07\xf5\xf2\xab\x86\xa1\x9f\x34\x73\xf0\x89\x7d\xce\xb8\xbc\x96\x28\x6b\x3e\x19\x40\x39\x86\x41\x2d\x5f\x55\x7b\x0d\x47\xd6\x38\xbd\x91\x54\x9a\xe6\xf2\x70\x62\xdd\x5f\x15\x56\x99\x52\x06\x98\xa3\x66\xc7\x2f\xb6\x36\x38\xc7\x36\x1b\x9a\x0e\x1b\x4e\xd3\xaf\x93\x78\xd9\xf4\x94\x6c\x3b\xed\x86\xbe\xbe\x55\x19\xa7\xc3\xaf\x78\x3b\x8e\x91\x9a\xff\x04\xb6\x2d\x79\xcf\x2e\x43\xce\x21\xbe\xe3\x2e\xe8\xf9\xc5\x61\x1c\xf6\xd1\xaa\xf4\x19\xeb\x1b\x79\xb9\x12\xff\xbb\x93\xd9\x77\xb3\xff\x85\xee\xef\x01\x00\x7c\xa8\xc6\x67\x40\x06\x00\x00"),
 		},
 		"/infrastructure/rbac/viewer-role-kubernetes.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "viewer-role-kubernetes.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1712,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x54\xc1\x6e\x1b\x37\x10\xbd\xf3\x2b\x1e\x76\x2f\x49\x61\xaf\xda\x9e\x0a\xf5\xa4\x26\x76\x2b\x34\x90\x00\xaf\xd2\x20\x47\x8a\x3b\xda\x9d\x9a\x4b\xb2\x43\xae\x37\xea\xd7\x17\xa4\xa4\xd8\x8e\x5b\xa0\x05\x02\x74\x2f\x9a\x19\x0e\xe7\xbd\x37\x33\x54\x8d\xeb\xaf\xf7\xa9\x1a\xef\xd8\x90\x8b\xd4\x21\x79\xa4\x81\xb0\x0a\xda\x0c\x84\xd6\x1f\xd2\xac\x85\x70\xeb\x27\xd7\xe9\xc4\xde\xe1\xd5\xaa\xbd\x7d\x8d\xc9\x75\x24\xf0\x8e\xe0\x05\xa3\x17\x52\x35\x8c\x77\x49\x78\x3f\x25\x2f\xb0\xa7\x82\xd0\xbd\x10\x8d\xe4\x52\x6c\x80\x96\xa8\x54\xdf\x6c\x77\xeb\x37\x37\x38\xb0\x25\x74\x1c\x4f\x97\xa8\xc3\xcc\x69\x50\x35\xd2\xc0\x11\xb3\x97\x7b\x1c\xbc\x40\x77\x1d\x67\x60\x6d\xc1\xee\xe0\x65\x3c\xd1\x10\xea\xb5\x74\xec\x7a\x18\x1f\x8e\xc2\xfd\x90\xe0\x67\x47\x12\x07\x0e\x8d\xaa\xb1\xcb\x32\xda\xdb\x0b\x93\x78\x2a\x5b\x30\x93\xc7\x47\x3f\x9d\x35\x3c\x91\x7b\xee\xc2\x15\x7e\x23\x89\x19\xe4\xfb\xe6\x5b\x55\xe3\x55\x4e\xa9\xce\x87\xd5\xeb\x1f\x71\xf4\x13\x46\x7d\x84\xf3\x09\x53\xa4\x27\x95\xe9\x93\xa1\x90\xc0\x0e\xc6\x8f\xc1\xb2\x76\x86\x1e\x65\x7d\x46\x68\x50\x08\xe4\x1a\x7e\x9f\x34\x3b\xe8\x22\x03\xfe\xf0\x34\x0d\x3a\xa9\x5a\xd5\x28\xdf\x90\x52\x58\x2e\x16\xf3\x3c\x37\xba\xd0\x6d\xbc\xf4\x8b\x8b\xba\xc5\xbb\xf5\x9b\x9b\x4d\x7b\x73\x5d\x28\xab\x1a\xef\x9d\xa5\x18\x21\xf4\xc7\xc4\x42\x1d\xf6\x47\xe8\x10\x2c\x1b\xbd\xb7\x04\xab\xe7\x3c\xb8\x32\x9d\x32\x74\x76\x98\x85\x13\xbb\xfe\x0a\xf1\x3c\x75\x55\x3f\x9b\xce\x63\xbb\x2e\xf4\x38\x3e\x4b\xf0\x0e\xda\xa1\x5a\xb5\x58\xb7\x15\x7e\x5a\xb5\xeb\xf6\x4a\xd5\xf8\xb0\xde\xfd\xb2\x7d\xbf\xc3\x87\xd5\xdd\xdd\x6a\xb3\x5b\xdf\xb4\xd8\xde\xe1\xcd\x76\xf3\x76\xbd\x5b\x6f\x37\x2d\xb6\xb7\x58\x6d\x3e\xe2\xd7\xf5\xe6\xed\x15\x88\xd3\x40\x02\xfa\x14\x24\xf3\xf7\x02\xce\x8d\xa4\x2e\xcf\xf4\xb2\x40\x17\x02\x79\x3f\xb2\x1f\x03\x19\x3e\xb0\x81\xd5\xae\x9f\x74\x4f\xe8\xfd\x03\x89\xcb\xeb\x11\x48\x46\x8e\x79\x9c\x11\xda\x75\xaa\x86\xe5\x91\x53\xd9\xa2\xf8\x52\x54\x86\xf9\x9a\x6f\x4b\xdd\xb3\xeb\x96\xb8\xf3\x96\x94\x0e\x7c\xde\xac\x25\x64\xaf\x4d\xa3\xa7\x34\x78\xe1\x3f\x0b\x99\xe6\xfe\x87\xd8\xb0\x5f\x3c\x7c\xa7\x46\x4a\xba\xd3\x49\x2f\x15\xe0\xf4\x48\x4b\x1c\xf5\x7d\xbc\x7e\x60\x9a\x49\x14\x60\xf5\x9e\x6c\xcc\xa7\xc8\x53\x5d\xa2\xca\xe7\x95\x92\xc9\x52\x5c\xaa\x6b\xe8\xc0\x3f\x8b\x9f\x42\xc9\xb9\x2e\xb7\x1b\xc3\x49\xa6\x78\x10\x3d\x52\x7e\x5c\x79\x7d\x14\x20\x14\xfd\x24\x86\xce\x99\xd5\x37\x95\x02\x1e\x48\xf6\x4f\x02\x2f\xea\x55\xd5\xcb\x9b\xc1\x77\xb1\x18\x91\xe4\x81\x0d\x5d\x1c\x23\x94\x4e\x36\xb9\x2e\x78\x76\x67\xcf\x78\x77\xe0\x7e\xd4\x21\x3e\x07\x34\x42\x3a\x51\x31\x3b\xb2\xf4\xcc\x34\xde\x5a\x32\xb9\x59\x25\xd8\x53\x2a\xbf\x96\xe3\xc9\x08\x3a\x99\xa1\x58\x53\xe8\x2e\x55\xe6\x12\xfc\xd7\x1a\x16\xd6\xf7\x8f\x4e\x4c\x3a\x4d\x5f\x30\x7c\x01\xfb\x0f\x08\x3a\x14\x6d\x5f\x60\x74\x14\xac\x3f\x96\xbf\xc4\xe2\x0b\x95\x57\x19\x2f\x5d\xca\x88\x74\x98\xec\x39\xf0\x7f\x74\x66\x9f\xe3\x7f\xd3\x9e\xdf\xfd\x3e\x7e\x36\xfe\x4b\x6b\xd4\x5f\x01\x00\x00\xff\xff\x22\xf7\x5e\xc1\xb0\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x54\x41\x6f\xe3\x36\x13\xbd\xf3\x57\x3c\x48\x97\xdd\x0f\x89\xfc\xb5\xa7\xc2\x3d\xb9\xd9\xa4\x35\xba\xb0\x81\xc8\xdb\xc5\x1e\x69\x6a\x2c\x4d\x43\x91\xec\x90\x8a\xd6\xfd\xf5\x05\x69\x7b\x93\x34\x2d\xd0\x02\x0b\x94\x17\x0d\x87\xc3\x79\x6f\xe6\x0d\x55\xe3\xfa\xeb\x2d\x55\xe3\x3d\x1b\x72\x91\x3a\x24\x8f\x34\x10\x56\x41\x9b\x81\xd0\xfa\x43\x9a\xb5\x10\xee\xfc\xe4\x3a\x9d\xd8\x3b\xbc\x59\xb5\x77\x6f\x31\xb9\x8e\x04\xde\x11\xbc\x60\xf4\x42\xaa\x86\xf1\x2e\x09\xef\xa7\xe4\x05\xf6\x94\x10\xba\x17\xa2\x91\x5c\x8a\x0d\xd0\x12\x95\xec\x9b\xed\x6e\x7d\x73\x8b\x03\x5b\x42\xc7\xf1\x74\x89\x3a\xcc\x9c\x06\x55\x23\x0d\x1c\x31\x7b\x79\xc0\xc1\x0b\x74\xd7\x71\x06\xd6\x16\xec\x0e\x5e\xc6\x13\x0d\xa1\x5e\x4b\xc7\xae\x87\xf1\xe1\x28\xdc\x0f\x09\x7e\x76\x24\x71\xe0\xd0\xa8\x1a\xbb\x5c\x46\x7b\x77\x61\x12\x4f\x69\x0b\x66\xf2\xf8\xe4\xa7\x73\x0d\xcf\xca\x3d\x77\xe1\x0a\xbf\x90\xc4\x0c\xf2\x6d\xf3\x7f\x55\xe3\x4d\x0e\xa9\xce\x87\xd5\xdb\xef\x71\xf4\x13\x46\x7d\x84\xf3\x09\x53\xa4\x67\x99\xe9\xb3\xa1\x90\xc0\x0e\xc6\x8f\xc1\xb2\x76\x86\x9e\xca\xfa\x82\xd0\xa0\x10\xc8\x39\xfc\x3e\x69\x76\xd0\xa5\x0c\xf8\xc3\xf3\x30\xe8\xa4\x6a\x55\xa3\xac\x21\xa5\xb0\x5c\x2c\xe6\x79\x6e\x74\x51\xa7\xf1\xd2\x2f\x2e\xd5\x2d\xde\xaf\x6f\x6e\x37\xed\xed\x75\xa1\xac\x6a\x7c\x70\x96\x62\x84\xd0\x6f\x13\x0b\x75\xd8\x1f\xa1\x43\xb0\x6c\xf4\xde\x12\xac\x9e\xb3\x70\x45\x9d\x22\x3a\x3b\xcc\xc2\x89\x5d\x7f\x85\x78\x56\x5d\xd5\x2f\xd4\x79\x6a\xd7\x85\x1e\xc7\x17\x01\xde\x41\x3b\x54\xab\x16\xeb\xb6\xc2\x0f\xab\x76\xdd\x5e\xa9\x1a\x1f\xd7\xbb\x9f\xb6\x1f\x76\xf8\xb8\xba\xbf\x5f\x6d\x76\xeb\xdb\x16\xdb\x7b\xdc\x6c\x37\xef\xd6\xbb\xf5\x76\xd3\x62\x7b\x87\xd5\xe6\x13\x7e\x5e\x6f\xde\x5d\x81\x38\x0d\x24\xa0\xcf\x41\x32\x7f\x2f\xe0\xdc\x48\xea\xb2\xa6\x97\x01\xba\x10\xc8\xf3\x91\xf7\x31\x90\xe1\x03\x1b\x58\xed\xfa\x49\xf7\x84\xde\x3f\x92\xb8\x3c\x1e\x81\x64\xe4\x98\xe5\x8c\xd0\xae\x53\x35\x2c\x8f\x9c\xca\x14\xc5\xd7\x45\x65\x98\xcb\xc3\xf8\x0a\x4b\xa9\x07\x76\xdd\x12\xf7\xde\x92\xd2\x81\xcf\x93\xb5\x84\xec\xb5\x69\xf4\x94\x06\x2f\xfc\x7b\x21\xd3\x3c\x7c\x17\x1b\xf6\x8b\xc7\x6f\xd4\x48\x49\x77\x3a\xe9\xa5\x02\x9c\x1e\x69\x89\xa3\x7e\x88\xd7\x8f\x4c\x33\x89\x02\xac\xde\x93\x8d\xf9\x14\x59\xd5\x25\xaa\x7c\x5e\x29\x99\x2c\xc5\xa5\xba\x86\x0e\xfc\xa3\xf8\x29\x94\x98\xeb\x72\xbb\x31\x9c\x64\x8a\x07\xd1\x23\xe5\xc7\x95\xc7\x47\x01\x42\xd1\x4f\x62\xe8\x1c\x59\xfd\xaf\x52\xc0\x23\xc9\xfe\x99\xe3\x55\xbe\xaa\x7a\x7d\x33\xf8\x2e\x16\x23\x92\x3c\xb2\xa1\xcb\xc6\x08\xa5\x93\x4d\xae\x0b\x9e\xdd\x79\x67\xbc\x3b\x70\x3f\xea\x10\x5f\x02\x1a\x21\x9d\xa8\x98\x1d\x59\x7a\x61\x1a\x6f\x2d\x99\xdc\xac\xe2\xec\x29\x95\xaf\xe5\x78\x32\x82\x4e\x66\x28\xd6\x14\xba\x4b\x96\xb9\x38\xff\x71\x0d\x0b\xeb\xfb\xa7\x4d\x4c\x3a\x4d\x7f\x62\xf8\x0a\xf6\x6f\x10\x74\x08\xf1\x35\x46\x47\xc1\xfa\x63\xf9\x25\x96\x28\xa1\xf2\x2a\xe3\xa5\x4b\x19\x91\x0e\x93\x3d\x3b\xfe\x8b\xce\xec\x73\xf0\x5f\xb4\xe7\x57\xbf\x8f\x5f\x8c\x7f\xd3\x1a\xf5\xc7\x00\x22\xf7\x5e\xc1\xb0\x06\x00\x00"),
 		},
 		"/infrastructure/rbac/viewer-role-openshift.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "viewer-role-openshift.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1711,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x54\xc1\x6e\x1b\x37\x10\xbd\xf3\x2b\x1e\x76\x2f\x49\x61\xaf\xda\x9e\x0a\xf5\xa4\x26\x76\x2b\x34\x90\x00\xaf\xd2\x20\x47\x8a\x3b\xda\x9d\x9a\x4b\xb2\x43\xae\x37\xea\xd7\x17\xa4\xa4\xd8\x8e\x5b\xa0\x05\x02\x74\x2f\x9a\x19\x0e\xe7\xbd\x37\x33\x54\x8d\xeb\xaf\xf7\xa9\x1a\xef\xd8\x90\x8b\xd4\x21\x79\xa4\x81\xb0\x0a\xda\x0c\x84\xd6\x1f\xd2\xac\x85\x70\xeb\x27\xd7\xe9\xc4\xde\xe1\xd5\xaa\xbd\x7d\x8d\xc9\x75\x24\xf0\x8e\xe0\x05\xa3\x17\x52\x35\x8c\x77\x49\x78\x3f\x25\x2f\xb0\xa7\x82\xd0\xbd\x10\x8d\xe4\x52\x6c\x80\x96\xa8\x54\xdf\x6c\x77\xeb\x37\x37\x38\xb0\x25\x74\x1c\x4f\x97\xa8\xc3\xcc\x69\x50\x35\xd2\xc0\x11\xb3\x97\x7b\x1c\xbc\x40\x77\x1d\x67\x60\x6d\xc1\xee\xe0\x65\x3c\xd1\x10\xea\xb5\x74\xec\x7a\x18\x1f\x8e\xc2\xfd\x90\xe0\x67\x47\x12\x07\x0e\x8d\xaa\xb1\xcb\x32\xda\xdb\x0b\x93\x78\x2a\x5b\x30\x93\xc7\x47\x3f\x9d\x35\x3c\x91\x7b\xee\xc2\x15\x7e\x23\x89\x19\xe4\xfb\xe6\x5b\x55\xe3\x55\x4e\xa9\xce\x87\xd5\xeb\x1f\x71\xf4\x13\x46\x7d\x84\xf3\x09\x53\xa4\x27\x95\xe9\x93\xa1\x90\xc0\x0e\xc6\x8f\xc1\xb2\x76\x86\x1e\x65\x7d\x46\x68\x50\x08\xe4\x1a\x7e\x9f\x34\x3b\xe8\x22\x03\xfe\xf0\x34\x0d\x3a\xa9\x5a\xd5\x28\xdf\x90\x52\x58\x2e\x16\xf3\x3c\x37\xba\xd0\x6d\xbc\xf4\x8b\x8b\xba\xc5\xbb\xf5\x9b\x9b\x4d\x7b\x73\x5d\x28\xab\x1a\xef\x9d\xa5\x18\x21\xf4\xc7\xc4\x42\x1d\xf6\x47\xe8\x10\x2c\x1b\xbd\xb7\x04\xab\xe7\x3c\xb8\x32\x9d\x32\x74\x76\x98\x85\x13\xbb\xfe\x0a\xf1\x3c\x75\x55\x3f\x9b\xce\x63\xbb\x2e\xf4\x38\x3e\x4b\xf0\x0e\xda\xa1\x5a\xb5\x58\xb7\x15\x7e\x5a\xb5\xeb\xf6\x4a\xd5\xf8\xb0\xde\xfd\xb2\x7d\xbf\xc3\x87\xd5\xdd\xdd\x6a\xb3\x5b\xdf\xb4\xd8\xde\xe1\xcd\x76\xf3\x76\xbd\x5b\x6f\x37\x2d\xb6\xb7\x58\x6d\x3e\xe2\xd7\xf5\xe6\xed\x15\x88\xd3\x40\x02\xfa\x14\x24\xf3\xf7\x02\xce\x8d\xa4\x2e\xcf\xf4\xb2\x40\x17\x02\x79\x3f\xb2\x1f\x03\x19\x3e\xb0\x81\xd5\xae\x9f\x74\x4f\xe8\xfd\x03\x89\xcb\xeb\x11\x48\x46\x8e\x79\x9c\x11\xda\x75\xaa\x86\xe5\x91\x53\xd9\xa2\xf8\x52\x54\x86\xf9\x9a\x6f\x4b\xdd\xb3\xeb\x96\xb8\xf3\x96\x94\x0e\x7c\xde\xac\x25\x64\xaf\x4d\xa3\xa7\x34\x78\xe1\x3f\x0b\x99\xe6\xfe\x87\xd8\xb0\x5f\x3c\x7c\xa7\x46\x4a\xba\xd3\x49\x2f\x15\xe0\xf4\x48\x4b\x1c\xf5\x7d\xbc\x7e\x60\x9a\x49\x14\x60\xf5\x9e\x6c\xcc\xa7\xc8\x53\x5d\xa2\xca\xe7\x95\x92\xc9\x52\x5c\xaa\x6b\xe8\xc0\x3f\x8b\x9f\x42\xc9\xb9\x2e\xb7\x1b\xc3\x49\xa6\x78\x10\x3d\x52\x7e\x5c\x79\x7d\x14\x20\x14\xfd\x24\x86\xce\x99\xd5\x37\x95\x02\x1e\x48\xf6\x4f\x02\x2f\xea\x55\xd5\xcb\x9b\xc1\x77\xb1\x18\x91\xe4\x81\x0d\x5d\x1c\x23\x94\x4e\x36\xb9\x2e\x78\x76\x67\xcf\x78\x77\xe0\x7e\xd4\x21\x3e\x07\x34\x42\x3a\x51\x31\x3b\xb2\xf4\xcc\x34\xde\x5a\x32\xb9\x59\x25\xd8\x53\x2a\xbf\x96\xe3\xc9\x08\x3a\x99\xa1\x58\x53\xe8\x2e\x55\xe6\x12\xfc\xd7\x1a\x16\xd6\xf7\x8f\x4e\x4c\x3a\x4d\x5f\x30\x7c\x01\xfb\x0f\x08\x3a\x14\x6d\x5f\x60\x74\x14\xac\x3f\x96\xbf\xc4\xe2\x0b\x95\x57\x19\x2f\x5d\xca\x88\x74\x98\xec\x39\xf0\x7f\x74\x66\x9f\xe3\x7f\xd3\x9e\xdf\xfd\x3e\x7e\x36\xfe\x4b\x6b\xfe\x0a\x00\x00\xff\xff\x12\xf9\x80\x30\xaf\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x54\x41\x6f\xe3\x36\x13\xbd\xf3\x57\x3c\x48\x97\xdd\x0f\x89\xfc\xb5\xa7\xc2\x3d\xb9\xd9\xa4\x35\xba\xb0\x81\xc8\xdb\xc5\x1e\x69\x6a\x2c\x4d\x43\x91\xec\x90\x8a\xd6\xfd\xf5\x05\x69\x7b\x93\x34\x2d\xd0\x02\x0b\x94\x17\x0d\x87\xc3\x79\x6f\xe6\x0d\x55\xe3\xfa\xeb\x2d\x55\xe3\x3d\x1b\x72\x91\x3a\x24\x8f\x34\x10\x56\x41\x9b\x81\xd0\xfa\x43\x9a\xb5\x10\xee\xfc\xe4\x3a\x9d\xd8\x3b\xbc\x59\xb5\x77\x6f\x31\xb9\x8e\x04\xde\x11\xbc\x60\xf4\x42\xaa\x86\xf1\x2e\x09\xef\xa7\xe4\x05\xf6\x94\x10\xba\x17\xa2\x91\x5c\x8a\x0d\xd0\x12\x95\xec\x9b\xed\x6e\x7d\x73\x8b\x03\x5b\x42\xc7\xf1\x74\x89\x3a\xcc\x9c\x06\x55\x23\x0d\x1c\x31\x7b\x79\xc0\xc1\x0b\x74\xd7\x71\x06\xd6\x16\xec\x0e\x5e\xc6\x13\x0d\xa1\x5e\x4b\xc7\xae\x87\xf1\xe1\x28\xdc\x0f\x09\x7e\x76\x24\x71\xe0\xd0\xa8\x1a\xbb\x5c\x46\x7b\x77\x61\x12\x4f\x69\x0b\x66\xf2\xf8\xe4\xa7\x73\x0d\xcf\xca\x3d\x77\xe1\x0a\xbf\x90\xc4\x0c\xf2\x6d\xf3\x7f\x55\xe3\x4d\x0e\xa9\xce\x87\xd5\xdb\xef\x71\xf4\x13\x46\x7d\x84\xf3\x09\x53\xa4\x67\x99\xe9\xb3\xa1\x90\xc0\x0e\xc6\x8f\xc1\xb2\x76\x86\x9e\xca\xfa\x82\xd0\xa0\x10\xc8\x39\xfc\x3e\x69\x76\xd0\xa5\x0c\xf8\xc3\xf3\x30\xe8\xa4\x6a\x55\xa3\xac\x21\xa5\xb0\x5c\x2c\xe6\x79\x6e\x74\x51\xa7\xf1\xd2\x2f\x2e\xd5\x2d\xde\xaf\x6f\x6e\x37\xed\xed\x75\xa1\xac\x6a\x7c\x70\x96\x62\x84\xd0\x6f\x13\x0b\x75\xd8\x1f\xa1\x43\xb0\x6c\xf4\xde\x12\xac\x9e\xb3\x70\x45\x9d\x22\x3a\x3b\xcc\xc2\x89\x5d\x7f\x85\x78\x56\x5d\xd5\x2f\xd4\x79\x6a\xd7\x85\x1e\xc7\x17\x01\xde\x41\x3b\x54\xab\x16\xeb\xb6\xc2\x0f\xab\x76\xdd\x5e\xa9\x1a\x1f\xd7\xbb\x9f\xb6\x1f\x76\xf8\xb8\xba\xbf\x5f\x6d\x76\xeb\xdb\x16\xdb\x7b\xdc\x6c\x37\xef\xd6\xbb\xf5\x76\xd3\x62\x7b\x87\xd5\xe6\x13\x7e\x5e\x6f\xde\x5d\x81\x38\x0d\x24\xa0\xcf\x41\x32\x7f\x2f\xe0\xdc\x48\xea\xb2\xa6\x97\x01\xba\x10\xc8\xf3\x91\xf7\x31\x90\xe1\x03\x1b\x58\xed\xfa\x49\xf7\x84\xde\x3f\x92\xb8\x3c\x1e\x81\x64\xe4\x98\xe5\x8c\xd0\xae\x53\x35\x2c\x8f\x9c\xca\x14\xc5\xd7\x45\x65\x98\xcb\xc3\xf8\x0a\x4b\xa9\x07\x76\xdd\x12\xf7\xde\x92\xd2\x81\xcf\x93\xb5\x84\xec\xb5\x69\xf4\x94\x06\x2f\xfc\x7b\x21\xd3\x3c\x7c\x17\x1b\xf6\x8b\xc7\x6f\xd4\x48\x49\x77\x3a\xe9\xa5\x02\x9c\x1e\x69\x89\xa3\x7e\x88\xd7\x8f\x4c\x33\x89\x02\xac\xde\x93\x8d\xf9\x14\x59\xd5\x25\xaa\x7c\x5e\x29\x99\x2c\xc5\xa5\xba\x86\x0e\xfc\xa3\xf8\x29\x94\x98\xeb\x72\xbb\x31\x9c\x64\x8a\x07\xd1\x23\xe5\xc7\x95\xc7\x47\x01\x42\xd1\x4f\x62\xe8\x1c\x59\xfd\xaf\x52\xc0\x23\xc9\xfe\x99\xe3\x55\xbe\xaa\x7a\x7d\x33\xf8\x2e\x16\x23\x92\x3c\xb2\xa1\xcb\xc6\x08\xa5\x93\x4d\xae\x0b\x9e\xdd\x79\x67\xbc\x3b\x70\x3f\xea\x10\x5f\x02\x1a\x21\x9d\xa8\x98\x1d\x59\x7a\x61\x1a\x6f\x2d\x99\xdc\xac\xe2\xec\x29\x95\xaf\xe5\x78\x32\x82\x4e\x66\x28\xd6\x14\xba\x4b\x96\xb9\x38\xff\x71\x0d\x0b\xeb\xfb\xa7\x4d\x4c\x3a\x4d\x7f\x62\xf8\x0a\xf6\x6f\x10\x74\x08\xf1\x35\x46\x47\xc1\xfa\x63\xf9\x25\x96\x28\xa1\xf2\x2a\xe3\xa5\x4b\x19\x91\x0e\x93\x3d\x3b\xfe\x8b\xce\xec\x73\xf0\x5f\xb4\xe7\x57\xbf\x8f\x5f\x8c\x7f\xd3\x9a\x3f\x06\x00\x12\xf9\x80\x30\xaf\x06\x00\x00"),
 		},
 		"/infrastructure/rbac/viewer-role-strimzi.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "viewer-role-strimzi.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1245,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xac\x4b\x02\xac\xe5\xb6\xa7\xc2\x3d\xb9\x9b\xdd\x56\x68\x60\x03\x96\xd3\x20\xc7\x31\x35\x96\x06\xa6\x48\x96\xa4\xac\x38\x5f\x5f\x50\x96\xb3\xbb\xed\x35\x3c\x91\x9e\xe7\x37\xef\xcd\x1b\x15\x58\xfe\xb8\xa3\x0a\x7c\x14\xcd\x36\x72\x83\xe4\x90\x3a\xc6\xc6\x93\xee\x18\xb5\x3b\xa5\x91\x02\xe3\xd9\x0d\xb6\xa1\x24\xce\xe2\xdd\xa6\x7e\x7e\x8f\xc1\x36\x1c\xe0\x2c\xc3\x05\xf4\x2e\xb0\x2a\xa0\x9d\x4d\x41\x8e\x43\x72\x01\xe6\x46\x08\x6a\x03\x73\xcf\x36\xc5\x12\xa8\x99\x27\xf6\xed\xee\x50\x3d\x3e\xe1\x24\x86\xd1\x48\xbc\xfd\x89\x1b\x8c\x92\x3a\x55\x20\x75\x12\x31\xba\x70\xc6\xc9\x05\x50\xd3\x48\x6e\x4c\x06\x62\x4f\x2e\xf4\x37\x19\x81\x5b\x0a\x8d\xd8\x16\xda\xf9\x6b\x90\xb6\x4b\x70\xa3\xe5\x10\x3b\xf1\xa5\x2a\x70\xc8\x36\xea\xe7\xbb\x92\x78\xa3\x9d\x7a\x26\x87\x2f\x6e\x98\x3d\xbc\xb2\x3b\x4f\xe1\x01\x7f\x73\x88\xb9\xc9\x2f\xe5\x4f\xaa\xc0\xbb\x0c\x59\xcc\xc5\xc5\xfb\xdf\x70\x75\x03\x7a\xba\xc2\xba\x84\x21\xf2\x2b\x66\xfe\xaa\xd9\x27\x88\x85\x76\xbd\x37\x42\x56\xf3\x8b\xad\xef\x1d\x4a\x4c\x02\x32\x87\x3b\x26\x12\x0b\x9a\x6c\xc0\x9d\x5e\xc3\x40\x49\x15\xaa\xc0\x74\xba\x94\xfc\x7a\xb5\x1a\xc7\xb1\xa4\x49\x6e\xe9\x42\xbb\xba\xbb\x5b\x7d\xac\x1e\x9f\xb6\xf5\xd3\x72\x92\xac\x0a\x7c\xb2\x86\x63\x44\xe0\x7f\x06\x09\xdc\xe0\x78\x05\x79\x6f\x44\xd3\xd1\x30\x0c\x8d\x39\xb8\x29\x9d\x29\x74\xb1\x18\x83\x24\xb1\xed\x03\xe2\x9c\xba\x2a\xde\xa4\xf3\x32\xae\xbb\x3c\x89\x6f\x00\xce\x82\x2c\x16\x9b\x1a\x55\xbd\xc0\xef\x9b\xba\xaa\x1f\x54\x81\xcf\xd5\xe1\xcf\xdd\xa7\x03\x3e\x6f\xf6\xfb\xcd\xf6\x50\x3d\xd5\xd8\xed\xf1\xb8\xdb\x7e\xa8\x0e\xd5\x6e\x5b\x63\xf7\x8c\xcd\xf6\x0b\xfe\xaa\xb6\x1f\x1e\xc0\x92\x3a\x0e\xe0\xaf\x3e\x64\xfd\x2e\x40\xf2\x20\xb9\xc9\x99\xde\x17\xe8\x2e\x20\xef\x47\x7e\x47\xcf\x5a\x4e\xa2\x61\xc8\xb6\x03\xb5\x8c\xd6\x5d\x38\xd8\xbc\x1e\x9e\x43\x2f\x31\xc7\x19\x41\xb6\x51\x05\x8c\xf4\x92\xa6\x2d\x8a\xff\x37\x95\xdb\xfc\xc8\x6f\x4b\x9d\xc5\x36\x6b\xec\x9d\x61\x45\x5e\xe6\xcd\x5a\x23\x1c\x49\x97\x34\xa4\xce\x05\xf9\x36\x89\x29\xcf\xbf\xc6\x52\xdc\xea\xf2\xb3\xea\x39\x51\x43\x89\xd6\x0a\xb0\xd4\xf3\x1a\x57\x3a\xc7\xe5\x45\x78\xe4\xb0\xcc\x13\xef\xbf\x89\x02\x0c\x1d\xd9\xc4\x8c\x42\x4e\x77\x8d\x45\xc6\x2d\x54\x18\x0c\xc7\xb5\x5a\x82\xbc\xfc\x11\xdc\xe0\x67\xcc\x12\x67\x3a\x9d\xa9\x9c\x19\x4a\x71\x0a\x08\x1c\xdd\x10\x34\xbf\xc5\x24\xe7\x45\x47\x05\x5c\x38\x1c\xbf\x97\x74\x60\x4a\x3c\x3f\x1a\x36\xfc\x9f\x87\x76\xc6\xb0\xce\x6e\xe6\x9f\x5b\x4e\xf3\xcd\x48\xbc\x5f\x3d\x25\xdd\xcd\xf7\xc1\x37\x2f\x8c\xe3\x54\xf8\x37\x00\x00\xff\xff\x40\x19\xd0\xb3\xdd\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xac\x4b\x02\xac\xe5\xb6\xa7\xc2\x3d\xb9\x9b\xdd\x56\x68\x60\x03\x96\xd3\x20\xc7\x31\x35\x96\x06\xa6\x48\x96\xa4\xac\x38\x5f\x5f\x50\x96\xb3\xbb\xed\x35\x3c\x91\xe2\xd3\x9b\xf7\xe6\x0d\x0b\x2c\x7f\xdc\x52\x05\x3e\x8a\x66\x1b\xb9\x41\x72\x48\x1d\x63\xe3\x49\x77\x8c\xda\x9d\xd2\x48\x81\xf1\xec\x06\xdb\x50\x12\x67\xf1\x6e\x53\x3f\xbf\xc7\x60\x1b\x0e\x70\x96\xe1\x02\x7a\x17\x58\x15\xd0\xce\xa6\x20\xc7\x21\xb9\x00\x73\x23\x04\xb5\x81\xb9\x67\x9b\x62\x09\xd4\xcc\x13\xfb\x76\x77\xa8\x1e\x9f\x70\x12\xc3\x68\x24\xde\x7e\xe2\x06\xa3\xa4\x4e\x15\x48\x9d\x44\x8c\x2e\x9c\x71\x72\x01\xd4\x34\x92\x0b\x93\x81\xd8\x93\x0b\xfd\x4d\x46\xe0\x96\x42\x23\xb6\x85\x76\xfe\x1a\xa4\xed\x12\xdc\x68\x39\xc4\x4e\x7c\xa9\x0a\x1c\xb2\x8d\xfa\xf9\xae\x24\xde\x68\xa7\x9a\xc9\xe1\x8b\x1b\x66\x0f\xaf\xec\xce\x5d\x78\xc0\xdf\x1c\x62\x2e\xf2\x4b\xf9\x93\x2a\xf0\x2e\x43\x16\xf3\xe5\xe2\xfd\x6f\xb8\xba\x01\x3d\x5d\x61\x5d\xc2\x10\xf9\x15\x33\x7f\xd5\xec\x13\xc4\x42\xbb\xde\x1b\x21\xab\xf9\xc5\xd6\xf7\x0a\x25\x26\x01\x99\xc3\x1d\x13\x89\x05\x4d\x36\xe0\x4e\xaf\x61\xa0\xa4\x0a\x55\x60\x5a\x5d\x4a\x7e\xbd\x5a\x8d\xe3\x58\xd2\x94\x4e\xe9\x42\xbb\xba\xbb\x5b\x7d\xac\x1e\x9f\xb6\xf5\xd3\x72\x92\xac\x0a\x7c\xb2\x86\x63\x44\xe0\x7f\x06\x09\xdc\xe0\x78\x05\x79\x6f\x44\xd3\xd1\x30\x0c\x8d\x39\xb8\x29\x9d\x29\x74\xb1\x18\x83\x24\xb1\xed\x03\xe2\x9c\xba\x2a\xde\xa4\xf3\xd2\xae\xbb\x3c\x89\x6f\x00\xce\x82\x2c\x16\x9b\x1a\x55\xbd\xc0\xef\x9b\xba\xaa\x1f\x54\x81\xcf\xd5\xe1\xcf\xdd\xa7\x03\x3e\x6f\xf6\xfb\xcd\xf6\x50\x3d\xd5\xd8\xed\xf1\xb8\xdb\x7e\xa8\x0e\xd5\x6e\x5b\x63\xf7\x8c\xcd\xf6\x0b\xfe\xaa\xb6\x1f\x1e\xc0\x92\x3a\x0e\xe0\xaf\x3e\x64\xfd\x2e\x40\x72\x23\xb9\xc9\x99\xde\x07\xe8\x2e\x20\xcf\x47\x3e\x47\xcf\x5a\x4e\xa2\x61\xc8\xb6\x03\xb5\x8c\xd6\x5d\x38\xd8\x3c\x1e\x9e\x43\x2f\x31\xc7\x19\x41\xb6\x51\x05\x8c\xf4\x92\xa6\x29\x8a\xff\x37\x95\xcb\xdc\x1f\xc6\x0f\x58\x4a\x9d\xc5\x36\x6b\xec\x9d\x61\x45\x5e\xe6\xc9\x5a\x23\x1c\x49\x97\x34\xa4\xce\x05\xf9\x36\x89\x29\xcf\xbf\xc6\x52\xdc\xea\xf2\xb3\xea\x39\x51\x43\x89\xd6\x0a\xb0\xd4\xf3\x1a\x57\x3a\xc7\xe5\x45\x78\xe4\xb0\xcc\x0f\xa6\xff\x26\x0a\x30\x74\x64\x13\x33\x0a\x39\xdd\x35\x16\x19\xb7\x50\x61\x30\x1c\xd7\x6a\x09\xf2\xf2\x47\x70\x83\x9f\x31\x4b\x9c\xe9\x74\xa6\x72\x66\x28\xc5\x29\x20\x70\x74\x43\xd0\xfc\x16\x93\x9c\x17\x1d\x15\x70\xe1\x70\xfc\x7e\xa5\x03\x53\xe2\xf9\xd0\xb0\xe1\xff\x1c\xb4\x33\x86\x75\x76\x33\x7f\x6e\x39\xcd\x3b\x23\xf1\xbe\xf5\x94\x74\x37\xef\x07\xdf\xbc\x30\x8e\x94\x74\xa7\xfe\x1d\x00\x40\x19\xd0\xb3\xdd\x04\x00\x00"),
 		},
 		"/manager": &vfsgen۰DirInfo{
 			name:    "manager",
@@ -162,14 +162,14 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 2145,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x54\x4d\x6f\xe3\x36\x14\xbc\xeb\x57\x0c\xac\xcb\x2e\x10\xcb\xbb\x3d\xf4\xa0\x9e\xd4\xc4\x41\x8c\xa6\xb2\x61\x79\x1b\xec\xa9\x78\xa1\x9e\x24\x22\x14\xa9\x92\x54\xb4\xfa\xf7\x05\x65\x3b\xb1\xb3\xeb\x05\x0a\x04\xe5\x49\xd2\xfb\x9a\x79\x33\x62\x8c\xf9\xfb\x9d\x28\xc6\xbd\x14\xac\x1d\x97\xf0\x06\xbe\x61\x64\x1d\x89\x86\x51\x98\xca\x0f\x64\x19\xb7\xa6\xd7\x25\x79\x69\x34\x3e\x64\xc5\xed\x47\xf4\xba\x64\x0b\xa3\x19\xc6\xa2\x35\x96\xa3\x18\xc2\x68\x6f\xe5\x63\xef\x8d\x85\xda\x37\x04\xd5\x96\xb9\x65\xed\x5d\x02\x14\xcc\x53\xf7\x7c\xbd\x5b\x5d\x2f\x51\x49\xc5\x28\xa5\xdb\x17\x71\x89\x41\xfa\x26\x8a\xe1\x1b\xe9\x30\x18\xfb\x84\xca\x58\x50\x59\xca\x30\x98\x14\xa4\xae\x8c\x6d\xf7\x30\x2c\xd7\x64\x4b\xa9\x6b\x08\xd3\x8d\x56\xd6\x8d\x87\x19\x34\x5b\xd7\xc8\x2e\x89\x62\xec\x02\x8d\xe2\xf6\x88\xc4\xed\xdb\x4e\x33\xbd\xc1\x57\xd3\x1f\x38\x9c\xd0\x3d\x6c\xe1\x0a\x7f\xb1\x75\x61\xc8\x2f\xc9\xa7\x28\xc6\x87\x90\x32\x3b\x04\x67\x1f\x7f\xc3\x68\x7a\xb4\x34\x42\x1b\x8f\xde\xf1\x49\x67\xfe\x26\xb8\xf3\x90\x1a\xc2\xb4\x9d\x92\xa4\x05\xbf\xd2\x7a\x99\x90\x60\x02\x10\x7a\x98\x47\x4f\x52\x83\x26\x1a\x30\xd5\x69\x1a\xc8\x47\x71\x14\x63\x3a\x8d\xf7\x5d\xba\x58\x0c\xc3\x90\xd0\x04\x37\x31\xb6\x5e\x1c\xd9\x2d\xee\x57\xd7\xcb\xbc\x58\xce\x27\xc8\x51\x8c\x2f\x5a\xb1\x73\xb0\xfc\x4f\x2f\x2d\x97\x78\x1c\x41\x5d\xa7\xa4\xa0\x47\xc5\x50\x34\x04\xe1\x26\x75\x26\xd1\xa5\xc6\x60\xa5\x97\xba\xbe\x82\x3b\xa8\x1e\xc5\x67\xea\xbc\xae\xeb\x08\x4f\xba\xb3\x04\xa3\x41\x1a\xb3\xac\xc0\xaa\x98\xe1\xf7\xac\x58\x15\x57\x51\x8c\x87\xd5\xee\x6e\xfd\x65\x87\x87\x6c\xbb\xcd\xf2\xdd\x6a\x59\x60\xbd\xc5\xf5\x3a\xbf\x59\xed\x56\xeb\xbc\xc0\xfa\x16\x59\xfe\x15\x7f\xac\xf2\x9b\x2b\xb0\xf4\x0d\x5b\xf0\xb7\xce\x06\xfc\xc6\x42\x86\x45\x72\x19\x34\x3d\x1a\xe8\x08\x20\xf8\x23\xbc\xbb\x8e\x85\xac\xa4\x80\x22\x5d\xf7\x54\x33\x6a\xf3\xcc\x56\x07\x7b\x74\x6c\x5b\xe9\x82\x9c\x0e\xa4\xcb\x28\x86\x92\xad\xf4\x93\x8b\xdc\xf7\xa4\xc2\x98\xf7\xfc\xb7\xa2\x27\xa9\xcb\x14\x37\xdc\x29\x33\x86\x1f\x21\xa2\x4e\x1e\xfc\x95\x06\x49\xdc\xe2\xf9\x73\xd4\xb2\xa7\x92\x3c\xa5\x11\xa0\xa9\xe5\x14\x23\x3d\xb9\xb9\xe9\xd8\x92\x37\x36\x02\x14\x3d\xb2\x72\x21\x8e\x50\x95\x62\x16\x32\x66\xd3\x7b\x78\x4a\x84\xf4\xb6\x77\x95\xa5\x96\xc3\xbf\x33\xb9\x23\x98\xd0\x68\xd6\x3e\xc5\x4b\xab\xb0\xab\xd0\xc6\xf2\xe4\x06\x97\xe2\x73\x04\x38\x6f\xc9\x73\x3d\xee\x07\xf8\xb1\xe3\x14\x5b\x16\x96\xc9\x73\x08\xb3\x62\xe1\x8d\xdd\x87\x5b\xf2\xa2\xb9\x3f\x01\x74\x09\xb4\xe7\xb6\x53\xe4\xf9\x50\x76\x42\x32\x1c\x75\xd6\xe1\x52\x8f\xfd\xf9\x4f\x14\x8f\x45\x6f\xf7\x74\xa4\x3e\x3d\xb3\x7d\x96\x82\x33\x21\x4c\xaf\x7d\x7e\x71\x74\xb8\xd4\x48\x86\x7b\xe5\x15\xe8\xfc\xa7\x50\x01\xd9\x52\xcd\x29\x4a\x23\x9e\xd8\x26\xd2\x2c\xde\x00\x5f\x84\xc2\xf4\x53\xf2\x6b\xf2\x69\x5e\xe4\xd9\xa6\xb8\x5b\xef\x4e\xca\x85\x69\x5b\xd2\x65\x7a\xf2\x69\x3e\xcd\x3a\xfb\x70\x69\xee\xa6\x57\x6a\x63\x94\x14\x63\x8a\x55\x95\x1b\xbf\xb1\xec\x82\xef\x5e\xf3\x58\x3f\x9f\x36\x7f\x25\xf4\x90\xed\xae\xef\xfe\xce\xb3\x3f\x97\xc5\x26\xbb\x5e\x9e\xe5\x00\xcf\xa4\x7a\xbe\xb5\xa6\x4d\xdf\x04\x80\x4a\xb2\x2a\xb7\x5c\x7d\x1f\x39\xc4\x36\xe4\x9b\xf4\xc5\x01\x49\x18\xe7\x3a\x12\xfc\x43\x18\xeb\xcd\x72\x9b\xed\xd6\xdb\x09\xc9\x8f\x40\x9c\xa9\xfa\xb6\x7a\xb3\xbe\xb9\x58\xf8\x7e\xe8\xcf\x52\x63\xbc\xec\x2c\x5c\x8a\xa4\x06\x1a\xdd\x74\xab\x1c\x55\xc2\x0b\xe3\x2b\x48\x5d\x72\xc7\xba\x64\xed\xd5\x88\xca\x9a\xf6\xa7\x8b\x3f\xf2\xfa\x5f\x65\xf9\x37\x00\x00\xff\xff\xde\x0e\xf6\x9d\x61\x08\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x5f\x6f\xa3\xb8\x17\x7d\xe7\x53\x1c\x85\x97\x19\xa9\x25\x9d\xdf\xcb\x4f\x62\x9f\xd8\x36\x55\xa3\xed\x92\x28\x64\xb6\x9a\xa7\xd5\xad\xb9\x80\x55\x63\xb3\xb6\x29\xc3\xb7\x5f\x99\x86\x34\xe9\x4c\x47\x5a\xa9\xe6\xa5\xf4\xfe\x3b\xe7\x9e\x63\x12\xe3\xf2\xe3\x4e\x14\xe3\x5e\x0a\xd6\x8e\x4b\x78\x03\xdf\x30\xb2\x8e\x44\xc3\x28\x4c\xe5\x07\xb2\x8c\x5b\xd3\xeb\x92\xbc\x34\x1a\x9f\xb2\xe2\xf6\x33\x7a\x5d\xb2\x85\xd1\x0c\x63\xd1\x1a\xcb\x51\x0c\x61\xb4\xb7\xf2\xb1\xf7\xc6\x42\xbd\x34\x04\xd5\x96\xb9\x65\xed\x5d\x02\x14\xcc\x53\xf7\x7c\xb3\x5f\x5f\xaf\x50\x49\xc5\x28\xa5\x7b\x29\xe2\x12\x83\xf4\x4d\x14\xc3\x37\xd2\x61\x30\xf6\x09\x95\xb1\xa0\xb2\x94\x61\x30\x29\x48\x5d\x19\xdb\xbe\xc0\xb0\x5c\x93\x2d\xa5\xae\x21\x4c\x37\x5a\x59\x37\x1e\x66\xd0\x6c\x5d\x23\xbb\x24\x8a\xb1\x0f\x34\x8a\xdb\x19\x89\x7b\x69\x3b\xcd\xf4\x06\xdf\x4c\x7f\xe0\x70\x42\xf7\xb0\x85\x0b\xfc\xc5\xd6\x85\x21\xff\x4b\xae\xa2\x18\x9f\x42\xca\xe2\x10\x5c\x7c\xfe\x0d\xa3\xe9\xd1\xd2\x08\x6d\x3c\x7a\xc7\x27\x9d\xf9\xbb\xe0\xce\x43\x6a\x08\xd3\x76\x4a\x92\x16\xfc\x4a\xeb\x38\x21\xc1\x04\x20\xf4\x30\x8f\x9e\xa4\x06\x4d\x34\x60\xaa\xd3\x34\x90\x8f\xe2\x28\xc6\x74\x1a\xef\xbb\x74\xb9\x1c\x86\x21\xa1\x49\x9d\xc4\xd8\x7a\x39\xb3\x5b\xde\xaf\xaf\x57\x79\xb1\xba\x9c\x20\x47\x31\xbe\x6a\xc5\xce\xc1\xf2\x3f\xbd\xb4\x5c\xe2\x71\x04\x75\x9d\x92\x82\x1e\x15\x43\xd1\x10\x84\x9b\xd4\x99\x44\x97\x1a\x83\x95\x5e\xea\xfa\x02\xee\xa0\x7a\x14\x9f\xa9\xf3\xba\xae\x19\x9e\x74\x67\x09\x46\x83\x34\x16\x59\x81\x75\xb1\xc0\xef\x59\xb1\x2e\x2e\xa2\x18\x0f\xeb\xfd\xdd\xe6\xeb\x1e\x0f\xd9\x6e\x97\xe5\xfb\xf5\xaa\xc0\x66\x87\xeb\x4d\x7e\xb3\xde\xaf\x37\x79\x81\xcd\x2d\xb2\xfc\x1b\xfe\x58\xe7\x37\x17\x60\xe9\x1b\xb6\xe0\xef\x9d\x0d\xf8\x8d\x85\x0c\x8b\xe4\x32\x68\x3a\x1b\x68\x06\x10\xfc\x11\xde\x5d\xc7\x42\x56\x52\x40\x91\xae\x7b\xaa\x19\xb5\x79\x66\xab\x83\x3d\x3a\xb6\xad\x74\x41\x4e\x07\xd2\x65\x14\x43\xc9\x56\xfa\xc9\x45\xee\x47\x52\x61\xcc\x7c\x31\x3e\xe0\x44\xd1\x93\xd4\x65\x8a\x1b\xee\x94\x19\xc3\x45\x88\xa8\x93\x07\x7f\xa5\x41\x12\xb7\x7c\xfe\x12\xb5\xec\xa9\x24\x4f\x69\x04\x68\x6a\x39\xc5\x48\x4f\xee\xd2\x74\x6c\xc9\x1b\x1b\x01\x8a\x1e\x59\xb9\x10\x47\xa8\x4a\xb1\x08\x19\x8b\xe9\x3d\xfc\x95\x08\xe9\x6d\xef\x2a\x4b\x2d\x87\xbb\x33\xb9\x23\x98\xd0\x68\xd6\x3e\xc5\xb1\x55\xd8\x55\x68\x63\x79\x72\x83\x4b\xf1\x25\x02\x9c\xb7\xe4\xb9\x1e\x43\x04\xf0\x63\xc7\x29\x76\x2c\x2c\x93\xe7\x10\x66\xc5\xc2\x1b\xfb\x12\x6e\xc9\x8b\xe6\xfe\x04\xd0\x7b\xa0\x3d\xb7\x9d\x22\xcf\x87\xb2\x13\x92\xc0\x39\xa5\xf7\x7b\x00\xff\x99\xe2\x5c\xf4\x76\x4f\x33\xf5\xf0\x38\xb6\xcf\x52\x70\x26\x84\xe9\xb5\xcf\xdf\x1d\x1d\x3e\x6a\x24\xc3\x77\xe5\x15\xe8\xe5\x2f\xa1\x02\xb2\xa5\x9a\x53\x94\x46\x3c\xb1\x4d\xa4\x59\xbe\x01\xbe\x0c\x90\xd2\xab\xe4\xff\xc9\xd5\x65\x91\x67\xdb\xe2\x6e\xb3\x3f\x29\x17\xa6\x6d\x49\x97\xaf\xf3\xc2\xc4\x50\x72\xf6\x8f\xf7\xe6\x6e\x7b\xa5\xb6\x46\x49\x31\xa6\x58\x57\xb9\xf1\x5b\xcb\x2e\xf8\x6e\xce\x02\x58\x3f\x9f\x36\x7f\x25\xf4\x90\xed\xaf\xef\xfe\xce\xb3\x3f\x57\xc5\x36\xbb\x5e\x9d\xe5\x00\xcf\xa4\x7a\xbe\xb5\xa6\x3d\x2f\x0e\x4f\x25\x59\x95\x3b\xae\x7e\x8c\x1c\x62\x5b\xf2\x4d\x7a\x74\x40\x12\xc6\xb9\x8e\x04\xff\x14\xc6\x66\xbb\xda\x65\xfb\xcd\x6e\x42\xf2\xa6\xe3\x04\xe2\x4c\xd5\xb7\xd5\xdb\xcd\xcd\xbb\x85\x1f\x87\xfe\x2c\x35\xc6\x71\x67\x90\x0e\xa4\x06\x1a\xc3\x0f\x0e\x1f\x3d\x89\x23\xe3\x0b\x48\x5d\x72\xc7\xba\x64\xed\xd5\x88\xca\x9a\xf6\x97\x8b\x9f\x79\xfd\x3c\xfa\xe1\xc4\x5c\x47\x82\xa3\x7f\x07\x00\xdc\x2b\xd8\xc4\x61\x08\x00\x00"),
 		},
 		"/manager/operator-service-account.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-service-account.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1033,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x4f\xe3\x48\x10\xbd\xf7\xaf\x78\x8a\x2f\x20\x85\x64\x77\x8f\xd9\x93\x07\x12\x8d\x35\xc8\x91\x70\x18\xc4\xb1\x62\x57\xec\x12\x76\x97\xa7\xbb\x8d\xc9\xbf\x1f\xb5\x93\x0c\xa0\xb9\xd2\x37\xab\xcb\xef\xa3\xde\xeb\x04\x37\x5f\x77\x4c\x82\x7b\x29\xd9\x7a\xae\x10\x14\xa1\x61\xa4\x3d\x95\x0d\xa3\xd0\x43\x18\xc9\x31\x36\x3a\xd8\x8a\x82\xa8\xc5\x55\x5a\x6c\xae\x31\xd8\x8a\x1d\xd4\x32\xd4\xa1\x53\xc7\x26\x41\xa9\x36\x38\xd9\x0f\x41\x1d\xda\x13\x20\xa8\x76\xcc\x1d\xdb\xe0\x17\x40\xc1\x3c\xa1\xe7\xdb\x5d\x76\xbb\xc6\x41\x5a\x46\x25\xfe\xf4\x13\x57\x18\x25\x34\x26\x41\x68\xc4\x63\x54\xf7\x82\x83\x3a\x50\x55\x49\x24\xa6\x16\x62\x0f\xea\xba\x93\x0c\xc7\x35\xb9\x4a\x6c\x8d\x52\xfb\xa3\x93\xba\x09\xd0\xd1\xb2\xf3\x8d\xf4\x0b\x93\x60\x17\x6d\x14\x9b\x8b\x12\x7f\x82\x9d\x38\x83\xe2\x59\x87\xb3\x87\x0f\x76\xcf\x5b\x98\xe3\x27\x3b\x1f\x49\xfe\x5b\xfc\x63\x12\x5c\xc5\x91\xd9\xf9\x72\x76\xfd\x3f\x8e\x3a\xa0\xa3\x23\xac\x06\x0c\x9e\x3f\x20\xf3\x5b\xc9\x7d\x80\x58\x94\xda\xf5\xad\x90\x2d\xf9\xdd\xd6\x1f\x86\x05\x26\x01\x11\x43\xf7\x81\xc4\x82\x26\x1b\xd0\xc3\xc7\x31\x50\x30\x89\x49\x30\x9d\x26\x84\x7e\xb5\x5c\x8e\xe3\xb8\xa0\x49\xee\x42\x5d\xbd\xbc\xb8\x5b\xde\x67\xb7\xeb\xbc\x58\xdf\x4c\x92\x4d\x82\x47\xdb\xb2\xf7\x70\xfc\x6b\x10\xc7\x15\xf6\x47\x50\xdf\xb7\x52\xd2\xbe\x65\xb4\x34\xc6\xe0\xa6\x74\xa6\xd0\xc5\x62\x74\x12\xc4\xd6\x73\xf8\x73\xea\x26\xf9\x94\xce\xfb\xba\x2e\xf2\xc4\x7f\x1a\x50\x0b\xb2\x98\xa5\x05\xb2\x62\x86\x6f\x69\x91\x15\x73\x93\xe0\x29\xdb\x7d\xdf\x3e\xee\xf0\x94\x3e\x3c\xa4\xf9\x2e\x5b\x17\xd8\x3e\xe0\x76\x9b\xdf\x65\xbb\x6c\x9b\x17\xd8\x6e\x90\xe6\xcf\xf8\x91\xe5\x77\x73\xb0\x84\x86\x1d\xf8\xad\x77\x51\xbf\x3a\x48\x5c\x24\x57\x31\xd3\x4b\x81\x2e\x02\x62\x3f\xe2\xb7\xef\xb9\x94\x83\x94\x68\xc9\xd6\x03\xd5\x8c\x5a\x5f\xd9\xd9\x58\x8f\x9e\x5d\x27\x3e\xc6\xe9\x41\xb6\x32\x09\x5a\xe9\x24\x4c\x2d\xf2\x7f\x9b\x8a\x34\x5f\xf9\xb6\x0c\xf5\x72\xae\xd3\x0a\xaf\xff\x9a\x17\xb1\xd5\x0a\x05\xbb\x57\x29\x39\x2d\x4b\x1d\x6c\x30\x1d\x07\xaa\x28\xd0\xca\x00\x96\x3a\x5e\xe1\x48\x2f\xfe\x46\x7b\x76\x14\xd4\x19\xa0\xa5\x3d\xb7\x3e\xde\x23\xe6\xb8\xc2\x2c\x4e\xcc\xcc\xef\x00\x00\x00\xff\xff\xf3\x6f\xdb\x67\x09\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xda\x40\x10\xbd\xef\xaf\x78\xc2\x97\x44\x22\xd0\xf6\x48\x4f\x6e\x02\xaa\xd5\x08\xa4\x98\x34\xca\x71\xb0\x07\x7b\x84\xbd\xe3\xee\xae\xe3\xf0\xef\xab\x35\xd0\x24\xea\x35\x73\x43\x3b\xbc\x8f\x79\xcf\x09\x6e\x3e\x6f\x4c\x82\x7b\x29\xd8\x7a\x2e\x11\x14\xa1\x66\xa4\x1d\x15\x35\x23\xd7\x7d\x18\xc8\x31\x56\xda\xdb\x92\x82\xa8\xc5\x55\x9a\xaf\xae\xd1\xdb\x92\x1d\xd4\x32\xd4\xa1\x55\xc7\x26\x41\xa1\x36\x38\xd9\xf5\x41\x1d\x9a\x13\x20\xa8\x72\xcc\x2d\xdb\xe0\x67\x40\xce\x3c\xa2\xaf\x37\xdb\xec\x76\x89\xbd\x34\x8c\x52\xfc\xe9\x4f\x5c\x62\x90\x50\x9b\x04\xa1\x16\x8f\x41\xdd\x01\x7b\x75\xa0\xb2\x94\x48\x4c\x0d\xc4\xee\xd5\xb5\x27\x19\x8e\x2b\x72\xa5\xd8\x0a\x85\x76\x47\x27\x55\x1d\xa0\x83\x65\xe7\x6b\xe9\x66\x26\xc1\x36\xda\xc8\x57\x17\x25\xfe\x04\x3b\x72\x06\xc5\xb3\xf6\x67\x0f\xef\xec\x9e\xaf\x30\xc5\x6f\x76\x3e\x92\x7c\x9b\x7d\x31\x09\xae\xe2\xca\xe4\xfc\x38\xb9\xfe\x8e\xa3\xf6\x68\xe9\x08\xab\x01\xbd\xe7\x77\xc8\xfc\x5a\x70\x17\x20\x16\x85\xb6\x5d\x23\x64\x0b\x7e\xb3\xf5\x8f\x61\x86\x51\x40\xc4\xd0\x5d\x20\xb1\xa0\xd1\x06\x74\xff\x7e\x0d\x14\x4c\x62\x12\x8c\x53\x87\xd0\x2d\xe6\xf3\x61\x18\x66\x34\xa6\x33\x53\x57\xcd\x2f\xee\xe6\xf7\xd9\xed\x72\x9d\x2f\x6f\x46\xc9\x26\xc1\xa3\x6d\xd8\x7b\x38\xfe\xd3\x8b\xe3\x12\xbb\x23\xa8\xeb\x1a\x29\x68\xd7\x30\x1a\x1a\x62\x70\x63\x3a\x63\xe8\x62\x31\x38\x09\x62\xab\x29\xfc\x39\x75\x93\x7c\x48\xe7\xed\x5c\x17\x79\xe2\x3f\x2c\xa8\x05\x59\x4c\xd2\x1c\x59\x3e\xc1\x8f\x34\xcf\xf2\xa9\x49\xf0\x94\x6d\x7f\x6e\x1e\xb7\x78\x4a\x1f\x1e\xd2\xf5\x36\x5b\xe6\xd8\x3c\xe0\x76\xb3\xbe\xcb\xb6\xd9\x66\x9d\x63\xb3\x42\xba\x7e\xc6\xaf\x6c\x7d\x37\x05\x4b\xa8\xd9\x81\x5f\x3b\x17\xf5\xab\x83\xc4\x43\x72\x19\x33\xbd\x14\xe8\x22\x20\xf6\x23\xfe\xf6\x1d\x17\xb2\x97\x02\x0d\xd9\xaa\xa7\x8a\x51\xe9\x0b\x3b\x1b\xeb\xd1\xb1\x6b\xc5\xc7\x38\x3d\xc8\x96\x26\x41\x23\xad\x84\xb1\x45\xfe\x7f\x53\x91\xe6\xf2\x61\x7c\xc2\x18\x43\x9d\x9c\xeb\xb4\xc0\xcb\x57\x73\x10\x5b\x2e\x90\xb3\x7b\x91\x82\xd3\xa2\xd0\xde\x06\xd3\x72\xa0\x92\x02\x2d\x0c\x60\xa9\xe5\x05\x8e\x74\xf0\x37\xda\xb1\xa3\xa0\xce\x00\x0d\xed\xb8\xf1\xf1\x1d\x31\xc7\x05\x26\x47\x3a\xf8\x89\xf9\x3b\x00\xf3\x6f\xdb\x67\x09\x04\x00\x00"),
 		},
 		"/rbac": &vfsgen۰DirInfo{
 			name:    "rbac",
@@ -180,133 +180,133 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 1248,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x8f\xdb\x36\x10\xbd\xf3\x57\x3c\x58\x97\x04\x58\xcb\x6d\x4f\x85\x7b\x52\x36\xeb\x56\x68\x60\x03\x96\xd3\x20\xc7\x31\x35\x96\xa6\x96\x38\x2a\x49\xad\xe2\xfe\xfa\x82\xb2\xdd\xdd\xa0\x45\x7b\x09\x6f\x02\x47\xef\x63\xde\x63\x86\xe5\xb7\x3b\x26\xc3\x07\xb1\xec\x02\xd7\x88\x8a\xd8\x32\x8a\x81\x6c\xcb\xa8\xf4\x14\x27\xf2\x8c\x8d\x8e\xae\xa6\x28\xea\xf0\xa6\xa8\x36\x6f\x31\xba\x9a\x3d\xd4\x31\xd4\xa3\x57\xcf\x26\x83\x55\x17\xbd\x1c\xc7\xa8\x1e\xdd\x15\x10\xd4\x78\xe6\x9e\x5d\x0c\x39\x50\x31\xcf\xe8\xdb\xdd\xa1\x7c\x7c\xc2\x49\x3a\x46\x2d\xe1\xfa\x13\xd7\x98\x24\xb6\x26\x43\x6c\x25\x60\x52\x7f\xc6\x49\x3d\xa8\xae\x25\x11\x53\x07\x71\x27\xf5\xfd\x55\x86\xe7\x86\x7c\x2d\xae\x81\xd5\xe1\xe2\xa5\x69\x23\x74\x72\xec\x43\x2b\x43\x6e\x32\x1c\x92\x8d\x6a\x73\x57\x12\xae\xb0\x33\x67\x54\x7c\xd6\xf1\xe6\xe1\x95\xdd\xdb\x16\x1e\xf0\x1b\xfb\x90\x48\x7e\xc8\xbf\x33\x19\xde\xa4\x91\xc5\xed\x72\xf1\xf6\x27\x5c\x74\x44\x4f\x17\x38\x8d\x18\x03\xbf\x42\xe6\x2f\x96\x87\x08\x71\xb0\xda\x0f\x9d\x90\xb3\xfc\x62\xeb\x6f\x86\x1c\xb3\x80\x84\xa1\xc7\x48\xe2\x40\xb3\x0d\xe8\xe9\xf5\x18\x28\x9a\xcc\x64\x98\x4f\x1b\xe3\xb0\x5e\xad\xa6\x69\xca\x69\x96\x9b\xab\x6f\x56\x77\x77\xab\x0f\xe5\xe3\xd3\xb6\x7a\x5a\xce\x92\x4d\x86\x8f\xae\xe3\x10\xe0\xf9\x8f\x51\x3c\xd7\x38\x5e\x40\xc3\xd0\x89\xa5\x63\xc7\xe8\x68\x4a\xc1\xcd\xe9\xcc\xa1\x8b\xc3\xe4\x25\x8a\x6b\x1e\x10\x6e\xa9\x9b\xec\xab\x74\x5e\xd6\x75\x97\x27\xe1\xab\x01\x75\x20\x87\x45\x51\xa1\xac\x16\x78\x57\x54\x65\xf5\x60\x32\x7c\x2a\x0f\xbf\xec\x3e\x1e\xf0\xa9\xd8\xef\x8b\xed\xa1\x7c\xaa\xb0\xdb\xe3\x71\xb7\x7d\x5f\x1e\xca\xdd\xb6\xc2\x6e\x83\x62\xfb\x19\xbf\x96\xdb\xf7\x0f\x60\x89\x2d\x7b\xf0\x97\xc1\x27\xfd\xea\x21\x69\x91\x5c\xa7\x4c\xef\x05\xba\x0b\x48\xfd\x48\xdf\x61\x60\x2b\x27\xb1\xe8\xc8\x35\x23\x35\x8c\x46\x9f\xd9\xbb\x54\x8f\x81\x7d\x2f\x21\xc5\x19\x40\xae\x36\x19\x3a\xe9\x25\xce\x2d\x0a\xff\x34\x95\x68\xbe\xe5\xdb\x32\x67\x71\xf5\x1a\x7b\xed\xf8\x9d\xb8\x54\x58\x43\x83\xdc\x0a\xb6\x86\x3f\x92\xcd\x69\x8c\xad\x7a\xf9\x73\xd6\x94\x9f\x7f\x0c\xb9\xe8\xea\xf9\x7b\xd3\x73\xa4\x9a\x22\xad\x0d\xe0\xa8\xe7\x35\x2e\x74\x0e\x4b\x1d\xd8\x53\x54\xbf\xb4\xd4\x73\xb7\x3c\x1b\xa0\xa3\x23\x77\x21\xcd\x21\xc5\xbc\xc6\x22\x4d\x2e\x0c\x40\xce\xe9\xcd\xec\xf5\xda\x92\x5b\x0e\x5e\x7b\x8d\xbc\xc6\x22\xfa\x91\x17\x26\x8c\xc7\xdf\xd9\xc6\xb0\x36\x4b\x5c\xf5\x56\xec\x9f\xc5\x72\x61\xad\x8e\x2e\xfe\x3b\xbf\xf1\xda\xf1\x9e\x4f\x09\xf7\xc5\xe5\xff\x6a\xa5\x41\x7e\xf6\x3a\x0e\xff\x61\xde\xfc\x15\x00\x00\xff\xff\x93\x32\xa9\x4a\xe0\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x8f\xdb\x36\x10\xbd\xf3\x57\x3c\x58\x97\x04\x58\xcb\x6d\x4f\x85\x7b\x52\x36\xeb\x56\x68\x60\x03\x96\xd3\x20\xc7\x31\x35\x96\xa6\x96\x38\x2a\x49\xad\xe2\xfe\xfa\x82\xb2\xdd\xdd\xa0\x45\x7b\xc9\xdc\x0c\x8e\xdf\xc7\xbc\xa7\x0c\xcb\x6f\x37\x26\xc3\x07\xb1\xec\x02\xd7\x88\x8a\xd8\x32\x8a\x81\x6c\xcb\xa8\xf4\x14\x27\xf2\x8c\x8d\x8e\xae\xa6\x28\xea\xf0\xa6\xa8\x36\x6f\x31\xba\x9a\x3d\xd4\x31\xd4\xa3\x57\xcf\x26\x83\x55\x17\xbd\x1c\xc7\xa8\x1e\xdd\x15\x10\xd4\x78\xe6\x9e\x5d\x0c\x39\x50\x31\xcf\xe8\xdb\xdd\xa1\x7c\x7c\xc2\x49\x3a\x46\x2d\xe1\xfa\x27\xae\x31\x49\x6c\x4d\x86\xd8\x4a\xc0\xa4\xfe\x8c\x93\x7a\x50\x5d\x4b\x22\xa6\x0e\xe2\x4e\xea\xfb\xab\x0c\xcf\x0d\xf9\x5a\x5c\x03\xab\xc3\xc5\x4b\xd3\x46\xe8\xe4\xd8\x87\x56\x86\xdc\x64\x38\x24\x1b\xd5\xe6\xae\x24\x5c\x61\x67\xce\xa8\xf8\xac\xe3\xcd\xc3\x2b\xbb\xb7\x2b\x3c\xe0\x37\xf6\x21\x91\xfc\x90\x7f\x67\x32\xbc\x49\x2b\x8b\xdb\xe3\xe2\xed\x4f\xb8\xe8\x88\x9e\x2e\x70\x1a\x31\x06\x7e\x85\xcc\x5f\x2c\x0f\x11\xe2\x60\xb5\x1f\x3a\x21\x67\xf9\xc5\xd6\xdf\x0c\x39\x66\x01\x09\x43\x8f\x91\xc4\x81\x66\x1b\xd0\xd3\xeb\x35\x50\x34\x99\xc9\x30\x4f\x1b\xe3\xb0\x5e\xad\xa6\x69\xca\x69\x4e\x27\x57\xdf\xac\xee\xee\x56\x1f\xca\xc7\xa7\x6d\xf5\xb4\x9c\x25\x9b\x0c\x1f\x5d\xc7\x21\xc0\xf3\x1f\xa3\x78\xae\x71\xbc\x80\x86\xa1\x13\x4b\xc7\x8e\xd1\xd1\x94\x82\x9b\xd3\x99\x43\x17\x87\xc9\x4b\x14\xd7\x3c\x20\xdc\x52\x37\xd9\x57\xe9\xbc\x9c\xeb\x2e\x4f\xc2\x57\x0b\xea\x40\x0e\x8b\xa2\x42\x59\x2d\xf0\xae\xa8\xca\xea\xc1\x64\xf8\x54\x1e\x7e\xd9\x7d\x3c\xe0\x53\xb1\xdf\x17\xdb\x43\xf9\x54\x61\xb7\xc7\xe3\x6e\xfb\xbe\x3c\x94\xbb\x6d\x85\xdd\x06\xc5\xf6\x33\x7e\x2d\xb7\xef\x1f\xc0\x12\x5b\xf6\xe0\x2f\x83\x4f\xfa\xd5\x43\xd2\x21\xb9\x4e\x99\xde\x0b\x74\x17\x90\xfa\x91\x7e\x87\x81\xad\x9c\xc4\xa2\x23\xd7\x8c\xd4\x30\x1a\x7d\x66\xef\x52\x3d\x06\xf6\xbd\x84\x14\x67\x00\xb9\xda\x64\xe8\xa4\x97\x38\xb7\x28\xfc\xd3\x54\xa2\xb9\x7f\x18\xdf\x60\x8c\x39\x8b\xab\xd7\xd8\x6b\xc7\xef\xc4\xa5\xc2\x1a\x1a\xe4\x56\xb0\x35\xfc\x91\x6c\x4e\x63\x6c\xd5\xcb\x9f\xb3\xa6\xfc\xfc\x63\xc8\x45\x57\xcf\xdf\x9b\x9e\x23\xd5\x14\x69\x6d\x00\x47\x3d\xaf\x71\xa1\x73\x58\xea\xc0\x9e\xa2\xfa\xa5\xa5\x9e\xbb\xe5\xd9\x00\x1d\x1d\xb9\x0b\x69\x0f\x29\xe6\x35\x16\x69\x73\x61\x00\x72\x4e\x6f\x66\xaf\xcf\x96\xdc\x72\xf0\xda\x6b\xe4\x35\x16\xd1\x8f\xbc\x30\x61\x3c\xfe\xce\x36\x86\xb5\x59\xe2\xaa\xb7\x62\xff\x2c\x96\x0b\x6b\x75\x74\xf1\xdf\xf9\x8d\xd7\x8e\xf7\x7c\x4a\xb8\x2f\x2e\xff\x57\x2b\x0d\xf2\xb3\xd7\x71\xf8\x0f\xf3\xe6\xaf\x01\x00\x93\x32\xa9\x4a\xe0\x04\x00\x00"),
 		},
 		"/rbac/operator-role-binding-knative.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-binding-knative.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1248,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xdb\x46\x10\xbd\xef\xaf\x78\x10\x2f\x09\x60\xd1\x6d\x4f\x85\x7a\x52\x1c\xbb\x25\x1a\x48\x80\xa8\x34\xc8\x71\x44\x8e\xc8\xa9\xc8\x1d\x76\x76\x69\x46\xfd\xf5\xc5\x52\x52\xed\xa0\x45\x7b\xc9\xde\x88\x1d\xbe\x8f\x79\x6f\x33\x2c\xbf\xdd\x71\x19\x3e\x48\xc5\x3e\x70\x8d\xa8\x88\x2d\x63\x3d\x50\xd5\x32\x4a\x3d\xc6\x89\x8c\xf1\xa4\xa3\xaf\x29\x8a\x7a\xbc\x59\x97\x4f\x6f\x31\xfa\x9a\x0d\xea\x19\x6a\xe8\xd5\xd8\x65\xa8\xd4\x47\x93\xc3\x18\xd5\xd0\x5d\x00\x41\x8d\x31\xf7\xec\x63\xc8\x81\x92\x79\x46\xdf\x6c\xf7\xc5\xc3\x23\x8e\xd2\x31\x6a\x09\x97\x9f\xb8\xc6\x24\xb1\x75\x19\x62\x2b\x01\x93\xda\x09\x47\x35\x50\x5d\x4b\x22\xa6\x0e\xe2\x8f\x6a\xfd\x45\x86\x71\x43\x56\x8b\x6f\x50\xe9\x70\x36\x69\xda\x08\x9d\x3c\x5b\x68\x65\xc8\x5d\x86\x7d\xb2\x51\x3e\xdd\x94\x84\x0b\xec\xcc\x19\x15\x9f\x75\xbc\x7a\x78\x65\xf7\xba\x85\x3b\xfc\xc6\x16\x12\xc9\x0f\xf9\x77\x2e\xc3\x9b\x34\xb2\xb8\x5e\x2e\xde\xfe\x84\xb3\x8e\xe8\xe9\x0c\xaf\x11\x63\xe0\x57\xc8\xfc\xa5\xe2\x21\x42\x3c\x2a\xed\x87\x4e\xc8\x57\xfc\x62\xeb\x6f\x86\x1c\xb3\x80\x84\xa1\x87\x48\xe2\x41\xb3\x0d\xe8\xf1\xf5\x18\x28\xba\xcc\x65\x98\x4f\x1b\xe3\xb0\xba\xbf\x9f\xa6\x29\xa7\x59\x6e\xae\xd6\xdc\xdf\xdc\xdd\x7f\x28\x1e\x1e\x37\xe5\xe3\x72\x96\xec\x32\x7c\xf4\x1d\x87\x00\xe3\x3f\x46\x31\xae\x71\x38\x83\x86\xa1\x93\x8a\x0e\x1d\xa3\xa3\x29\x05\x37\xa7\x33\x87\x2e\x1e\x93\x49\x14\xdf\xdc\x21\x5c\x53\x77\xd9\x57\xe9\xbc\xac\xeb\x26\x4f\xc2\x57\x03\xea\x41\x1e\x8b\x75\x89\xa2\x5c\xe0\xdd\xba\x2c\xca\x3b\x97\xe1\x53\xb1\xff\x65\xfb\x71\x8f\x4f\xeb\xdd\x6e\xbd\xd9\x17\x8f\x25\xb6\x3b\x3c\x6c\x37\xef\x8b\x7d\xb1\xdd\x94\xd8\x3e\x61\xbd\xf9\x8c\x5f\x8b\xcd\xfb\x3b\xb0\xc4\x96\x0d\xfc\x65\xb0\xa4\x5f\x0d\x92\x16\xc9\x75\xca\xf4\x56\xa0\x9b\x80\xd4\x8f\xf4\x1d\x06\xae\xe4\x28\x15\x3a\xf2\xcd\x48\x0d\xa3\xd1\x67\x36\x9f\xea\x31\xb0\xf5\x12\x52\x9c\x01\xe4\x6b\x97\xa1\x93\x5e\xe2\xdc\xa2\xf0\x4f\x53\x89\xe6\x5b\xbe\x2d\x77\x12\x5f\xaf\xb0\xd3\x8e\xdf\x89\x4f\x85\x75\x34\xc8\xb5\x60\x2b\xd8\x81\xaa\x9c\xc6\xd8\xaa\xc9\x9f\xb3\xa6\xfc\xf4\x63\xc8\x45\xef\x9f\xbf\x77\x3d\x47\xaa\x29\xd2\xca\x01\x9e\x7a\x5e\xe1\x4c\xa7\xb0\xd4\x81\x8d\xa2\xda\xf2\xe4\x29\xca\x33\x3b\xa0\xa3\x03\x77\x21\xcd\x21\xc5\xbc\xc2\x22\x4d\x2e\x1c\x40\xde\xeb\xd5\xec\xe5\xba\x22\xbf\x1c\x4c\x7b\x8d\xbc\xc2\x22\xda\xc8\x0b\x17\xc6\xc3\xef\x5c\xc5\xb0\x72\x4b\x5c\xf4\x96\x6c\xcf\x52\xf1\xba\xaa\x74\xf4\xf1\xdf\xf9\x9d\x69\xc7\x3b\x3e\x26\xdc\x17\x97\xff\xab\x95\x06\xf9\xd9\x74\x1c\xfe\xc3\xbc\xfb\x2b\x00\x00\xff\xff\x0a\x6b\x4b\x4a\xe0\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xdb\x46\x10\xbd\xef\xaf\x78\x10\x2f\x09\x60\xd1\x6d\x4f\x85\x7a\x52\x1c\xbb\x25\x1a\x48\x80\xa8\x34\xc8\x71\x44\x8e\xc8\xa9\xc8\x1d\x76\x76\x69\x46\xfd\xf5\xc5\x52\x52\xed\xa0\x45\x7b\xc9\xdc\x84\x1d\xbd\x8f\x79\x8f\x19\x96\xdf\x6e\x5c\x86\x0f\x52\xb1\x0f\x5c\x23\x2a\x62\xcb\x58\x0f\x54\xb5\x8c\x52\x8f\x71\x22\x63\x3c\xe9\xe8\x6b\x8a\xa2\x1e\x6f\xd6\xe5\xd3\x5b\x8c\xbe\x66\x83\x7a\x86\x1a\x7a\x35\x76\x19\x2a\xf5\xd1\xe4\x30\x46\x35\x74\x17\x40\x50\x63\xcc\x3d\xfb\x18\x72\xa0\x64\x9e\xd1\x37\xdb\x7d\xf1\xf0\x88\xa3\x74\x8c\x5a\xc2\xe5\x4f\x5c\x63\x92\xd8\xba\x0c\xb1\x95\x80\x49\xed\x84\xa3\x1a\xa8\xae\x25\x11\x53\x07\xf1\x47\xb5\xfe\x22\xc3\xb8\x21\xab\xc5\x37\xa8\x74\x38\x9b\x34\x6d\x84\x4e\x9e\x2d\xb4\x32\xe4\x2e\xc3\x3e\xd9\x28\x9f\x6e\x4a\xc2\x05\x76\xe6\x8c\x8a\xcf\x3a\x5e\x3d\xbc\xb2\x7b\xbd\xc2\x1d\x7e\x63\x0b\x89\xe4\x87\xfc\x3b\x97\xe1\x4d\x5a\x59\x5c\x1f\x17\x6f\x7f\xc2\x59\x47\xf4\x74\x86\xd7\x88\x31\xf0\x2b\x64\xfe\x52\xf1\x10\x21\x1e\x95\xf6\x43\x27\xe4\x2b\x7e\xb1\xf5\x37\x43\x8e\x59\x40\xc2\xd0\x43\x24\xf1\xa0\xd9\x06\xf4\xf8\x7a\x0d\x14\x5d\xe6\x32\xcc\xd3\xc6\x38\xac\xee\xef\xa7\x69\xca\x69\x4e\x27\x57\x6b\xee\x6f\xee\xee\x3f\x14\x0f\x8f\x9b\xf2\x71\x39\x4b\x76\x19\x3e\xfa\x8e\x43\x80\xf1\x1f\xa3\x18\xd7\x38\x9c\x41\xc3\xd0\x49\x45\x87\x8e\xd1\xd1\x94\x82\x9b\xd3\x99\x43\x17\x8f\xc9\x24\x8a\x6f\xee\x10\xae\xa9\xbb\xec\xab\x74\x5e\xce\x75\x93\x27\xe1\xab\x05\xf5\x20\x8f\xc5\xba\x44\x51\x2e\xf0\x6e\x5d\x16\xe5\x9d\xcb\xf0\xa9\xd8\xff\xb2\xfd\xb8\xc7\xa7\xf5\x6e\xb7\xde\xec\x8b\xc7\x12\xdb\x1d\x1e\xb6\x9b\xf7\xc5\xbe\xd8\x6e\x4a\x6c\x9f\xb0\xde\x7c\xc6\xaf\xc5\xe6\xfd\x1d\x58\x62\xcb\x06\xfe\x32\x58\xd2\xaf\x06\x49\x87\xe4\x3a\x65\x7a\x2b\xd0\x4d\x40\xea\x47\xfa\x1d\x06\xae\xe4\x28\x15\x3a\xf2\xcd\x48\x0d\xa3\xd1\x67\x36\x9f\xea\x31\xb0\xf5\x12\x52\x9c\x01\xe4\x6b\x97\xa1\x93\x5e\xe2\xdc\xa2\xf0\x4f\x53\x89\xe6\xf6\x61\x7c\x83\x71\xee\x24\xbe\x5e\x61\xa7\x1d\xbf\x13\x9f\x0a\xeb\x68\x90\x6b\xc1\x56\xb0\x03\x55\x39\x8d\xb1\x55\x93\x3f\x67\x4d\xf9\xe9\xc7\x90\x8b\xde\x3f\x7f\xef\x7a\x8e\x54\x53\xa4\x95\x03\x3c\xf5\xbc\xc2\x99\x4e\x61\xa9\x03\x1b\x45\xb5\xe5\xc9\x53\x94\x67\x76\x40\x47\x07\xee\x42\xda\x43\x8a\x79\x85\x45\xda\x5c\x38\x80\xbc\xd7\xab\xd9\xcb\x73\x45\x7e\x39\x98\xf6\x1a\x79\x85\x45\xb4\x91\x17\x2e\x8c\x87\xdf\xb9\x8a\x61\xe5\x96\xb8\xe8\x2d\xd9\x9e\xa5\xe2\x75\x55\xe9\xe8\xe3\xbf\xf3\x3b\xd3\x8e\x77\x7c\x4c\xb8\x2f\x2e\xff\x57\x2b\x0d\xf2\xb3\xe9\x38\xfc\x87\x79\xf7\xd7\x00\x0a\x6b\x4b\x4a\xe0\x04\x00\x00"),
 		},
 		"/rbac/operator-role-binding-kubernetes.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-binding-kubernetes.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1232,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xdb\x46\x10\xbd\xef\xaf\x78\x10\x2f\x09\x60\x51\x6d\x4f\x85\x7a\x52\x1c\xbb\x25\x1a\x48\x80\xa8\x34\xc8\x71\x44\x8e\xc8\xa9\xc9\x1d\x76\x76\x69\x46\xfd\xf5\xc5\x52\x52\xed\xa0\x48\x4f\xd9\x9b\x30\xa3\xf7\x31\xef\x31\xc3\xf2\xfb\x3d\x97\xe1\x83\x54\xec\x03\xd7\x88\x8a\xd8\x32\x36\x03\x55\x2d\xa3\xd4\x53\x9c\xc8\x18\x8f\x3a\xfa\x9a\xa2\xa8\xc7\x9b\x4d\xf9\xf8\x16\xa3\xaf\xd9\xa0\x9e\xa1\x86\x5e\x8d\x5d\x86\x4a\x7d\x34\x39\x8e\x51\x0d\xdd\x05\x10\xd4\x18\x73\xcf\x3e\x86\x1c\x28\x99\x67\xf4\xed\xee\x50\xdc\x3f\xe0\x24\x1d\xa3\x96\x70\xf9\x13\xd7\x98\x24\xb6\x2e\x43\x6c\x25\x60\x52\x7b\xc2\x49\x0d\x54\xd7\x92\x88\xa9\x83\xf8\x93\x5a\x7f\x91\x61\xdc\x90\xd5\xe2\x1b\x54\x3a\x9c\x4d\x9a\x36\x42\x27\xcf\x16\x5a\x19\x72\x97\xe1\x90\x6c\x94\x8f\x37\x25\xe1\x02\x3b\x73\x46\xc5\x67\x1d\xaf\x1e\x5e\xd9\xbd\x5e\xe1\x0e\x7f\xb0\x85\x44\xf2\x53\xfe\x83\xcb\xf0\x26\xad\x2c\xae\xc3\xc5\xdb\x5f\x70\xd6\x11\x3d\x9d\xe1\x35\x62\x0c\xfc\x0a\x99\xbf\x54\x3c\x44\x88\x47\xa5\xfd\xd0\x09\xf9\x8a\x5f\x6c\xfd\xcb\x90\x63\x16\x90\x30\xf4\x18\x49\x3c\x68\xb6\x01\x3d\xbd\x5e\x03\x45\x97\xb9\x0c\xf3\x6b\x63\x1c\xd6\xab\xd5\x34\x4d\x39\xcd\x72\x73\xb5\x66\x75\x73\xb7\xfa\x50\xdc\x3f\x6c\xcb\x87\xe5\x2c\xd9\x65\xf8\xe8\x3b\x0e\x01\xc6\x7f\x8d\x62\x5c\xe3\x78\x06\x0d\x43\x27\x15\x1d\x3b\x46\x47\x53\x0a\x6e\x4e\x67\x0e\x5d\x3c\x26\x93\x28\xbe\xb9\x43\xb8\xa6\xee\xb2\xaf\xd2\x79\x39\xd7\x4d\x9e\x84\xaf\x16\xd4\x83\x3c\x16\x9b\x12\x45\xb9\xc0\xbb\x4d\x59\x94\x77\x2e\xc3\xa7\xe2\xf0\xdb\xee\xe3\x01\x9f\x36\xfb\xfd\x66\x7b\x28\x1e\x4a\xec\xf6\xb8\xdf\x6d\xdf\x17\x87\x62\xb7\x2d\xb1\x7b\xc4\x66\xfb\x19\xbf\x17\xdb\xf7\x77\x60\x89\x2d\x1b\xf8\xcb\x60\x49\xbf\x1a\x24\x1d\x92\xeb\x94\xe9\xad\x40\x37\x01\xa9\x1f\xe9\x77\x18\xb8\x92\x93\x54\xe8\xc8\x37\x23\x35\x8c\x46\x9f\xd9\x7c\xaa\xc7\xc0\xd6\x4b\x48\x71\x06\x90\xaf\x5d\x86\x4e\x7a\x89\x73\x8b\xc2\x7f\x4d\x25\x9a\xef\xf9\x6d\xb9\x27\xf1\xf5\x1a\x7b\xed\xf8\x9d\xf8\x54\x58\x47\x83\x5c\x0b\xb6\x86\x1d\xa9\xca\x69\x8c\xad\x9a\xfc\x3d\x6b\xca\x9f\x7e\x0e\xb9\xe8\xea\xf9\x47\xd7\x73\xa4\x9a\x22\xad\x1d\xe0\xa9\xe7\x35\xce\xf4\x14\x96\x3a\xb0\x51\x54\x73\x40\x47\x47\xee\x42\x9a\x23\xc5\xbb\xc6\x22\x6d\x2c\x1c\x40\xde\xeb\xd5\xe4\x65\x5c\x91\x5f\x0e\xa6\xbd\x46\x5e\x63\x11\x6d\xe4\x85\x0b\xe3\xf1\x4f\xae\x62\x58\xbb\x25\x2e\x3a\x4b\xb6\x67\xa9\x78\x53\x55\x3a\xfa\xf8\x0d\x5e\xd3\x8e\xf7\x7c\x4a\xb8\x2f\xee\xbe\xa9\x91\x06\xf9\xd5\x74\x1c\xfe\xc7\xac\xfb\x27\x00\x00\xff\xff\xea\xf7\xbf\x1a\xd0\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xdb\x46\x10\xbd\xef\xaf\x78\x10\x2f\x09\x60\x51\x6d\x4f\x85\x7a\x52\x1c\xbb\x25\x1a\x48\x80\xa8\x34\xc8\x71\x44\x8e\xc8\xa9\xc9\x1d\x76\x76\x69\x46\xfd\xf5\xc5\x52\x52\xed\xa0\x48\x4f\xd9\x9b\x30\xa3\xf7\x31\xef\x31\xc3\xf2\xfb\x3d\x97\xe1\x83\x54\xec\x03\xd7\x88\x8a\xd8\x32\x36\x03\x55\x2d\xa3\xd4\x53\x9c\xc8\x18\x8f\x3a\xfa\x9a\xa2\xa8\xc7\x9b\x4d\xf9\xf8\x16\xa3\xaf\xd9\xa0\x9e\xa1\x86\x5e\x8d\x5d\x86\x4a\x7d\x34\x39\x8e\x51\x0d\xdd\x05\x10\xd4\x18\x73\xcf\x3e\x86\x1c\x28\x99\x67\xf4\xed\xee\x50\xdc\x3f\xe0\x24\x1d\xa3\x96\x70\xf9\x13\xd7\x98\x24\xb6\x2e\x43\x6c\x25\x60\x52\x7b\xc2\x49\x0d\x54\xd7\x92\x88\xa9\x83\xf8\x93\x5a\x7f\x91\x61\xdc\x90\xd5\xe2\x1b\x54\x3a\x9c\x4d\x9a\x36\x42\x27\xcf\x16\x5a\x19\x72\x97\xe1\x90\x6c\x94\x8f\x37\x25\xe1\x02\x3b\x73\x46\xc5\x67\x1d\xaf\x1e\x5e\xd9\xbd\x5e\xe1\x0e\x7f\xb0\x85\x44\xf2\x53\xfe\x83\xcb\xf0\x26\xad\x2c\xae\xc3\xc5\xdb\x5f\x70\xd6\x11\x3d\x9d\xe1\x35\x62\x0c\xfc\x0a\x99\xbf\x54\x3c\x44\x88\x47\xa5\xfd\xd0\x09\xf9\x8a\x5f\x6c\xfd\xcb\x90\x63\x16\x90\x30\xf4\x18\x49\x3c\x68\xb6\x01\x3d\xbd\x5e\x03\x45\x97\xb9\x0c\xf3\x6b\x63\x1c\xd6\xab\xd5\x34\x4d\x39\xcd\xe9\xe4\x6a\xcd\xea\xe6\x6e\xf5\xa1\xb8\x7f\xd8\x96\x0f\xcb\x59\xb2\xcb\xf0\xd1\x77\x1c\x02\x8c\xff\x1a\xc5\xb8\xc6\xf1\x0c\x1a\x86\x4e\x2a\x3a\x76\x8c\x8e\xa6\x14\xdc\x9c\xce\x1c\xba\x78\x4c\x26\x51\x7c\x73\x87\x70\x4d\xdd\x65\x5f\xa5\xf3\x72\xae\x9b\x3c\x09\x5f\x2d\xa8\x07\x79\x2c\x36\x25\x8a\x72\x81\x77\x9b\xb2\x28\xef\x5c\x86\x4f\xc5\xe1\xb7\xdd\xc7\x03\x3e\x6d\xf6\xfb\xcd\xf6\x50\x3c\x94\xd8\xed\x71\xbf\xdb\xbe\x2f\x0e\xc5\x6e\x5b\x62\xf7\x88\xcd\xf6\x33\x7e\x2f\xb6\xef\xef\xc0\x12\x5b\x36\xf0\x97\xc1\x92\x7e\x35\x48\x3a\x24\xd7\x29\xd3\x5b\x81\x6e\x02\x52\x3f\xd2\xef\x30\x70\x25\x27\xa9\xd0\x91\x6f\x46\x6a\x18\x8d\x3e\xb3\xf9\x54\x8f\x81\xad\x97\x90\xe2\x0c\x20\x5f\xbb\x0c\x9d\xf4\x12\xe7\x16\x85\xff\x9a\x4a\x34\xb7\x0f\xe3\x3b\x3c\xe7\x9e\xc4\xd7\x6b\xec\xb5\xe3\x77\xe2\x53\x61\x1d\x0d\x72\x2d\xd8\x1a\x76\xa4\x2a\xa7\x31\xb6\x6a\xf2\xf7\xac\x29\x7f\xfa\x39\xe4\xa2\xab\xe7\x1f\x5d\xcf\x91\x6a\x8a\xb4\x76\x80\xa7\x9e\xd7\x38\xd3\x53\x58\xea\xc0\x46\x51\xcd\x01\x1d\x1d\xb9\x0b\x69\x8e\x14\xef\x1a\x8b\xb4\xb1\x70\x00\x79\xaf\x57\x93\x97\x71\x45\x7e\x39\x98\xf6\x1a\x79\x8d\x45\xb4\x91\x17\x2e\x8c\xc7\x3f\xb9\x8a\x61\xed\x96\xb8\xe8\x2c\xd9\x9e\xa5\xe2\x4d\x55\xe9\xe8\xe3\x37\x78\x4d\x3b\xde\xf3\x29\xe1\xbe\xb8\xfb\xa6\x46\x1a\xe4\x57\xd3\x71\xf8\x1f\xb3\xee\x9f\x01\x00\xea\xf7\xbf\x1a\xd0\x04\x00\x00"),
 		},
 		"/rbac/operator-role-binding-olm-cluster.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-binding-olm-cluster.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 414,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\xbf\x4e\x33\x41\x0c\xc4\x7b\x3f\x85\xa5\xab\x93\x4f\x5f\x87\xae\x03\x0a\xfa\x20\x51\xc7\xd9\x75\x38\x73\x7b\xeb\xc5\xf6\x46\x82\xa7\x47\xa7\x0b\x05\xe2\x4f\x69\x6b\xe6\x37\xf6\x0c\x30\xe0\x9d\xd4\x2c\xf5\x19\xc5\xd1\xf8\xb5\x8b\x71\xc6\xb3\x1a\x1e\x17\x9a\x19\x4f\xbd\xe6\xc2\x47\x0c\xc5\xcc\xc1\x29\x30\x26\x0a\x18\x30\x26\x46\x67\xbb\x48\x62\xa4\x94\xb4\xd7\xc0\x37\x9a\x7d\xa7\x8d\x8d\x42\xed\x93\xe6\xab\xd4\x19\x06\x24\x77\x4d\x42\xc1\x19\x53\xe9\x1e\x6c\xd8\xd8\x16\x71\x17\xad\x0e\x03\x50\x93\x27\xb6\x75\x1a\xd1\x4e\x94\xf6\xd4\x63\x52\x93\x77\x0a\xd1\xba\x9f\x6f\x7c\x2f\xfa\xef\xf2\x1f\x66\xa9\x79\xc4\xfb\x0d\x72\xd0\xc2\xd7\x27\x60\xe1\xa0\x4c\x41\x23\x20\x56\x5a\x78\xfc\x7a\xd3\x4e\xcb\x02\xa6\x85\x0f\x7c\x5e\x25\xd4\xe4\xc1\xb4\xb7\x3f\xe2\x00\xf1\x5b\xda\xcf\x70\xf0\x7e\x7a\xe1\x14\x3e\xc2\xee\xea\x79\xdc\x0a\xba\xdd\xfa\xf9\xc5\xb6\x6d\xbd\x51\xe2\x11\x5b\xa1\xc4\x93\x96\xcc\x06\x1f\x01\x00\x00\xff\xff\x1f\xd5\x61\x24\x9e\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\xbf\x4e\x33\x41\x0c\xc4\x7b\x3f\x85\xa5\xab\x93\x4f\x5f\x87\xae\x03\x0a\xfa\x20\x51\xc7\xd9\x75\x38\x73\x7b\xeb\xc5\xf6\x46\x82\xa7\x47\xa7\x0b\x05\xe2\x4f\x69\x6b\xe6\x37\xf6\x0c\x30\xe0\x9d\xd4\x2c\xf5\x19\xc5\xd1\xf8\xb5\x8b\x71\xc6\xb3\x1a\x1e\x17\x9a\x19\x4f\xbd\xe6\xc2\x47\x0c\xc5\xcc\xc1\x29\x30\x26\x0a\x18\x30\x26\x46\x67\xbb\x48\x62\xa4\x94\xb4\xd7\xc0\x37\x9a\x7d\xa7\x8d\x8d\x42\xed\x93\xe6\xab\xd4\x19\x06\x24\x77\x4d\x42\xc1\x19\x53\xe9\x1e\x6c\xd8\xd8\x16\x71\x17\xad\x0e\x03\x50\x93\x27\xb6\x75\x1a\xd1\x4e\x94\xf6\xd4\x63\x52\x93\x77\x0a\xd1\xba\x9f\x6f\x7c\x2f\xfa\xef\xf2\x1f\x66\xa9\x79\xc4\xfb\x0d\x72\xd0\xc2\xd7\x27\x60\xe1\xa0\x4c\x41\x23\x20\x56\x5a\x78\xfc\x7a\xd3\x4e\xcb\x02\xa6\x85\x0f\x7c\x5e\x25\xd4\xe4\xc1\xb4\xb7\x3f\xe2\x00\xf1\x5b\xda\xcf\x70\xf0\x7e\x7a\xe1\x14\x3e\xc2\xee\xea\x79\xdc\x0a\xba\xdd\xfa\xf9\xc5\xb6\x6d\xbd\x51\xe2\x11\x5b\xa1\xc4\x93\x96\xcc\x06\x1f\x03\x00\x1f\xd5\x61\x24\x9e\x01\x00\x00"),
 		},
 		"/rbac/operator-role-binding-olm.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-binding-olm.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 367,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x41\x4b\xc4\x40\x0c\x85\xef\xf9\x15\x81\x9e\x5b\xf1\x26\xbd\xe9\xc5\xfb\x0a\x9e\x37\x9d\xc9\xda\xd8\x76\x32\x26\x99\x05\xfd\xf5\x52\x76\x45\x04\xf5\x18\xf8\xf2\xde\xe3\xeb\xa0\xc3\x07\x29\x59\xca\x0b\x8a\xa3\xf1\x5b\x13\xe3\x8c\x27\x35\x3c\x6e\xb4\x30\x4e\xad\xe4\x95\x8f\x18\x8a\x99\x83\x53\x60\xcc\x14\xd0\x61\xcc\x8c\xce\x76\x96\xc4\x48\x29\x69\x2b\x81\xef\xb4\x78\xaf\x95\x8d\x42\xed\x2b\xcd\x77\xd4\x19\x3a\x24\x77\x4d\x42\xc1\x19\x2b\xdb\x26\xee\xa2\xc5\xa1\x03\xaa\xf2\xcc\xb6\x5f\x23\xda\x44\x69\xa0\x16\xb3\x9a\x7c\x50\x88\x96\x61\xb9\xf3\x41\xf4\xe6\x7c\x0b\x8b\x94\x3c\xe2\x41\x57\xbe\xae\x86\x8d\x83\x32\x05\x8d\x80\x58\x68\xe3\xf1\xe7\x88\x5e\xd7\x0d\x4c\x57\x3e\xf0\x69\x47\xa8\xca\xa3\x69\xab\xff\xf4\x00\xe2\x77\xcd\xef\xa9\xe0\x6d\x7a\xe5\x14\x3e\x42\x7f\x85\x9f\x2e\x2a\xee\x2f\x26\xfe\x78\xfb\x0c\x00\x00\xff\xff\xc1\x72\x20\x8a\x6f\x01\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x41\x4b\xc4\x40\x0c\x85\xef\xf9\x15\x81\x9e\x5b\xf1\x26\xbd\xe9\xc5\xfb\x0a\x9e\x37\x9d\xc9\xda\xd8\x76\x32\x26\x99\x05\xfd\xf5\x52\x76\x45\x04\xf5\x18\xf8\xf2\xde\xe3\xeb\xa0\xc3\x07\x29\x59\xca\x0b\x8a\xa3\xf1\x5b\x13\xe3\x8c\x27\x35\x3c\x6e\xb4\x30\x4e\xad\xe4\x95\x8f\x18\x8a\x99\x83\x53\x60\xcc\x14\xd0\x61\xcc\x8c\xce\x76\x96\xc4\x48\x29\x69\x2b\x81\xef\xb4\x78\xaf\x95\x8d\x42\xed\x2b\xcd\x77\xd4\x19\x3a\x24\x77\x4d\x42\xc1\x19\x2b\xdb\x26\xee\xa2\xc5\xa1\x03\xaa\xf2\xcc\xb6\x5f\x23\xda\x44\x69\xa0\x16\xb3\x9a\x7c\x50\x88\x96\x61\xb9\xf3\x41\xf4\xe6\x7c\x0b\x8b\x94\x3c\xe2\x41\x57\xbe\xae\x86\x8d\x83\x32\x05\x8d\x80\x58\x68\xe3\xf1\xe7\x88\x5e\xd7\x0d\x4c\x57\x3e\xf0\x69\x47\xa8\xca\xa3\x69\xab\xff\xf4\x00\xe2\x77\xcd\xef\xa9\xe0\x6d\x7a\xe5\x14\x3e\x42\x7f\x85\x9f\x2e\x2a\xee\x2f\x26\xfe\x78\xfb\x1c\x00\xc1\x72\x20\x8a\x6f\x01\x00\x00"),
 		},
 		"/rbac/operator-role-binding-openshift.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-binding-openshift.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1232,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xdb\x46\x10\xbd\xef\xaf\x78\x10\x2f\x09\x60\x51\x6d\x4f\x85\x7a\x52\x1c\xbb\x25\x1a\x48\x80\xa8\x34\xc8\x71\x44\x8e\xc8\xa9\xc9\x1d\x76\x76\x69\x46\xfd\xf5\xc5\x52\x52\xed\xa0\x48\x4f\xd9\x9b\x30\xa3\xf7\x31\xef\x31\xc3\xf2\xfb\x3d\x97\xe1\x83\x54\xec\x03\xd7\x88\x8a\xd8\x32\x36\x03\x55\x2d\xa3\xd4\x53\x9c\xc8\x18\x8f\x3a\xfa\x9a\xa2\xa8\xc7\x9b\x4d\xf9\xf8\x16\xa3\xaf\xd9\xa0\x9e\xa1\x86\x5e\x8d\x5d\x86\x4a\x7d\x34\x39\x8e\x51\x0d\xdd\x05\x10\xd4\x18\x73\xcf\x3e\x86\x1c\x28\x99\x67\xf4\xed\xee\x50\xdc\x3f\xe0\x24\x1d\xa3\x96\x70\xf9\x13\xd7\x98\x24\xb6\x2e\x43\x6c\x25\x60\x52\x7b\xc2\x49\x0d\x54\xd7\x92\x88\xa9\x83\xf8\x93\x5a\x7f\x91\x61\xdc\x90\xd5\xe2\x1b\x54\x3a\x9c\x4d\x9a\x36\x42\x27\xcf\x16\x5a\x19\x72\x97\xe1\x90\x6c\x94\x8f\x37\x25\xe1\x02\x3b\x73\x46\xc5\x67\x1d\xaf\x1e\x5e\xd9\xbd\x5e\xe1\x0e\x7f\xb0\x85\x44\xf2\x53\xfe\x83\xcb\xf0\x26\xad\x2c\xae\xc3\xc5\xdb\x5f\x70\xd6\x11\x3d\x9d\xe1\x35\x62\x0c\xfc\x0a\x99\xbf\x54\x3c\x44\x88\x47\xa5\xfd\xd0\x09\xf9\x8a\x5f\x6c\xfd\xcb\x90\x63\x16\x90\x30\xf4\x18\x49\x3c\x68\xb6\x01\x3d\xbd\x5e\x03\x45\x97\xb9\x0c\xf3\x6b\x63\x1c\xd6\xab\xd5\x34\x4d\x39\xcd\x72\x73\xb5\x66\x75\x73\xb7\xfa\x50\xdc\x3f\x6c\xcb\x87\xe5\x2c\xd9\x65\xf8\xe8\x3b\x0e\x01\xc6\x7f\x8d\x62\x5c\xe3\x78\x06\x0d\x43\x27\x15\x1d\x3b\x46\x47\x53\x0a\x6e\x4e\x67\x0e\x5d\x3c\x26\x93\x28\xbe\xb9\x43\xb8\xa6\xee\xb2\xaf\xd2\x79\x39\xd7\x4d\x9e\x84\xaf\x16\xd4\x83\x3c\x16\x9b\x12\x45\xb9\xc0\xbb\x4d\x59\x94\x77\x2e\xc3\xa7\xe2\xf0\xdb\xee\xe3\x01\x9f\x36\xfb\xfd\x66\x7b\x28\x1e\x4a\xec\xf6\xb8\xdf\x6d\xdf\x17\x87\x62\xb7\x2d\xb1\x7b\xc4\x66\xfb\x19\xbf\x17\xdb\xf7\x77\x60\x89\x2d\x1b\xf8\xcb\x60\x49\xbf\x1a\x24\x1d\x92\xeb\x94\xe9\xad\x40\x37\x01\xa9\x1f\xe9\x77\x18\xb8\x92\x93\x54\xe8\xc8\x37\x23\x35\x8c\x46\x9f\xd9\x7c\xaa\xc7\xc0\xd6\x4b\x48\x71\x06\x90\xaf\x5d\x86\x4e\x7a\x89\x73\x8b\xc2\x7f\x4d\x25\x9a\xef\xf9\x6d\xb9\x27\xf1\xf5\x1a\x7b\xed\xf8\x9d\xf8\x54\x58\x47\x83\x5c\x0b\xb6\x86\x1d\xa9\xca\x69\x8c\xad\x9a\xfc\x3d\x6b\xca\x9f\x7e\x0e\xb9\xe8\xea\xf9\x47\xd7\x73\xa4\x9a\x22\xad\x1d\xe0\xa9\xe7\x35\xce\xf4\x14\x96\x3a\xb0\x51\x54\x73\x40\x47\x47\xee\x42\x9a\x23\xc5\xbb\xc6\x22\x6d\x2c\x1c\x40\xde\xeb\xd5\xe4\x65\x5c\x91\x5f\x0e\xa6\xbd\x46\x5e\x63\x11\x6d\xe4\x85\x0b\xe3\xf1\x4f\xae\x62\x58\xbb\x25\x2e\x3a\x4b\xb6\x67\xa9\x78\x53\x55\x3a\xfa\xf8\x0d\x5e\xd3\x8e\xf7\x7c\x4a\xb8\x2f\xee\xbe\xa9\x91\x06\xf9\xd5\x74\x1c\xfe\xc7\xac\xfb\x27\x00\x00\xff\xff\xea\xf7\xbf\x1a\xd0\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xdb\x46\x10\xbd\xef\xaf\x78\x10\x2f\x09\x60\x51\x6d\x4f\x85\x7a\x52\x1c\xbb\x25\x1a\x48\x80\xa8\x34\xc8\x71\x44\x8e\xc8\xa9\xc9\x1d\x76\x76\x69\x46\xfd\xf5\xc5\x52\x52\xed\xa0\x48\x4f\xd9\x9b\x30\xa3\xf7\x31\xef\x31\xc3\xf2\xfb\x3d\x97\xe1\x83\x54\xec\x03\xd7\x88\x8a\xd8\x32\x36\x03\x55\x2d\xa3\xd4\x53\x9c\xc8\x18\x8f\x3a\xfa\x9a\xa2\xa8\xc7\x9b\x4d\xf9\xf8\x16\xa3\xaf\xd9\xa0\x9e\xa1\x86\x5e\x8d\x5d\x86\x4a\x7d\x34\x39\x8e\x51\x0d\xdd\x05\x10\xd4\x18\x73\xcf\x3e\x86\x1c\x28\x99\x67\xf4\xed\xee\x50\xdc\x3f\xe0\x24\x1d\xa3\x96\x70\xf9\x13\xd7\x98\x24\xb6\x2e\x43\x6c\x25\x60\x52\x7b\xc2\x49\x0d\x54\xd7\x92\x88\xa9\x83\xf8\x93\x5a\x7f\x91\x61\xdc\x90\xd5\xe2\x1b\x54\x3a\x9c\x4d\x9a\x36\x42\x27\xcf\x16\x5a\x19\x72\x97\xe1\x90\x6c\x94\x8f\x37\x25\xe1\x02\x3b\x73\x46\xc5\x67\x1d\xaf\x1e\x5e\xd9\xbd\x5e\xe1\x0e\x7f\xb0\x85\x44\xf2\x53\xfe\x83\xcb\xf0\x26\xad\x2c\xae\xc3\xc5\xdb\x5f\x70\xd6\x11\x3d\x9d\xe1\x35\x62\x0c\xfc\x0a\x99\xbf\x54\x3c\x44\x88\x47\xa5\xfd\xd0\x09\xf9\x8a\x5f\x6c\xfd\xcb\x90\x63\x16\x90\x30\xf4\x18\x49\x3c\x68\xb6\x01\x3d\xbd\x5e\x03\x45\x97\xb9\x0c\xf3\x6b\x63\x1c\xd6\xab\xd5\x34\x4d\x39\xcd\xe9\xe4\x6a\xcd\xea\xe6\x6e\xf5\xa1\xb8\x7f\xd8\x96\x0f\xcb\x59\xb2\xcb\xf0\xd1\x77\x1c\x02\x8c\xff\x1a\xc5\xb8\xc6\xf1\x0c\x1a\x86\x4e\x2a\x3a\x76\x8c\x8e\xa6\x14\xdc\x9c\xce\x1c\xba\x78\x4c\x26\x51\x7c\x73\x87\x70\x4d\xdd\x65\x5f\xa5\xf3\x72\xae\x9b\x3c\x09\x5f\x2d\xa8\x07\x79\x2c\x36\x25\x8a\x72\x81\x77\x9b\xb2\x28\xef\x5c\x86\x4f\xc5\xe1\xb7\xdd\xc7\x03\x3e\x6d\xf6\xfb\xcd\xf6\x50\x3c\x94\xd8\xed\x71\xbf\xdb\xbe\x2f\x0e\xc5\x6e\x5b\x62\xf7\x88\xcd\xf6\x33\x7e\x2f\xb6\xef\xef\xc0\x12\x5b\x36\xf0\x97\xc1\x92\x7e\x35\x48\x3a\x24\xd7\x29\xd3\x5b\x81\x6e\x02\x52\x3f\xd2\xef\x30\x70\x25\x27\xa9\xd0\x91\x6f\x46\x6a\x18\x8d\x3e\xb3\xf9\x54\x8f\x81\xad\x97\x90\xe2\x0c\x20\x5f\xbb\x0c\x9d\xf4\x12\xe7\x16\x85\xff\x9a\x4a\x34\xb7\x0f\xe3\x3b\x3c\xe7\x9e\xc4\xd7\x6b\xec\xb5\xe3\x77\xe2\x53\x61\x1d\x0d\x72\x2d\xd8\x1a\x76\xa4\x2a\xa7\x31\xb6\x6a\xf2\xf7\xac\x29\x7f\xfa\x39\xe4\xa2\xab\xe7\x1f\x5d\xcf\x91\x6a\x8a\xb4\x76\x80\xa7\x9e\xd7\x38\xd3\x53\x58\xea\xc0\x46\x51\xcd\x01\x1d\x1d\xb9\x0b\x69\x8e\x14\xef\x1a\x8b\xb4\xb1\x70\x00\x79\xaf\x57\x93\x97\x71\x45\x7e\x39\x98\xf6\x1a\x79\x8d\x45\xb4\x91\x17\x2e\x8c\xc7\x3f\xb9\x8a\x61\xed\x96\xb8\xe8\x2c\xd9\x9e\xa5\xe2\x4d\x55\xe9\xe8\xe3\x37\x78\x4d\x3b\xde\xf3\x29\xe1\xbe\xb8\xfb\xa6\x46\x1a\xe4\x57\xd3\x71\xf8\x1f\xb3\xee\x9f\x01\x00\xea\xf7\xbf\x1a\xd0\x04\x00\x00"),
 		},
 		"/rbac/operator-role-binding-servicemonitors.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-binding-servicemonitors.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1264,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x41\x8f\xdb\x46\x0c\x85\xef\xf3\x2b\x1e\xac\x4b\x02\xac\xe5\xb6\xa7\xc2\x3d\x39\x9b\xdd\x56\x68\x60\x03\x96\xd3\x20\x47\x5a\xa2\x25\x76\xa5\xa1\xca\x19\xad\xe2\xfe\xfa\x62\x64\xbb\xbb\x41\x8b\xa2\x87\xcc\x4d\x18\x0e\xf9\x3d\xbe\xa7\x0c\xcb\x6f\x77\x5c\x86\x0f\x52\xb1\x0f\x5c\x23\x2a\x62\xcb\xd8\x0c\x54\xb5\x8c\x52\x4f\x71\x22\x63\x3c\xea\xe8\x6b\x8a\xa2\x1e\x6f\x36\xe5\xe3\x5b\x8c\xbe\x66\x83\x7a\x86\x1a\x7a\x35\x76\x19\x2a\xf5\xd1\xe4\x38\x46\x35\x74\x97\x86\xa0\xc6\x98\x7b\xf6\x31\xe4\x40\xc9\x3c\x77\xdf\xee\x0e\xc5\xfd\x03\x4e\xd2\x31\x6a\x09\x97\x47\x5c\x63\x92\xd8\xba\x0c\xb1\x95\x80\x49\xed\x09\x27\x35\x50\x5d\x4b\x1a\x4c\x1d\xc4\x9f\xd4\xfa\x0b\x86\x71\x43\x56\x8b\x6f\x50\xe9\x70\x36\x69\xda\x08\x9d\x3c\x5b\x68\x65\xc8\x5d\x86\x43\x92\x51\x3e\xde\x48\xc2\xa5\xed\x3c\x33\x2a\x3e\xeb\x78\xd5\xf0\x4a\xee\x75\x0b\x77\xf8\x8d\x2d\xa4\x21\x3f\xe4\xdf\xb9\x0c\x6f\x52\xc9\xe2\x7a\xb9\x78\xfb\x13\xce\x3a\xa2\xa7\x33\xbc\x46\x8c\x81\x5f\x75\xe6\x2f\x15\x0f\x11\xe2\x51\x69\x3f\x74\x42\xbe\xe2\x17\x59\x7f\x4f\xc8\x31\x03\xa4\x1e\x7a\x8c\x24\x1e\x34\xcb\x80\x9e\x5e\x97\x81\xa2\xcb\x5c\x86\xf9\xb4\x31\x0e\xeb\xd5\x6a\x9a\xa6\x9c\x66\xdc\x5c\xad\x59\xdd\xd4\xad\x3e\x14\xf7\x0f\xdb\xf2\x61\x39\x23\xbb\x0c\x1f\x7d\xc7\x21\xc0\xf8\x8f\x51\x8c\x6b\x1c\xcf\xa0\x61\xe8\xa4\xa2\x63\xc7\xe8\x68\x4a\xc6\xcd\xee\xcc\xa6\x8b\xc7\x64\x12\xc5\x37\x77\x08\x57\xd7\x5d\xf6\x95\x3b\x2f\xeb\xba\xe1\x49\xf8\xaa\x40\x3d\xc8\x63\xb1\x29\x51\x94\x0b\xbc\xdb\x94\x45\x79\xe7\x32\x7c\x2a\x0e\xbf\xec\x3e\x1e\xf0\x69\xb3\xdf\x6f\xb6\x87\xe2\xa1\xc4\x6e\x8f\xfb\xdd\xf6\x7d\x71\x28\x76\xdb\x12\xbb\x47\x6c\xb6\x9f\xf1\x6b\xb1\x7d\x7f\x07\x96\xd8\xb2\x81\xbf\x0c\x96\xf8\xd5\x20\x69\x91\x5c\x27\x4f\x6f\x01\xba\x01\xa4\x7c\xa4\xef\x30\x70\x25\x27\xa9\xd0\x91\x6f\x46\x6a\x18\x8d\x3e\xb3\xf9\x14\x8f\x81\xad\x97\x90\xec\x0c\x20\x5f\xbb\x0c\x9d\xf4\x12\xe7\x14\x85\x7f\x8a\x4a\x63\xbe\xe5\xbf\xe5\x9e\xc4\xd7\x6b\xec\xb5\xe3\x77\xe2\x53\x60\x1d\x0d\x72\x0d\xd8\x1a\x76\xa4\x2a\xa7\x31\xb6\x6a\xf2\xe7\xcc\x94\x3f\xfd\x18\x72\xd1\xd5\xf3\xf7\xae\xe7\x48\x35\x45\x5a\x3b\xc0\x53\xcf\x6b\x9c\xe9\x29\x2c\x75\x60\xa3\xa8\xb6\x0c\x6c\xcf\x52\x71\xaf\x5e\xa2\x5a\x70\x40\x47\x47\xee\x42\xaa\x47\xb2\x7b\x8d\x45\x7a\xb1\x70\x00\x79\xaf\x57\xd1\x97\xeb\x8a\xfc\x72\x30\xed\x35\xf2\x1a\x8b\x68\x23\x2f\x5c\x18\x8f\xbf\x73\x15\xc3\xda\x2d\x71\xe1\x2e\x2f\x23\x36\x55\xa5\xa3\x8f\xff\xce\xe1\x4c\x3b\xde\xf3\x29\xf5\x7d\x51\xfb\xbf\x99\x69\x90\x9f\x4d\xc7\xe1\x3f\x96\xe1\xfe\x0a\x00\x00\xff\xff\x0f\xe3\x43\x07\xf0\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x6f\xdb\x46\x10\xbd\xef\xaf\x78\x10\x2f\x09\x60\x51\x6d\x4f\x85\x7a\x52\x1c\xbb\x25\x1a\x48\x80\xa8\x34\xc8\x71\x44\x8e\xc8\xa9\xc9\x1d\x76\x76\x69\x46\xfd\xf5\xc5\x52\x52\xed\xa0\x45\xd1\x43\xf6\x46\xec\xec\xfb\x98\xf7\x98\x61\xf9\xed\x8e\xcb\xf0\x41\x2a\xf6\x81\x6b\x44\x45\x6c\x19\x9b\x81\xaa\x96\x51\xea\x29\x4e\x64\x8c\x47\x1d\x7d\x4d\x51\xd4\xe3\xcd\xa6\x7c\x7c\x8b\xd1\xd7\x6c\x50\xcf\x50\x43\xaf\xc6\x2e\x43\xa5\x3e\x9a\x1c\xc7\xa8\x86\xee\x02\x08\x6a\x8c\xb9\x67\x1f\x43\x0e\x94\xcc\x33\xfa\x76\x77\x28\xee\x1f\x70\x92\x8e\x51\x4b\xb8\x3c\xe2\x1a\x93\xc4\xd6\x65\x88\xad\x04\x4c\x6a\x4f\x38\xa9\x81\xea\x5a\x12\x31\x75\x10\x7f\x52\xeb\x2f\x32\x8c\x1b\xb2\x5a\x7c\x83\x4a\x87\xb3\x49\xd3\x46\xe8\xe4\xd9\x42\x2b\x43\xee\x32\x1c\x92\x8d\xf2\xf1\xa6\x24\x5c\x60\x67\xce\xa8\xf8\xac\xe3\xd5\xc3\x2b\xbb\xd7\x2d\xdc\xe1\x37\xb6\x90\x48\x7e\xc8\xbf\x73\x19\xde\xa4\x91\xc5\xf5\x72\xf1\xf6\x27\x9c\x75\x44\x4f\x67\x78\x8d\x18\x03\xbf\x42\xe6\x2f\x15\x0f\x11\xe2\x51\x69\x3f\x74\x42\xbe\xe2\x17\x5b\x7f\x33\xe4\x98\x05\x24\x0c\x3d\x46\x12\x0f\x9a\x6d\x40\x4f\xaf\xc7\x40\xd1\x65\x2e\xc3\x7c\xda\x18\x87\xf5\x6a\x35\x4d\x53\x4e\x73\x3a\xb9\x5a\xb3\xba\xb9\x5b\x7d\x28\xee\x1f\xb6\xe5\xc3\x72\x96\xec\x32\x7c\xf4\x1d\x87\x00\xe3\x3f\x46\x31\xae\x71\x3c\x83\x86\xa1\x93\x8a\x8e\x1d\xa3\xa3\x29\x05\x37\xa7\x33\x87\x2e\x1e\x93\x49\x14\xdf\xdc\x21\x5c\x53\x77\xd9\x57\xe9\xbc\xac\xeb\x26\x4f\xc2\x57\x03\xea\x41\x1e\x8b\x4d\x89\xa2\x5c\xe0\xdd\xa6\x2c\xca\x3b\x97\xe1\x53\x71\xf8\x65\xf7\xf1\x80\x4f\x9b\xfd\x7e\xb3\x3d\x14\x0f\x25\x76\x7b\xdc\xef\xb6\xef\x8b\x43\xb1\xdb\x96\xd8\x3d\x62\xb3\xfd\x8c\x5f\x8b\xed\xfb\x3b\xb0\xc4\x96\x0d\xfc\x65\xb0\xa4\x5f\x0d\x92\x16\xc9\x75\xca\xf4\x56\xa0\x9b\x80\xd4\x8f\xf4\x1d\x06\xae\xe4\x24\x15\x3a\xf2\xcd\x48\x0d\xa3\xd1\x67\x36\x9f\xea\x31\xb0\xf5\x12\x52\x9c\x01\xe4\x6b\x97\xa1\x93\x5e\xe2\xdc\xa2\xf0\x4f\x53\x89\xe6\xf6\x63\x7c\x83\xe3\xdc\x93\xf8\x7a\x8d\xbd\x76\xfc\x4e\x7c\x2a\xac\xa3\x41\xae\x05\x5b\xc3\x8e\x54\xe5\x34\xc6\x56\x4d\xfe\x9c\x35\xe5\x4f\x3f\x86\x5c\x74\xf5\xfc\xbd\xeb\x39\x52\x4d\x91\xd6\x0e\xf0\xd4\xf3\x1a\x67\x7a\x0a\x4b\x1d\xd8\x28\xaa\x2d\x03\xdb\xb3\x54\xdc\xab\x97\xa8\x16\x1c\xd0\xd1\x91\xbb\x90\xe6\x91\xe2\x5e\x63\x91\x5e\x2c\x1c\x40\xde\xeb\xd5\xf4\xe5\xba\x22\xbf\x1c\x4c\x7b\x8d\xbc\xc6\x22\xda\xc8\x0b\x17\xc6\xe3\xef\x5c\xc5\xb0\x76\x4b\x5c\x74\x97\x17\x8a\x4d\x55\xe9\xe8\xe3\xbf\xeb\x70\xa6\x1d\xef\xf9\x94\x70\x5f\xdc\xfe\x6f\xcd\x34\xc8\xcf\xa6\xe3\xf0\x1f\xcb\x70\x7f\x0d\x00\x0f\xe3\x43\x07\xf0\x04\x00\x00"),
 		},
 		"/rbac/operator-role-binding-strimzi.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-binding-strimzi.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1248,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xcd\x8e\xdb\x46\x13\xbc\xcf\x53\x14\xc4\x8b\x0d\xac\xa8\xef\xcb\x29\x50\x4e\xf2\x7a\x37\x21\x62\x48\x80\x28\xc7\xf0\xb1\x45\xb6\xc8\xce\x92\xd3\x4c\xcf\x70\x69\xf9\xe9\x83\xa1\xa4\xec\x1a\x09\x92\x8b\xe7\x46\x4c\xb3\x7e\xba\x6a\x32\x2c\xbf\xdf\x71\x19\x3e\x48\xc5\x3e\x70\x8d\xa8\x88\x2d\x63\x33\x50\xd5\x32\x4a\x3d\xc5\x89\x8c\xf1\xa8\xa3\xaf\x29\x8a\x7a\xbc\xd9\x94\x8f\x6f\x31\xfa\x9a\x0d\xea\x19\x6a\xe8\xd5\xd8\x65\xa8\xd4\x47\x93\xe3\x18\xd5\xd0\x5d\x00\x41\x8d\x31\xf7\xec\x63\xc8\x81\x92\x79\x46\xdf\xee\x0e\xc5\xfd\x03\x4e\xd2\x31\x6a\x09\x97\x9f\xb8\xc6\x24\xb1\x75\x19\x62\x2b\x01\x93\xda\x13\x4e\x6a\xa0\xba\x96\x44\x4c\x1d\xc4\x9f\xd4\xfa\x8b\x0c\xe3\x86\xac\x16\xdf\xa0\xd2\xe1\x6c\xd2\xb4\x11\x3a\x79\xb6\xd0\xca\x90\xbb\x0c\x87\x64\xa3\x7c\xbc\x29\x09\x17\xd8\x99\x33\x2a\x3e\xeb\x78\xf5\xf0\xca\xee\x75\x0b\x77\xf8\x8d\x2d\x24\x92\x1f\xf2\xff\xb9\x0c\x6f\xd2\xc8\xe2\x7a\xb9\x78\xfb\x13\xce\x3a\xa2\xa7\x33\xbc\x46\x8c\x81\x5f\x21\xf3\x97\x8a\x87\x08\xf1\xa8\xb4\x1f\x3a\x21\x5f\xf1\x8b\xad\xbf\x18\x72\xcc\x02\x12\x86\x1e\x23\x89\x07\xcd\x36\xa0\xa7\xd7\x63\xa0\xe8\x32\x97\x61\x3e\x6d\x8c\xc3\x7a\xb5\x9a\xa6\x29\xa7\x59\x6e\xae\xd6\xac\x6e\xee\x56\x1f\x8a\xfb\x87\x6d\xf9\xb0\x9c\x25\xbb\x0c\x1f\x7d\xc7\x21\xc0\xf8\x8f\x51\x8c\x6b\x1c\xcf\xa0\x61\xe8\xa4\xa2\x63\xc7\xe8\x68\x4a\xc1\xcd\xe9\xcc\xa1\x8b\xc7\x64\x12\xc5\x37\x77\x08\xd7\xd4\x5d\xf6\x4d\x3a\x2f\xeb\xba\xc9\x93\xf0\xcd\x80\x7a\x90\xc7\x62\x53\xa2\x28\x17\x78\xb7\x29\x8b\xf2\xce\x65\xf8\x54\x1c\x7e\xd9\x7d\x3c\xe0\xd3\x66\xbf\xdf\x6c\x0f\xc5\x43\x89\xdd\x1e\xf7\xbb\xed\xfb\xe2\x50\xec\xb6\x25\x76\x8f\xd8\x6c\x3f\xe3\xd7\x62\xfb\xfe\x0e\x2c\xb1\x65\x03\x7f\x19\x2c\xe9\x57\x83\xa4\x45\x72\x9d\x32\xbd\x15\xe8\x26\x20\xf5\x23\x7d\x87\x81\x2b\x39\x49\x85\x8e\x7c\x33\x52\xc3\x68\xf4\x99\xcd\xa7\x7a\x0c\x6c\xbd\x84\x14\x67\x00\xf9\xda\x65\xe8\xa4\x97\x38\xb7\x28\xfc\xdd\x54\xa2\xf9\x9e\x6f\xcb\x3d\x89\xaf\xd7\xd8\x6b\xc7\xef\xc4\xa7\xc2\x3a\x1a\xe4\x5a\xb0\x35\xec\x48\x55\x4e\x63\x6c\xd5\xe4\xeb\xac\x29\x7f\xfa\x31\xe4\xa2\xab\xe7\xff\xbb\x9e\x23\xd5\x14\x69\xed\x00\x4f\x3d\xaf\x71\xa6\xa7\xb0\xd4\x81\x8d\xa2\xda\x32\xad\xbe\xff\x2a\x0e\xe8\xe8\xc8\x5d\x48\x73\x48\x31\xaf\xb1\x48\x93\x0b\x07\x90\xf7\x7a\x35\x7b\xb9\xae\xc8\x2f\x07\xd3\x5e\x23\xaf\xb1\x88\x36\xf2\xc2\x85\xf1\xf8\x3b\x57\x31\xac\xdd\x12\x17\xbd\x25\xdb\xb3\x54\xbc\xa9\x2a\x1d\x7d\xfc\x67\x7e\x67\xda\xf1\x9e\x4f\x09\xf7\xc5\xe5\x7f\x6a\xa5\x41\x7e\x36\x1d\x87\x7f\x31\xef\xfe\x0c\x00\x00\xff\xff\x97\x8e\xb5\x22\xe0\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xcd\x8e\xdb\x46\x13\xbc\xcf\x53\x14\xc4\x8b\x0d\xac\xa8\xef\xcb\x29\x50\x4e\xf2\x7a\x37\x21\x62\x48\x80\x28\xc7\xf0\xb1\x45\xb6\xc8\xce\x92\xd3\x4c\xcf\x70\x69\xf9\xe9\x83\xa1\xa4\xec\x1a\x09\x92\x8b\xe7\x46\x4c\xb3\x7e\xba\x6a\x32\x2c\xbf\xdf\x71\x19\x3e\x48\xc5\x3e\x70\x8d\xa8\x88\x2d\x63\x33\x50\xd5\x32\x4a\x3d\xc5\x89\x8c\xf1\xa8\xa3\xaf\x29\x8a\x7a\xbc\xd9\x94\x8f\x6f\x31\xfa\x9a\x0d\xea\x19\x6a\xe8\xd5\xd8\x65\xa8\xd4\x47\x93\xe3\x18\xd5\xd0\x5d\x00\x41\x8d\x31\xf7\xec\x63\xc8\x81\x92\x79\x46\xdf\xee\x0e\xc5\xfd\x03\x4e\xd2\x31\x6a\x09\x97\x9f\xb8\xc6\x24\xb1\x75\x19\x62\x2b\x01\x93\xda\x13\x4e\x6a\xa0\xba\x96\x44\x4c\x1d\xc4\x9f\xd4\xfa\x8b\x0c\xe3\x86\xac\x16\xdf\xa0\xd2\xe1\x6c\xd2\xb4\x11\x3a\x79\xb6\xd0\xca\x90\xbb\x0c\x87\x64\xa3\x7c\xbc\x29\x09\x17\xd8\x99\x33\x2a\x3e\xeb\x78\xf5\xf0\xca\xee\x75\x0b\x77\xf8\x8d\x2d\x24\x92\x1f\xf2\xff\xb9\x0c\x6f\xd2\xc8\xe2\x7a\xb9\x78\xfb\x13\xce\x3a\xa2\xa7\x33\xbc\x46\x8c\x81\x5f\x21\xf3\x97\x8a\x87\x08\xf1\xa8\xb4\x1f\x3a\x21\x5f\xf1\x8b\xad\xbf\x18\x72\xcc\x02\x12\x86\x1e\x23\x89\x07\xcd\x36\xa0\xa7\xd7\x63\xa0\xe8\x32\x97\x61\x3e\x6d\x8c\xc3\x7a\xb5\x9a\xa6\x29\xa7\x39\x9d\x5c\xad\x59\xdd\xdc\xad\x3e\x14\xf7\x0f\xdb\xf2\x61\x39\x4b\x76\x19\x3e\xfa\x8e\x43\x80\xf1\x1f\xa3\x18\xd7\x38\x9e\x41\xc3\xd0\x49\x45\xc7\x8e\xd1\xd1\x94\x82\x9b\xd3\x99\x43\x17\x8f\xc9\x24\x8a\x6f\xee\x10\xae\xa9\xbb\xec\x9b\x74\x5e\xd6\x75\x93\x27\xe1\x9b\x01\xf5\x20\x8f\xc5\xa6\x44\x51\x2e\xf0\x6e\x53\x16\xe5\x9d\xcb\xf0\xa9\x38\xfc\xb2\xfb\x78\xc0\xa7\xcd\x7e\xbf\xd9\x1e\x8a\x87\x12\xbb\x3d\xee\x77\xdb\xf7\xc5\xa1\xd8\x6d\x4b\xec\x1e\xb1\xd9\x7e\xc6\xaf\xc5\xf6\xfd\x1d\x58\x62\xcb\x06\xfe\x32\x58\xd2\xaf\x06\x49\x8b\xe4\x3a\x65\x7a\x2b\xd0\x4d\x40\xea\x47\xfa\x0e\x03\x57\x72\x92\x0a\x1d\xf9\x66\xa4\x86\xd1\xe8\x33\x9b\x4f\xf5\x18\xd8\x7a\x09\x29\xce\x00\xf2\xb5\xcb\xd0\x49\x2f\x71\x6e\x51\xf8\xbb\xa9\x44\x73\x7b\x18\xdf\xe1\x38\xf7\x24\xbe\x5e\x63\xaf\x1d\xbf\x13\x9f\x0a\xeb\x68\x90\x6b\xc1\xd6\xb0\x23\x55\x39\x8d\xb1\x55\x93\xaf\xb3\xa6\xfc\xe9\xc7\x90\x8b\xae\x9e\xff\xef\x7a\x8e\x54\x53\xa4\xb5\x03\x3c\xf5\xbc\xc6\x99\x9e\xc2\x52\x07\x36\x8a\x6a\xcb\xf4\x72\xfa\xaf\xe2\x80\x8e\x8e\xdc\x85\x34\x87\x14\xf3\x1a\x8b\x34\xb9\x70\x00\x79\xaf\x57\xb3\x97\xeb\x8a\xfc\x72\x30\xed\x35\xf2\x1a\x8b\x68\x23\x2f\x5c\x18\x8f\xbf\x73\x15\xc3\xda\x2d\x71\xd1\x5b\xb2\x3d\x4b\xc5\x9b\xaa\xd2\xd1\xc7\x7f\xe6\x77\xa6\x1d\xef\xf9\x94\x70\x5f\x5c\xfe\xa7\x56\x1a\xe4\x67\xd3\x71\xf8\x17\xf3\xee\xcf\x01\x00\x97\x8e\xb5\x22\xe0\x04\x00\x00"),
 		},
 		"/rbac/operator-role-camel-k.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-camel-k.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1324,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xac\x4b\x02\xac\xed\xb6\xa7\xc2\x3d\xb9\x9b\xdd\x56\x68\x60\x03\x2b\xa7\x41\x8e\x63\x69\x2c\x0d\x2c\x71\xd4\x21\xb5\x8a\xfb\xf5\x05\x65\x39\xbb\xdb\x5c\xa3\x13\x87\x7c\x9a\xf7\x1e\xdf\x30\xc3\xf2\xc7\x7d\x2e\xc3\x47\x29\xd9\x07\xae\x10\x15\xb1\x61\x6c\x7b\x2a\x1b\x46\xa1\xa7\x38\x92\x31\x1e\x75\xf0\x15\x45\x51\x8f\x77\xdb\xe2\xf1\x3d\x06\x5f\xb1\x41\x3d\x43\x0d\x9d\x1a\xbb\x0c\xa5\xfa\x68\x72\x1c\xa2\x1a\xda\x6b\x43\x50\x6d\xcc\x1d\xfb\x18\x56\x40\xc1\x3c\x75\xdf\xed\x0f\xf9\xfd\x03\x4e\xd2\x32\x2a\x09\xd7\x9f\xb8\xc2\x28\xb1\x71\x19\x62\x23\x01\xa3\xda\x19\x27\x35\x50\x55\x49\x22\xa6\x16\xe2\x4f\x6a\xdd\x55\x86\x71\x4d\x56\x89\xaf\x51\x6a\x7f\x31\xa9\x9b\x08\x1d\x3d\x5b\x68\xa4\x5f\xb9\x0c\x87\x64\xa3\x78\xbc\x29\x09\xd7\xb6\x13\x67\x54\x7c\xd1\x61\xf6\xf0\xca\xee\x7c\x0b\x77\xf8\x9b\x2d\x24\x92\x5f\x56\x3f\xb9\x0c\xef\x12\x64\x31\x1f\x2e\xde\xff\x86\x8b\x0e\xe8\xe8\x02\xaf\x11\x43\xe0\x57\x9d\xf9\x6b\xc9\x7d\x84\x78\x94\xda\xf5\xad\x90\x2f\xf9\xc5\xd6\x37\x86\x15\x26\x01\xa9\x87\x1e\x23\x89\x07\x4d\x36\xa0\xa7\xd7\x30\x50\x74\x99\xcb\x30\x7d\x4d\x8c\xfd\x66\xbd\x1e\xc7\x71\x45\x93\xdc\x95\x5a\xbd\xbe\xb9\x5b\x7f\xcc\xef\x1f\x76\xc5\xc3\x72\x92\xec\x32\x7c\xf2\x2d\x87\x00\xe3\x7f\x06\x31\xae\x70\xbc\x80\xfa\xbe\x95\x92\x8e\x2d\xa3\xa5\x31\x05\x37\xa5\x33\x85\x2e\x1e\xa3\x49\x14\x5f\xdf\x21\xcc\xa9\xbb\xec\x4d\x3a\x2f\xd7\x75\x93\x27\xe1\x0d\x40\x3d\xc8\x63\xb1\x2d\x90\x17\x0b\xfc\xbe\x2d\xf2\xe2\xce\x65\xf8\x9c\x1f\xfe\xdc\x7f\x3a\xe0\xf3\xf6\xe9\x69\xbb\x3b\xe4\x0f\x05\xf6\x4f\xb8\xdf\xef\x3e\xe4\x87\x7c\xbf\x2b\xb0\x7f\xc4\x76\xf7\x05\x7f\xe5\xbb\x0f\x77\x60\x89\x0d\x1b\xf8\x6b\x6f\x49\xbf\x1a\x24\x5d\x24\x57\x29\xd3\xdb\x00\xdd\x04\xa4\xf9\x48\x75\xe8\xb9\x94\x93\x94\x68\xc9\xd7\x03\xd5\x8c\x5a\x9f\xd9\x7c\x1a\x8f\x9e\xad\x93\x90\xe2\x0c\x20\x5f\xb9\x0c\xad\x74\x12\xa7\x29\x0a\xdf\x9b\x4a\x34\x3f\xf2\x6d\xb9\xb3\xf8\x6a\x83\x27\x6d\xd9\x51\x2f\xf3\x64\x6d\x60\x47\x2a\x57\x34\xc4\x46\x4d\xfe\x9d\xc4\xac\xce\xbf\x86\x95\xe8\xfa\xf9\x67\xd7\x71\xa4\x8a\x22\x6d\x1c\xe0\xa9\xe3\x0d\x2e\x74\x0e\x4b\xed\xd9\x28\xaa\x2d\x4b\xea\xb8\x5d\x9e\x1d\xd0\xd2\x91\xdb\x90\x70\x48\xf9\x6e\xb0\x48\xc8\x85\x03\xc8\x7b\x9d\x5d\x5e\x8f\x4b\xf2\xcb\xde\xb4\xd3\xc8\x1b\x2c\xa2\x0d\xbc\x70\x36\xb4\x1c\x36\x6e\x09\xea\xe5\x0f\xd3\xa1\x9f\xb1\x4b\x4c\x14\xaf\x26\xcd\x01\xc6\x41\x07\x2b\xf9\x1b\x46\x7c\xe4\xda\xae\x1c\xf3\xd6\x39\xfd\xc6\xf1\x7f\xe5\x51\x7c\x7a\xaa\x69\xf7\x99\xed\xf8\x42\x62\x4c\x91\xe7\xa2\x4a\xc8\xb7\x45\xa9\x6d\xcb\x65\xea\x3f\x6f\xd7\x1c\xe7\x55\x2b\xe1\xb6\xec\x29\x96\xcd\xbc\x1e\xfa\xea\xa5\xe3\x38\x1d\xfc\x17\x00\x00\xff\xff\x06\x12\xc0\x7d\x2c\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x8f\xdb\x36\x10\xbd\xf3\x57\x3c\x58\x97\x04\x58\xdb\x6d\x4f\x85\x7b\x72\x37\xbb\xad\xd0\xc0\x06\x56\x4e\x83\x1c\xc7\xd2\x58\x1a\x58\xe2\xa8\x43\x6a\x15\xf7\xd7\x17\x94\xe5\xec\x6e\x73\x0d\x4f\x1c\x6a\xf8\x3e\xf8\x46\x19\x96\x3f\x6e\xb9\x0c\x1f\xa5\x64\x1f\xb8\x42\x54\xc4\x86\xb1\xed\xa9\x6c\x18\x85\x9e\xe2\x48\xc6\x78\xd4\xc1\x57\x14\x45\x3d\xde\x6d\x8b\xc7\xf7\x18\x7c\xc5\x06\xf5\x0c\x35\x74\x6a\xec\x32\x94\xea\xa3\xc9\x71\x88\x6a\x68\xaf\x80\xa0\xda\x98\x3b\xf6\x31\xac\x80\x82\x79\x42\xdf\xed\x0f\xf9\xfd\x03\x4e\xd2\x32\x2a\x09\xd7\x4b\x5c\x61\x94\xd8\xb8\x0c\xb1\x91\x80\x51\xed\x8c\x93\x1a\xa8\xaa\x24\x11\x53\x0b\xf1\x27\xb5\xee\x2a\xc3\xb8\x26\xab\xc4\xd7\x28\xb5\xbf\x98\xd4\x4d\x84\x8e\x9e\x2d\x34\xd2\xaf\x5c\x86\x43\xb2\x51\x3c\xde\x94\x84\x2b\xec\xc4\x19\x15\x5f\x74\x98\x3d\xbc\xb2\x3b\xbf\xc2\x1d\xfe\x66\x0b\x89\xe4\x97\xd5\x4f\x2e\xc3\xbb\xd4\xb2\x98\x3f\x2e\xde\xff\x86\x8b\x0e\xe8\xe8\x02\xaf\x11\x43\xe0\x57\xc8\xfc\xb5\xe4\x3e\x42\x3c\x4a\xed\xfa\x56\xc8\x97\xfc\x62\xeb\x1b\xc3\x0a\x93\x80\x84\xa1\xc7\x48\xe2\x41\x93\x0d\xe8\xe9\x75\x1b\x28\xba\xcc\x65\x98\x56\x13\x63\xbf\x59\xaf\xc7\x71\x5c\xd1\x94\xce\x4a\xad\x5e\xdf\xdc\xad\x3f\xe6\xf7\x0f\xbb\xe2\x61\x39\x49\x76\x19\x3e\xf9\x96\x43\x80\xf1\x3f\x83\x18\x57\x38\x5e\x40\x7d\xdf\x4a\x49\xc7\x96\xd1\xd2\x98\x82\x9b\xd2\x99\x42\x17\x8f\xd1\x24\x8a\xaf\xef\x10\xe6\xd4\x5d\xf6\x26\x9d\x97\xe7\xba\xc9\x93\xf0\xa6\x41\x3d\xc8\x63\xb1\x2d\x90\x17\x0b\xfc\xbe\x2d\xf2\xe2\xce\x65\xf8\x9c\x1f\xfe\xdc\x7f\x3a\xe0\xf3\xf6\xe9\x69\xbb\x3b\xe4\x0f\x05\xf6\x4f\xb8\xdf\xef\x3e\xe4\x87\x7c\xbf\x2b\xb0\x7f\xc4\x76\xf7\x05\x7f\xe5\xbb\x0f\x77\x60\x89\x0d\x1b\xf8\x6b\x6f\x49\xbf\x1a\x24\x3d\x24\x57\x29\xd3\xdb\x00\xdd\x04\xa4\xf9\x48\x75\xe8\xb9\x94\x93\x94\x68\xc9\xd7\x03\xd5\x8c\x5a\x9f\xd9\x7c\x1a\x8f\x9e\xad\x93\x90\xe2\x0c\x20\x5f\xb9\x0c\xad\x74\x12\xa7\x29\x0a\xdf\x9b\x4a\x34\xb7\x1f\xe3\x07\x2c\xe7\xce\xe2\xab\x0d\x9e\xb4\x65\x47\xbd\xcc\x93\xb5\x81\x1d\xa9\x5c\xd1\x10\x1b\x35\xf9\x77\x12\xb3\x3a\xff\x1a\x56\xa2\xeb\xe7\x9f\x5d\xc7\x91\x2a\x8a\xb4\x71\x80\xa7\x8e\x37\xb8\xd0\x39\x2c\xb5\x67\xa3\xa8\xb6\x2c\xa9\xe3\x76\x79\x76\x40\x4b\x47\x6e\x43\xea\x43\xca\x77\x83\x45\xea\x5c\x38\x80\xbc\xd7\xd9\xe5\xf5\x73\x49\x7e\xd9\x9b\x76\x1a\x79\x83\x45\xb4\x81\x17\xce\x86\x96\xc3\xc6\x2d\x41\xbd\xfc\x61\x3a\xf4\x33\xd4\x12\x13\xc5\xab\x49\x73\x80\x71\xd0\xc1\x4a\xfe\xd6\x23\x3e\x72\x6d\x57\x8e\xf9\xe8\x9c\xae\x71\xfc\x5f\x79\x14\x9f\x7e\xd5\x74\xfa\xcc\x76\x7c\x21\x31\xa6\xc8\x73\x51\xa5\x8b\x6f\x8b\x52\xdb\x96\xcb\x84\x3f\x1f\xd7\x1c\xe7\x5d\x2b\xe1\xb6\xed\x29\x96\xcd\xbc\x1f\xfa\xea\x05\x71\xa4\x58\x36\xee\xbf\x01\x00\x06\x12\xc0\x7d\x2c\x05\x00\x00"),
 		},
 		"/rbac/operator-role-knative.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-knative.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1641,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x93\x41\x8f\xdb\x46\x0c\x85\xef\xf3\x2b\x1e\xa4\x4b\x52\xac\xe5\xb6\xa7\xc2\x3d\xb9\x9b\xdd\xd6\x68\x60\x03\x2b\xa7\x41\x8e\xb4\x44\x4b\x84\xa5\xa1\xca\x19\x59\x71\x7f\x7d\x21\x59\x6e\x76\x11\xa0\xbd\x2c\x1a\x5d\x4c\xcd\xd0\xe4\xf7\xf8\xa8\x14\x8b\xd7\x7b\x5c\x8a\xf7\x52\xb0\x0f\x5c\x22\x2a\x62\xcd\x58\x77\x54\xd4\x8c\x5c\x8f\x71\x20\x63\x3c\x6a\xef\x4b\x8a\xa2\x1e\x6f\xd6\xf9\xe3\x5b\xf4\xbe\x64\x83\x7a\x86\x1a\x5a\x35\x76\x29\x0a\xf5\xd1\xe4\xd0\x47\x35\x34\xd7\x82\xa0\xca\x98\x5b\xf6\x31\x64\x40\xce\x3c\x55\xdf\xee\xf6\x9b\xfb\x07\x1c\xa5\x61\x94\x12\xae\x7f\xe2\x12\x83\xc4\xda\xa5\x88\xb5\x04\x0c\x6a\x27\x1c\xd5\x40\x65\x29\x63\x63\x6a\x20\xfe\xa8\xd6\x5e\x31\x8c\x2b\xb2\x52\x7c\x85\x42\xbb\x8b\x49\x55\x47\xe8\xe0\xd9\x42\x2d\x5d\xe6\x52\xec\x47\x19\xf9\xe3\x8d\x24\x5c\xcb\x4e\x3d\xa3\xe2\x93\xf6\xb3\x86\x67\x72\xe7\x29\xdc\xe1\x0f\xb6\x30\x36\xf9\x31\xfb\xde\xa5\x78\x33\xa6\x24\xf3\x65\xf2\xf6\x67\x5c\xb4\x47\x4b\x17\x78\x8d\xe8\x03\x3f\xab\xcc\x9f\x0b\xee\x22\xc4\xa3\xd0\xb6\x6b\x84\x7c\xc1\x5f\x64\xfd\xd3\x21\xc3\x04\x30\xd6\xd0\x43\x24\xf1\xa0\x49\x06\xf4\xf8\x3c\x0d\x14\x5d\xea\x52\x4c\x4f\x1d\x63\xb7\x5a\x2e\x87\x61\xc8\x68\xc2\xcd\xd4\xaa\xe5\x4d\xdd\xf2\xfd\xe6\xfe\x61\x9b\x3f\x2c\x26\x64\x97\xe2\x83\x6f\x38\x04\x18\xff\xd9\x8b\x71\x89\xc3\x05\xd4\x75\x8d\x14\x74\x68\x18\x0d\x0d\xa3\x71\x93\x3b\x93\xe9\xe2\x31\x98\x44\xf1\xd5\x1d\xc2\xec\xba\x4b\x5f\xb8\xf3\x65\x5c\x37\x3c\x09\x2f\x12\xd4\x83\x3c\x92\x75\x8e\x4d\x9e\xe0\x97\x75\xbe\xc9\xef\x5c\x8a\x8f\x9b\xfd\x6f\xbb\x0f\x7b\x7c\x5c\x3f\x3d\xad\xb7\xfb\xcd\x43\x8e\xdd\x13\xee\x77\xdb\x77\x9b\xfd\x66\xb7\xcd\xb1\x7b\xc4\x7a\xfb\x09\xbf\x6f\xb6\xef\xee\xc0\x12\x6b\x36\xf0\xe7\xce\x46\x7e\x35\xc8\x38\x48\x2e\x47\x4f\x6f\x0b\x74\x03\x18\xf7\x63\x7c\x0f\x1d\x17\x72\x94\x02\x0d\xf9\xaa\xa7\x8a\x51\xe9\x99\xcd\x8f\xeb\xd1\xb1\xb5\x12\x46\x3b\x03\xc8\x97\x2e\x45\x23\xad\xc4\x69\x8b\xc2\xd7\xa2\xc6\x36\xaf\xf9\x6d\xb9\x93\xf8\x72\x85\x27\x6d\xd8\x51\x27\xf3\x66\xad\x60\x07\x2a\x32\xea\x63\xad\x26\x7f\x4d\x30\xd9\xe9\xa7\x90\x89\x2e\xcf\x3f\xb8\x96\x23\x95\x14\x69\xe5\x00\x4f\x2d\xaf\x70\xa1\x53\x58\x68\xc7\x46\x51\x6d\x71\xf2\x14\xe5\xcc\x0e\x68\xe8\xc0\x4d\x18\xf3\x30\xfa\xbb\x42\x32\x66\x26\x0e\x20\xef\x75\x56\x79\xbd\x2e\xc8\x2f\x3a\xd3\x56\x23\xaf\x90\x44\xeb\x39\x71\xd6\x37\x1c\x56\x6e\x01\xea\xe4\x57\xd3\xbe\x9b\x72\x17\xe0\x33\xfb\x71\x19\xb2\xb9\x53\x56\xf2\xd9\x01\xc6\x41\x7b\x2b\x78\xce\x3a\x98\x9e\xd8\xc2\x14\x47\x93\xaa\xba\xbe\x9c\xd9\x0e\x73\x46\x61\x4c\x91\xa7\xb0\xe4\x86\x5f\x84\x85\x36\x0d\x17\x23\xe0\x74\x58\x71\x9c\x7e\x1b\x09\xd7\xa0\xa3\x58\xd4\x53\xd4\x77\xe5\xad\xca\x30\x1d\x7e\xc5\xdb\x72\x08\x54\xfd\x27\x70\x51\x93\xf7\xdc\x5c\x89\xc5\xb7\xdc\xaa\x5d\x5e\x1c\x86\xfe\x10\x0a\x93\x6e\x9a\xdb\xb7\xd1\x32\x13\xff\xbb\x92\xe4\xbb\xe4\x7f\xa1\xfb\x3b\x00\x00\xff\xff\x55\xa8\x2a\x8a\x69\x06\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x93\x4f\x8f\xdb\x36\x10\xc5\xef\xfc\x14\x0f\xd2\x25\x29\xd6\x72\xdb\x53\xe1\x9e\xdc\xcd\x6e\x6b\x34\xb0\x81\x95\xd3\x20\x47\x5a\x1a\x4b\x03\x4b\x1c\x76\x48\x59\x71\x3f\x7d\x41\x59\x6e\x76\x11\xa0\xbd\x2c\x1a\x5e\xc4\x3f\xc3\x99\xdf\xe3\x1b\xe5\x58\xbc\xde\x30\x39\xde\x73\x45\x2e\x50\x8d\x28\x88\x2d\x61\xed\x6d\xd5\x12\x4a\x39\xc6\xd1\x2a\xe1\x51\x06\x57\xdb\xc8\xe2\xf0\x66\x5d\x3e\xbe\xc5\xe0\x6a\x52\x88\x23\x88\xa2\x17\x25\x93\xa3\x12\x17\x95\x0f\x43\x14\x45\x77\x4d\x08\xdb\x28\x51\x4f\x2e\x86\x02\x28\x89\xa6\xec\xdb\xdd\x7e\x73\xff\x80\x23\x77\x84\x9a\xc3\xf5\x12\xd5\x18\x39\xb6\x26\x47\x6c\x39\x60\x14\x3d\xe1\x28\x0a\x5b\xd7\x9c\x0a\xdb\x0e\xec\x8e\xa2\xfd\x15\x43\xa9\xb1\x5a\xb3\x6b\x50\x89\xbf\x28\x37\x6d\x84\x8c\x8e\x34\xb4\xec\x0b\x93\x63\x9f\x64\x94\x8f\x37\x92\x70\x4d\x3b\xd5\x8c\x82\x4f\x32\xcc\x1a\x9e\xc9\x9d\x5f\xe1\x0e\x7f\x90\x86\x54\xe4\xc7\xe2\x7b\x93\xe3\x4d\x0a\xc9\xe6\xc3\xec\xed\xcf\xb8\xc8\x80\xde\x5e\xe0\x24\x62\x08\xf4\x2c\x33\x7d\xae\xc8\x47\xb0\x43\x25\xbd\xef\xd8\xba\x8a\xbe\xc8\xfa\xa7\x42\x81\x09\x20\xe5\x90\x43\xb4\xec\x60\x27\x19\x90\xe3\xf3\x30\xd8\x68\x72\x93\x63\x1a\x6d\x8c\x7e\xb5\x5c\x8e\xe3\x58\xd8\xc9\x9d\x42\xb4\x59\xde\xd4\x2d\xdf\x6f\xee\x1f\xb6\xe5\xc3\x62\x42\x36\x39\x3e\xb8\x8e\x42\x80\xd2\x9f\x03\x2b\xd5\x38\x5c\x60\xbd\xef\xb8\xb2\x87\x8e\xd0\xd9\x31\x19\x37\xb9\x33\x99\xce\x0e\xa3\x72\x64\xd7\xdc\x21\xcc\xae\x9b\xfc\x85\x3b\x5f\x9e\xeb\x86\xc7\xe1\x45\x80\x38\x58\x87\x6c\x5d\x62\x53\x66\xf8\x65\x5d\x6e\xca\x3b\x93\xe3\xe3\x66\xff\xdb\xee\xc3\x1e\x1f\xd7\x4f\x4f\xeb\xed\x7e\xf3\x50\x62\xf7\x84\xfb\xdd\xf6\xdd\x66\xbf\xd9\x6d\x4b\xec\x1e\xb1\xde\x7e\xc2\xef\x9b\xed\xbb\x3b\x10\xc7\x96\x14\xf4\xd9\x6b\xe2\x17\x05\xa7\x87\xa4\x3a\x79\x7a\x6b\xa0\x1b\x40\xea\x8f\xb4\x0e\x9e\x2a\x3e\x72\x85\xce\xba\x66\xb0\x0d\xa1\x91\x33\xa9\x4b\xed\xe1\x49\x7b\x0e\xc9\xce\x00\xeb\x6a\x93\xa3\xe3\x9e\xe3\xd4\x45\xe1\x6b\x51\xa9\xcc\xed\xc7\x78\x85\x61\xcc\x89\x5d\xbd\xc2\x93\x74\x64\xac\xe7\xb9\xb3\x56\xd0\x83\xad\x0a\x3b\xc4\x56\x94\xff\x9a\x60\x8a\xd3\x4f\xa1\x60\x59\x9e\x7f\x30\x3d\x45\x5b\xdb\x68\x57\x06\x70\xb6\xa7\x15\x2e\xf6\x14\x16\xe2\x49\x6d\x14\x5d\x9c\x9c\x8d\x7c\x26\x03\x74\xf6\x40\x5d\x48\x71\x48\xfe\xae\x90\xa5\xc8\xcc\x00\xd6\x39\x99\x55\x5e\x8f\x2b\xeb\x16\x5e\xa5\x97\x48\x2b\x64\x51\x07\xca\x8c\x0e\x1d\x85\x95\x59\xc0\x7a\xfe\x55\x65\xf0\x53\xaa\x05\xe8\x4c\x2e\x35\x43\x31\x57\x2a\x6a\x3a\x1b\x40\x29\xc8\xa0\x15\xcd\x51\x07\x95\x13\x69\x98\x6e\x44\xe5\xa6\xb9\x2e\xce\xa4\x87\x39\xa2\x52\xb2\x31\x71\x2e\x50\x53\x47\x2f\xa6\x95\x74\x1d\x55\x09\x70\xda\x6c\x28\x4e\xdf\x8e\xc3\x75\xe2\x6d\xac\xda\x69\x36\xf8\xfa\x96\x65\x9c\x36\xbf\xe2\xed\x29\x04\xdb\xfc\x27\x70\xd5\x5a\xe7\xa8\x4b\x90\x0b\xb0\xeb\xa9\x17\xbd\xbc\xd8\x0c\xc3\x21\x54\xca\x3e\x61\x7d\x23\x2d\x33\xf1\xbf\x2b\xc9\xbe\xcb\xfe\x17\xba\xbf\x07\x00\x55\xa8\x2a\x8a\x69\x06\x00\x00"),
 		},
 		"/rbac/operator-role-kubernetes.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-kubernetes.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2585,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\xc1\x6e\x1b\x37\x10\xbd\xef\x57\x0c\x76\x2f\x49\x61\x49\x6d\x4f\x85\x7a\x52\x13\xbb\x15\x1a\x48\x80\x57\x69\x90\xe3\x88\x3b\x5a\x4d\xcd\xe5\xb0\x43\xae\x64\xf5\xeb\x0b\x72\xa5\xd8\x8a\xe2\xc2\x41\x83\x5a\x17\x0d\xc9\xd9\x37\xef\xcd\x3c\x82\x15\x8c\xbe\xdd\xaf\xa8\xe0\x1d\x1b\x72\x81\x1a\x88\x02\x71\x4b\x30\xf3\x68\xb6\x04\xb5\x6c\xe2\x1e\x95\xe0\x46\x7a\xd7\x60\x64\x71\xf0\x6a\x56\xdf\xbc\x86\xde\x35\xa4\x20\x8e\x40\x14\x3a\x51\x2a\x2a\x30\xe2\xa2\xf2\xba\x8f\xa2\x60\x07\x40\xc0\x56\x89\x3a\x72\x31\x8c\x01\x6a\xa2\x8c\xbe\x58\xae\xe6\x6f\xae\x61\xc3\x96\xa0\xe1\x30\x7c\x44\x0d\xec\x39\x6e\x8b\x0a\xe2\x96\x03\xec\x45\xef\x60\x23\x0a\xd8\x34\x9c\x0a\xa3\x05\x76\x1b\xd1\x6e\xa0\xa1\xd4\xa2\x36\xec\x5a\x30\xe2\x0f\xca\xed\x36\x82\xec\x1d\x69\xd8\xb2\x1f\x17\x15\xac\x92\x8c\xfa\xe6\xc4\x24\x0c\xb0\xb9\x66\x14\xf8\x28\xfd\x51\xc3\x23\xb9\xc7\x2e\x5c\xc1\x1f\xa4\x21\x15\xf9\x71\xfc\x7d\x51\xc1\xab\x94\x52\x1e\x0f\xcb\xd7\x3f\xc3\x41\x7a\xe8\xf0\x00\x4e\x22\xf4\x81\x1e\x21\xd3\xbd\x21\x1f\x81\x1d\x18\xe9\xbc\x65\x74\x86\x1e\x64\x7d\xaa\x30\x86\x4c\x20\x61\xc8\x3a\x22\x3b\xc0\x2c\x03\x64\xf3\x38\x0d\x30\x16\x55\x51\x41\xfe\x6d\x63\xf4\xd3\xc9\x64\xbf\xdf\x8f\x31\xd3\x1d\x8b\xb6\x93\x93\xba\xc9\xbb\xf9\x9b\xeb\x45\x7d\x3d\xca\x94\x8b\x0a\xde\x3b\x4b\x21\x80\xd2\x5f\x3d\x2b\x35\xb0\x3e\x00\x7a\x6f\xd9\xe0\xda\x12\x58\xdc\xa7\xc1\xe5\xe9\xe4\xa1\xb3\x83\xbd\x72\x64\xd7\x5e\x41\x38\x4e\xbd\xa8\xce\xa6\xf3\xd0\xae\x13\x3d\x0e\x67\x09\xe2\x00\x1d\x94\xb3\x1a\xe6\x75\x09\xbf\xcc\xea\x79\x7d\x55\x54\xf0\x61\xbe\xfa\x6d\xf9\x7e\x05\x1f\x66\xb7\xb7\xb3\xc5\x6a\x7e\x5d\xc3\xf2\x16\xde\x2c\x17\x6f\xe7\xab\xf9\x72\x51\xc3\xf2\x06\x66\x8b\x8f\xf0\xfb\x7c\xf1\xf6\x0a\x88\xe3\x96\x14\xe8\xde\x6b\xe2\x2f\x0a\x9c\x1a\x49\x4d\x9a\xe9\xc9\x40\x27\x02\xc9\x1f\x69\x1d\x3c\x19\xde\xb0\x01\x8b\xae\xed\xb1\x25\x68\x65\x47\xea\x92\x3d\x3c\x69\xc7\x21\x8d\x33\x00\xba\xa6\xa8\xc0\x72\xc7\x31\xbb\x28\x5c\x8a\x4a\x65\xbe\xe5\xdd\x2a\xee\xd8\x35\x53\xb8\x15\x4b\x05\x7a\x3e\x3a\x6b\x0a\xba\x46\x33\xc6\x3e\x6e\x45\xf9\xef\x4c\x66\x7c\xf7\x53\x18\xb3\x4c\x76\x3f\x14\x1d\x45\x6c\x30\xe2\xb4\x00\x70\xd8\xd1\x14\x0e\x78\x17\x46\xe2\x49\x31\x8a\x16\x00\x16\xd7\x64\x43\x3a\x87\x34\xd7\x29\x94\x29\xa3\x2c\x00\xd0\x39\x39\xaa\x1b\x8e\x0d\xba\x91\x57\xe9\x24\xd2\x14\xca\xa8\x3d\x95\x85\xf6\x96\xc2\xb4\x18\x01\x7a\xfe\x55\xa5\xf7\x39\x77\x94\xcb\x8c\x0d\x47\xed\xc3\x46\xb1\xa3\x74\x0b\x93\xcf\x0a\x00\xa5\x20\xbd\x1a\x3a\x66\x96\xdf\xa5\x62\x3b\xd2\xf5\xa3\x8d\x0b\xbc\x72\x8d\xd1\x6c\xcb\xcb\xcf\xff\x94\x75\x38\xff\xde\x28\x61\xa4\x1c\x36\x64\xe9\x2c\x34\x62\x2d\x99\xa4\x29\x6f\xb6\x14\xf3\xbf\xe5\x30\x04\x3e\x55\xc9\x51\xef\x9b\x13\xca\x3e\x6f\x7e\x1d\xa5\x49\x88\x18\xfb\xcf\x98\x5d\x94\x7b\x0a\xf9\x0b\xa0\x5e\x9a\x90\x83\x40\xba\x63\x43\xc3\x82\x5c\xe3\x85\x5d\x1c\x56\x3e\x79\x22\x44\x72\x71\x27\xb6\xef\xc8\x58\xe4\x6e\x38\x32\xe2\x36\xdc\x76\xe8\x4f\x20\x46\x29\x9e\x01\xa2\x31\xd2\x0f\x48\x2f\xd1\xcc\x27\x24\x4f\xac\xb4\x0f\x8b\xff\xd2\xd4\x27\xef\xc9\x65\x61\x15\x7b\xec\x6f\x8a\xd6\xec\xd2\xf3\xf0\x42\x8d\xf9\x0a\xda\xc6\xf6\x21\x92\x3e\xb0\x7f\xb4\xf1\x65\x11\x67\xcc\x9e\x35\x13\xda\xd1\xbf\x79\xe4\x41\xd9\x73\xc7\x82\x3e\x5b\xf2\xb3\x32\x0d\x79\x2b\x87\x8e\x4e\xce\x56\xca\x2f\x4e\xf8\xe4\xd9\x88\x91\x36\xbd\x3d\x6e\xbc\xc0\x5c\x9e\xe0\x8d\xd4\x89\xbb\x64\xf5\xdc\x6e\xd0\x7d\x24\x97\x9f\x98\x4b\x6c\x76\x6d\x7a\xc9\xe8\xff\x11\xfc\x4f\x00\x00\x00\xff\xff\x1b\x0c\xed\x67\x19\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\x4d\x6f\xdb\x46\x10\xbd\xf3\x57\x3c\x88\x97\xa4\xb0\xa4\xb6\xa7\x42\x3d\xa9\x89\xdd\x0a\x0d\x24\xc0\x54\x1a\xe4\x38\x5a\x8e\xa8\xad\x97\x3b\xdb\xd9\xa5\x64\xf5\xd7\x17\x4b\x49\xb1\x15\xc5\x81\x83\xa6\x29\x2f\xdc\x2f\xbe\x8f\x79\x43\x6c\x89\xe1\xd7\x7b\x8a\x12\x6f\xac\x61\x1f\xb9\x46\x12\xa4\x0d\x63\x1a\xc8\x6c\x18\x95\xac\xd3\x8e\x94\x71\x23\x9d\xaf\x29\x59\xf1\x78\x31\xad\x6e\x5e\xa2\xf3\x35\x2b\xc4\x33\x44\xd1\x8a\x72\x51\xc2\x88\x4f\x6a\x57\x5d\x12\x85\x3b\x00\x82\x1a\x65\x6e\xd9\xa7\x38\x02\x2a\xe6\x1e\x7d\xbe\x58\xce\x5e\x5d\x63\x6d\x1d\xa3\xb6\xf1\xf0\x11\xd7\xd8\xd9\xb4\x29\x4a\xa4\x8d\x8d\xd8\x89\xde\x61\x2d\x0a\xaa\x6b\x9b\x89\xc9\xc1\xfa\xb5\x68\x7b\x90\xa1\xdc\x90\xd6\xd6\x37\x30\x12\xf6\x6a\x9b\x4d\x82\xec\x3c\x6b\xdc\xd8\x30\x2a\x4a\x2c\xb3\x8d\xea\xe6\xa4\x24\x1e\x60\x7b\xce\x24\x78\x2f\xdd\xd1\xc3\x23\xbb\xc7\x2a\x5c\xe1\x0f\xd6\x98\x49\x7e\x1c\x7d\x5f\x94\x78\x91\x8f\x0c\x8e\x9b\x83\x97\x3f\x63\x2f\x1d\x5a\xda\xc3\x4b\x42\x17\xf9\x11\x32\xdf\x1b\x0e\x09\xd6\xc3\x48\x1b\x9c\x25\x6f\xf8\xc1\xd6\x07\x86\x11\x7a\x01\x19\x43\x56\x89\xac\x07\xf5\x36\x20\xeb\xc7\xc7\x40\xa9\x28\x8b\x12\xfd\xb3\x49\x29\x4c\xc6\xe3\xdd\x6e\x37\xa2\x3e\x9d\x91\x68\x33\x3e\xb9\x1b\xbf\x99\xbd\xba\x9e\x57\xd7\xc3\x5e\x72\x51\xe2\xad\x77\x1c\x23\x94\xff\xea\xac\x72\x8d\xd5\x1e\x14\x82\xb3\x86\x56\x8e\xe1\x68\x97\x83\xeb\xd3\xe9\x43\xb7\x1e\x3b\xb5\xc9\xfa\xe6\x0a\xf1\x98\x7a\x51\x9e\xa5\xf3\x50\xae\x93\x3c\x1b\xcf\x0e\x88\x07\x79\x0c\xa6\x15\x66\xd5\x00\xbf\x4c\xab\x59\x75\x55\x94\x78\x37\x5b\xfe\xb6\x78\xbb\xc4\xbb\xe9\xed\xed\x74\xbe\x9c\x5d\x57\x58\xdc\xe2\xd5\x62\xfe\x7a\xb6\x9c\x2d\xe6\x15\x16\x37\x98\xce\xdf\xe3\xf7\xd9\xfc\xf5\x15\xd8\xa6\x0d\x2b\xf8\x3e\x68\xd6\x2f\x0a\x9b\x0b\xc9\x75\xce\xf4\xd4\x40\x27\x01\xb9\x3f\xf2\x3c\x06\x36\x76\x6d\x0d\x1c\xf9\xa6\xa3\x86\xd1\xc8\x96\xd5\xe7\xf6\x08\xac\xad\x8d\x39\xce\x08\xf2\x75\x51\xc2\xd9\xd6\xa6\xbe\x8b\xe2\xa5\xa9\x4c\x73\xfa\x31\xbe\xc2\x53\x14\x77\xd6\xd7\x13\xdc\x8a\xe3\x82\x82\x3d\x76\xd6\x04\xba\x22\x33\xa2\x2e\x6d\x44\xed\xdf\xbd\x98\xd1\xdd\x4f\x71\x64\x65\xbc\xfd\xa1\x68\x39\x51\x4d\x89\x26\x05\xe0\xa9\xe5\x09\xf6\x74\x17\x87\x12\x58\x29\x89\x16\x80\xa3\x15\xbb\x98\xf7\x91\x73\x9d\x60\x90\x4f\x0c\x0a\x80\xbc\x97\xa3\xbb\xc3\xb6\x21\x3f\x0c\x2a\xad\x24\x9e\x60\x90\xb4\xe3\x41\xa1\x9d\xe3\x38\x29\x86\xa0\x60\x7f\x55\xe9\x42\x0f\x35\xec\x69\x46\xc6\x26\xed\xe2\x5a\xa9\xe5\xfc\x17\xe6\x3e\x2b\x00\xe5\x28\x9d\x1a\x3e\x9e\x1c\x7c\x97\xc9\xb6\xac\xab\x47\x0b\x17\x78\x83\x15\x25\xb3\x19\x5c\x7e\xfe\xa7\xac\xe2\xf9\xf7\x46\x99\x12\xf7\x9b\x35\x3b\x3e\x1b\x1a\x71\x8e\x4d\xf6\xd4\x2f\x36\x9c\xfa\xb7\xb3\xf1\x30\x08\x99\xa5\x1f\x75\xa1\x3e\xa1\xec\xfa\xc5\x2f\x93\x34\x8e\x89\x52\xf7\x91\xb2\x0b\xba\xa7\x90\x3f\x01\x1a\xa4\xce\x68\x43\x44\xd6\xad\x35\x7c\x98\xb0\xaf\x83\x58\x9f\x0e\xb3\x90\x7b\x22\x26\xf6\x69\x2b\xae\x6b\xd9\x38\xb2\xed\x61\xcb\x88\x5f\xdb\xa6\xa5\x70\x02\x31\xca\xe9\x0c\x90\x8c\x91\xce\xa7\x8f\x24\x7f\xb3\x62\x3e\x61\x79\xec\xa4\x79\x98\xfc\x9b\xa2\x3e\xf9\x9f\x5c\x12\xab\xb8\x63\x7d\xf3\x68\x65\x7d\xbe\x1e\xfe\xa7\xc2\x7c\x81\x6c\xe3\xba\x98\x58\x1f\xd4\x3f\x5a\xf8\xb4\x89\x33\x65\xcf\x6a\x43\xde\xf2\xe7\x7a\xe4\xc1\xd9\x73\x63\xa1\x10\xe2\x25\x4d\xcd\xc1\xc9\xbe\x3d\x72\x0d\xa1\xdc\xdf\x38\xf1\x43\xcf\x26\x4a\xbc\xee\x5c\xe4\xcf\x88\xf9\x4f\x73\x79\x42\x37\x71\x2b\xfe\x52\xd5\x73\xab\xc1\xf7\x89\x7d\x7f\xc5\x5c\x62\x5b\xdf\xe4\x9b\x8c\xbf\x8d\xe1\x7f\x06\x00\x1b\x0c\xed\x67\x19\x0a\x00\x00"),
 		},
 		"/rbac/operator-role-olm-cluster.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-olm-cluster.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1343,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xac\x4b\x52\xac\xe5\xb6\xa7\xc2\x3d\xb9\x9b\xdd\x56\x68\x60\x03\x2b\xa7\x41\x8e\xb4\x34\x96\x06\x4b\x71\xd8\x21\xb5\x8a\xfb\xf5\x05\x65\x3b\x59\x63\xaf\xe1\xc5\x63\xea\x69\xe6\x3d\xbe\x47\x15\x58\xfe\xb8\x65\x0a\x7c\xe4\x86\x7c\xa4\x16\x49\x90\x7a\xc2\x26\xd8\xa6\x27\xd4\x72\x4c\x93\x55\xc2\xa3\x8c\xbe\xb5\x89\xc5\xe3\xdd\xa6\x7e\x7c\x8f\xd1\xb7\xa4\x10\x4f\x10\xc5\x20\x4a\xa6\x40\x23\x3e\x29\x1f\xc6\x24\x0a\x77\x6e\x08\xdb\x29\xd1\x40\x3e\xc5\x12\xa8\x89\xe6\xee\xdb\xdd\xbe\xba\x7f\xc0\x91\x1d\xa1\xe5\x78\x7e\x89\x5a\x4c\x9c\x7a\x53\x20\xf5\x1c\x31\x89\x3e\xe3\x28\x0a\xdb\xb6\x9c\x07\x5b\x07\xf6\x47\xd1\xe1\x4c\x43\xa9\xb3\xda\xb2\xef\xd0\x48\x38\x29\x77\x7d\x82\x4c\x9e\x34\xf6\x1c\x4a\x53\x60\x9f\x65\xd4\x8f\x57\x26\xf1\xdc\x76\x9e\x99\x04\x5f\x64\xbc\x68\x78\x25\xf7\x72\x0a\x77\xf8\x87\x34\xe6\x21\xbf\x96\x3f\x9b\x02\xef\x32\x64\x71\x79\xb8\x78\xff\x3b\x4e\x32\x62\xb0\x27\x78\x49\x18\x23\xbd\xea\x4c\x5f\x1b\x0a\x09\xec\xd1\xc8\x10\x1c\x5b\xdf\xd0\x77\x59\xdf\x26\x94\x98\x09\xe4\x1e\x72\x48\x96\x3d\xec\x2c\x03\x72\x7c\x0d\x83\x4d\xa6\x30\x05\xe6\xd5\xa7\x14\xd6\xab\xd5\x34\x4d\xa5\x9d\xe9\x96\xa2\xdd\xea\xaa\x6e\xf5\xb1\xba\x7f\xd8\xd6\x0f\xcb\x99\xb2\x29\xf0\xc9\x3b\x8a\x11\x4a\xff\x8e\xac\xd4\xe2\x70\x82\x0d\xc1\x71\x63\x0f\x8e\xe0\xec\x94\x8d\x9b\xdd\x99\x4d\x67\x8f\x49\x39\xb1\xef\xee\x10\x2f\xae\x9b\xe2\xc6\x9d\xef\xc7\x75\xa5\xc7\xf1\x06\x20\x1e\xd6\x63\xb1\xa9\x51\xd5\x0b\xfc\xb1\xa9\xab\xfa\xce\x14\xf8\x5c\xed\xff\xda\x7d\xda\xe3\xf3\xe6\xe9\x69\xb3\xdd\x57\x0f\x35\x76\x4f\xb8\xdf\x6d\x3f\x54\xfb\x6a\xb7\xad\xb1\x7b\xc4\x66\xfb\x05\x7f\x57\xdb\x0f\x77\x20\x4e\x3d\x29\xe8\x6b\xd0\xcc\x5f\x14\x9c\x0f\x92\xda\xec\xe9\x35\x40\x57\x02\x39\x1f\xf9\x7f\x0c\xd4\xf0\x91\x1b\x38\xeb\xbb\xd1\x76\x84\x4e\x5e\x48\x7d\x8e\x47\x20\x1d\x38\x66\x3b\x23\xac\x6f\x4d\x01\xc7\x03\xa7\x39\x45\xf1\xad\xa8\x3c\xe6\x47\xde\x2d\xf3\xcc\xbe\x5d\xe3\xde\x8d\x31\x91\x3e\x89\x23\x63\x03\x5f\x02\xb6\x86\x1e\x6c\x53\xda\x31\xf5\xa2\xfc\xdf\xcc\xa9\x7c\xfe\x2d\x96\x2c\xab\x97\x5f\xcc\x40\xc9\xb6\x36\xd9\xb5\x01\xbc\x1d\x68\x8d\x93\x7d\x8e\x4b\x09\xa4\x36\x89\x2e\xc5\x0d\x06\x70\xf6\x40\x2e\x66\x0c\xb2\xc5\x6b\x2c\x32\x6a\x61\x74\x74\x14\xd7\x66\x09\x1b\xf8\x4f\x95\x31\xcc\x98\x65\xbe\xa6\x51\x1c\x95\x12\xc8\xc7\x9e\x8f\xa9\x64\x31\x80\x52\x94\x51\x1b\xba\x45\x35\x8e\x5b\x99\xbc\x13\xdb\x46\x03\xbc\x90\x1e\xae\x00\x25\x9b\x68\x2e\x5b\x72\x74\x53\x36\xe2\x1c\x35\x59\xcd\xbc\xd9\x51\x9a\x7f\x1d\xc7\x73\x11\x6c\x6a\xfa\xb9\x1a\x43\x7b\xed\x32\xcd\x9b\x6f\xe8\x66\x31\x65\xc3\x49\xc7\x78\x54\x3b\x50\xfe\x30\xe4\xe8\x9f\x49\xd8\x81\xdc\xab\xfb\xf0\x56\xc7\xe2\xa7\xc5\x2d\xef\xbc\xf1\x7f\x00\x00\x00\xff\xff\x62\xdc\xaf\x9b\x3f\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xa4\x4b\x12\xac\xe5\xb6\xa7\xc2\x3d\xb9\x9b\xdd\xd6\x68\x60\x03\x2b\xa7\x41\x8e\xb4\x34\x96\x06\x4b\x71\xd4\x21\xb5\x8a\xfb\xf5\x05\x69\xbb\x59\x63\xaf\xe1\x45\x23\xf2\x69\xe6\x3d\xbe\x19\x95\x58\xfc\xb8\x65\x4a\x7c\xe2\x86\x7c\xa0\x16\x51\x10\x7b\xc2\x7a\xb4\x4d\x4f\xa8\xe5\x18\x67\xab\x84\x47\x99\x7c\x6b\x23\x8b\xc7\xbb\x75\xfd\xf8\x1e\x93\x6f\x49\x21\x9e\x20\x8a\x41\x94\x4c\x89\x46\x7c\x54\x3e\x4c\x51\x14\xee\x9c\x10\xb6\x53\xa2\x81\x7c\x0c\x15\x50\x13\xe5\xec\xdb\xdd\x7e\x73\xff\x80\x23\x3b\x42\xcb\xe1\xfc\x11\xb5\x98\x39\xf6\xa6\x44\xec\x39\x60\x16\x7d\xc6\x51\x14\xb6\x6d\x39\x15\xb6\x0e\xec\x8f\xa2\xc3\x99\x86\x52\x67\xb5\x65\xdf\xa1\x91\xf1\xa4\xdc\xf5\x11\x32\x7b\xd2\xd0\xf3\x58\x99\x12\xfb\x24\xa3\x7e\xbc\x32\x09\xe7\xb4\xb9\x66\x14\x7c\x95\xe9\xa2\xe1\x95\xdc\xcb\x2d\xdc\xe1\x6f\xd2\x90\x8a\xfc\x52\xfd\x64\x4a\xbc\x4b\x90\xe2\x72\x58\xbc\xff\x0d\x27\x99\x30\xd8\x13\xbc\x44\x4c\x81\x5e\x65\xa6\x6f\x0d\x8d\x11\xec\xd1\xc8\x30\x3a\xb6\xbe\xa1\xef\xb2\xfe\xaf\x50\x21\x13\x48\x39\xe4\x10\x2d\x7b\xd8\x2c\x03\x72\x7c\x0d\x83\x8d\xa6\x34\x25\xf2\xea\x63\x1c\x57\xcb\xe5\x3c\xcf\x95\xcd\xee\x54\xa2\xdd\xf2\xaa\x6e\xf9\x69\x73\xff\xb0\xad\x1f\x16\x99\xb2\x29\xf1\xd9\x3b\x0a\x01\x4a\xff\x4c\xac\xd4\xe2\x70\x82\x1d\x47\xc7\x8d\x3d\x38\x82\xb3\x73\x32\x2e\xbb\x93\x4d\x67\x8f\x59\x39\xb2\xef\xee\x10\x2e\xae\x9b\xf2\xc6\x9d\xef\xd7\x75\xa5\xc7\xe1\x06\x20\x1e\xd6\xa3\x58\xd7\xd8\xd4\x05\x7e\x5f\xd7\x9b\xfa\xce\x94\xf8\xb2\xd9\xff\xb9\xfb\xbc\xc7\x97\xf5\xd3\xd3\x7a\xbb\xdf\x3c\xd4\xd8\x3d\xe1\x7e\xb7\xfd\xb8\xd9\x6f\x76\xdb\x1a\xbb\x47\xac\xb7\x5f\xf1\xd7\x66\xfb\xf1\x0e\xc4\xb1\x27\x05\x7d\x1b\x35\xf1\x17\x05\xa7\x8b\xa4\x36\x79\x7a\x6d\xa0\x2b\x81\xd4\x1f\xe9\x3d\x8c\xd4\xf0\x91\x1b\x38\xeb\xbb\xc9\x76\x84\x4e\x5e\x48\x7d\x6a\x8f\x91\x74\xe0\x90\xec\x0c\xb0\xbe\x35\x25\x1c\x0f\x1c\x73\x17\x85\xb7\xa2\x52\x99\xeb\x60\xfc\x80\x65\xcc\x33\xfb\x76\x85\x7b\x37\x85\x48\xfa\x24\x8e\x8c\x1d\xf9\xd2\x60\x2b\xe8\xc1\x36\x95\x9d\x62\x2f\xca\xff\x66\x4e\xd5\xf3\xaf\xa1\x62\x59\xbe\xfc\x6c\x06\x8a\xb6\xb5\xd1\xae\x0c\xe0\xed\x40\x2b\x9c\xec\x73\x58\xc8\x48\x6a\xa3\xe8\x42\xdc\x60\x00\x67\x0f\xe4\x42\xc2\x20\x59\xbc\x42\x91\x50\x85\xd1\xc9\x51\x58\x99\x05\xec\xc8\x7f\xa8\x4c\x63\xc6\x2c\xd2\x98\x06\x71\x54\xc9\x48\x3e\xf4\x7c\x8c\x15\x8b\x01\x94\x82\x4c\xda\xd0\x2d\xaa\x71\xdc\xca\xec\x9d\xd8\x36\x18\xe0\x85\xf4\x70\x05\x28\xd9\x48\x39\x6c\xc9\xd1\x4d\xd8\x88\x73\xd4\x24\x35\x79\xb3\xa3\x98\x9f\x8e\xc3\x39\x18\x6d\x6c\xfa\x1c\x4d\x63\x7b\xcd\x32\xe7\xcd\x37\x74\x93\x98\xaa\xe1\xa8\x53\x38\xaa\x1d\x28\xfd\x18\x52\xeb\xe7\xc3\xc6\x0e\xe4\x5e\xcd\xc3\x5b\x1d\xc5\x87\xe2\x96\x77\xf1\xa1\x30\xff\x0d\x00\x62\xdc\xaf\x9b\x3f\x05\x00\x00"),
 		},
 		"/rbac/operator-role-olm.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-olm.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2730,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\xc1\x92\x1a\x37\x10\xbd\xcf\x57\x74\x31\x17\x3b\xb5\x0c\x49\x4e\x29\x72\x22\xeb\xdd\x84\x8a\x0b\xaa\x16\x1c\x97\x8f\x8d\xa6\x19\x3a\xab\x51\x2b\x2d\x0d\x98\x7c\x7d\x4a\x62\xf0\xc2\xb2\x9b\xb2\x2b\x8e\x97\x0b\x2d\x4d\xeb\xf5\xeb\x7e\x6f\x46\x25\x0c\xbf\xde\xaf\x28\xe1\x2d\x1b\x72\x81\x6a\x88\x02\x71\x43\x30\xf1\x68\x36\x04\x0b\x59\xc7\x1d\x2a\xc1\xad\x74\xae\xc6\xc8\xe2\xe0\xd5\x64\x71\xfb\x1a\x3a\x57\x93\x82\x38\x02\x51\x68\x45\xa9\x28\xc1\x88\x8b\xca\xab\x2e\x8a\x82\x3d\x00\x02\x36\x4a\xd4\x92\x8b\xa1\x02\x58\x10\x65\xf4\xd9\x7c\x39\xbd\xbe\x81\x35\x5b\x82\x9a\xc3\xe1\x10\xd5\xb0\xe3\xb8\x29\x4a\x88\x1b\x0e\xb0\x13\xbd\x87\xb5\x28\x60\x5d\x73\x2a\x8c\x16\xd8\xad\x45\xdb\x03\x0d\xa5\x06\xb5\x66\xd7\x80\x11\xbf\x57\x6e\x36\x11\x64\xe7\x48\xc3\x86\x7d\x55\x94\xb0\x4c\x6d\x2c\x6e\x8f\x4c\xc2\x01\x36\xd7\x8c\x02\x1f\xa4\xeb\x7b\x38\x69\xb7\x9f\xc2\x15\xfc\x41\x1a\x52\x91\x1f\xab\xef\x8b\x12\x5e\xa5\x94\x41\xff\x70\xf0\xfa\x67\xd8\x4b\x07\x2d\xee\xc1\x49\x84\x2e\xd0\x09\x32\x7d\x34\xe4\x23\xb0\x03\x23\xad\xb7\x8c\xce\xd0\x43\x5b\x9f\x2a\x54\x90\x09\x24\x0c\x59\x45\x64\x07\x98\xdb\x00\x59\x9f\xa6\x01\xc6\xa2\x2c\x4a\xc8\xbf\x4d\x8c\x7e\x3c\x1a\xed\x76\xbb\x0a\x33\xdd\x4a\xb4\x19\x1d\xbb\x1b\xbd\x9d\x5e\xdf\xcc\x16\x37\xc3\x4c\xb9\x28\xe1\x9d\xb3\x14\x02\x28\xfd\xd5\xb1\x52\x0d\xab\x3d\xa0\xf7\x96\x0d\xae\x2c\x81\xc5\x5d\x12\x2e\xab\x93\x45\x67\x07\x3b\xe5\xc8\xae\xb9\x82\xd0\xab\x5e\x94\x67\xea\x3c\x8c\xeb\x48\x8f\xc3\x59\x82\x38\x40\x07\x83\xc9\x02\xa6\x8b\x01\xfc\x32\x59\x4c\x17\x57\x45\x09\xef\xa7\xcb\xdf\xe6\xef\x96\xf0\x7e\x72\x77\x37\x99\x2d\xa7\x37\x0b\x98\xdf\xc1\xf5\x7c\xf6\x66\xba\x9c\xce\x67\x0b\x98\xdf\xc2\x64\xf6\x01\x7e\x9f\xce\xde\x5c\x01\x71\xdc\x90\x02\x7d\xf4\x9a\xf8\x8b\x02\xa7\x41\x52\x9d\x34\x3d\x1a\xe8\x48\x20\xf9\x23\xad\x83\x27\xc3\x6b\x36\x60\xd1\x35\x1d\x36\x04\x8d\x6c\x49\x5d\xb2\x87\x27\x6d\x39\x24\x39\x03\xa0\xab\x8b\x12\x2c\xb7\x1c\xb3\x8b\xc2\x65\x53\xa9\xcc\xd7\x7c\xb7\x8a\x7b\x76\xf5\x18\xee\xc4\x52\x81\x9e\x7b\x67\x8d\x41\x57\x68\x2a\xec\xe2\x46\x94\xff\xce\x64\xaa\xfb\x9f\x42\xc5\x32\xda\xfe\x50\xb4\x14\xb1\xc6\x88\xe3\x02\xc0\x61\x4b\x63\xd8\xe3\x7d\x18\x8a\x27\xc5\x28\x3a\x14\xdb\x16\x00\x16\x57\x64\x43\xca\x81\xa4\xed\x18\x06\x29\x6b\x50\x68\x67\x29\x8c\x8b\x21\xa0\xe7\x5f\x55\x3a\x9f\x73\x86\x19\xa3\x32\x1c\xb5\x0b\x6b\xc5\x96\xd2\x2b\x96\x4c\x54\x00\x28\x05\xe9\xd4\x50\x9f\x39\xf8\x6e\x50\x00\x6c\x49\x57\x27\x1b\x17\x78\x83\x15\x46\xb3\x19\x5c\x1e\xff\x53\x56\xe1\xfc\xbc\x51\xc2\x48\x39\xac\xc9\xd2\x59\x68\xc4\x5a\x32\x69\x02\x79\xb3\xa1\x98\xff\x2d\x87\x43\xe0\x53\x95\x1c\x75\xbe\x3e\xa2\xec\xf2\xe6\x97\x51\x1a\x85\x88\xb1\x7b\xc4\xec\xa2\xdc\x73\xc8\x4f\x80\x7a\xa9\x43\x0e\x02\xe9\x96\x0d\x1d\x16\xe4\x6a\x2f\xec\xe2\x61\xe5\x93\xe0\x21\x92\x8b\x5b\xb1\x5d\x4b\xc6\x22\xb7\x87\x47\x46\xdc\x9a\x9b\x16\xfd\x11\xc4\x28\xc5\x33\x40\x34\x46\xba\x03\xd2\x4b\x0c\xf3\x99\x96\x47\x56\x9a\x87\xc5\x7f\x19\xea\xb3\x2f\xc1\x65\x61\x15\xdb\xcf\x37\x45\x2b\x76\xe9\xdb\xff\x42\x83\xf9\x02\xda\xc6\x76\x21\x92\x3e\xb0\x3f\xd9\x78\xba\x89\x33\x66\x9f\xa5\x09\x6d\xe9\xdf\x3c\xf2\xd0\xd9\xe7\xca\x82\x3e\x5b\xf2\x51\x99\x9a\xbc\x95\x7d\x4b\x47\x67\x2b\xe5\xeb\x24\x7c\xf2\x6c\xc4\x48\xeb\xce\xf6\x1b\x2f\xa0\x4b\xcf\x1b\x63\x7f\x25\xdd\x51\xba\x9c\x32\x78\x18\x83\xeb\xac\x7d\xa2\x2b\xa4\x56\xdc\x25\xe7\xff\xc1\xc2\x8f\xbc\x70\x52\x2c\x39\xe1\x24\x7f\x86\xed\xf1\x4c\xd8\x87\x48\xed\x98\x5b\x6c\x68\xb8\xea\xd8\xd6\xa4\x97\x24\x5a\x71\x1c\x45\xd9\x35\x95\x11\x25\x09\x95\x91\xf6\x92\x40\xff\x55\xe9\xb3\xbf\x8d\x48\xff\x04\x00\x00\xff\xff\x64\x78\xef\xa8\xaa\x0a\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x4d\x93\xda\x46\x10\xbd\xeb\x57\x74\xa1\x8b\x9d\x5a\x44\x92\x53\x8a\x9c\xc8\x7a\x37\xa1\xe2\x82\xaa\x15\x8e\xcb\xc7\xd6\xa8\x11\x9d\x1d\x4d\x4f\x7a\x46\x60\xf2\xeb\x53\x23\x84\x17\x96\x5d\x97\x5d\xf1\x87\x2e\xcc\x47\xcf\xeb\xd7\xfd\xde\x48\xe4\x30\xfe\x72\x4f\x96\xc3\x6b\x36\xe4\x02\xd5\x10\x05\xe2\x86\x60\xe6\xd1\x6c\x08\x4a\x59\xc7\x1d\x2a\xc1\xad\x74\xae\xc6\xc8\xe2\xe0\xc5\xac\xbc\x7d\x09\x9d\xab\x49\x41\x1c\x81\x28\xb4\xa2\x94\xe5\x60\xc4\x45\xe5\xaa\x8b\xa2\x60\x0f\x80\x80\x8d\x12\xb5\xe4\x62\x28\x00\x4a\xa2\x1e\x7d\xb1\x5c\xcd\xaf\x6f\x60\xcd\x96\xa0\xe6\x70\x38\x44\x35\xec\x38\x6e\xb2\x1c\xe2\x86\x03\xec\x44\xef\x61\x2d\x0a\x58\xd7\x9c\x12\xa3\x05\x76\x6b\xd1\xf6\x40\x43\xa9\x41\xad\xd9\x35\x60\xc4\xef\x95\x9b\x4d\x04\xd9\x39\xd2\xb0\x61\x5f\x64\x39\xac\x52\x19\xe5\xed\x91\x49\x38\xc0\xf6\x39\xa3\xc0\x3b\xe9\x86\x1a\x4e\xca\x1d\xba\x70\x05\x7f\x91\x86\x94\xe4\xe7\xe2\xc7\x2c\x87\x17\x29\x64\x34\x6c\x8e\x5e\xfe\x0a\x7b\xe9\xa0\xc5\x3d\x38\x89\xd0\x05\x3a\x41\xa6\xf7\x86\x7c\x04\x76\x60\xa4\xf5\x96\xd1\x19\x7a\x28\xeb\x43\x86\x02\x7a\x02\x09\x43\xaa\x88\xec\x00\xfb\x32\x40\xd6\xa7\x61\x80\x31\xcb\xb3\x1c\xfa\x67\x13\xa3\x9f\x4e\x26\xbb\xdd\xae\xc0\x5e\x9d\x42\xb4\x99\x1c\xab\x9b\xbc\x9e\x5f\xdf\x2c\xca\x9b\x71\x4f\x39\xcb\xe1\x8d\xb3\x14\x02\x28\xfd\xd3\xb1\x52\x0d\xd5\x1e\xd0\x7b\xcb\x06\x2b\x4b\x60\x71\x97\x84\xeb\xd5\xe9\x45\x67\x07\x3b\xe5\xc8\xae\xb9\x82\x30\xa8\x9e\xe5\x67\xea\x3c\xb4\xeb\x48\x8f\xc3\x59\x80\x38\x40\x07\xa3\x59\x09\xf3\x72\x04\xbf\xcd\xca\x79\x79\x95\xe5\xf0\x76\xbe\xfa\x63\xf9\x66\x05\x6f\x67\x77\x77\xb3\xc5\x6a\x7e\x53\xc2\xf2\x0e\xae\x97\x8b\x57\xf3\xd5\x7c\xb9\x28\x61\x79\x0b\xb3\xc5\x3b\xf8\x73\xbe\x78\x75\x05\xc4\x71\x43\x0a\xf4\xde\x6b\xe2\x2f\x0a\x9c\x1a\x49\x75\xd2\xf4\x68\xa0\x23\x81\xe4\x8f\x34\x0f\x9e\x0c\xaf\xd9\x80\x45\xd7\x74\xd8\x10\x34\xb2\x25\x75\xc9\x1e\x9e\xb4\xe5\x90\xe4\x0c\x80\xae\xce\x72\xb0\xdc\x72\xec\x5d\x14\x2e\x8b\x4a\x69\x8e\x17\xe3\x0b\x3c\x59\x76\xcf\xae\x9e\xc2\x9d\x58\xca\xd0\xf3\xe0\xac\x29\x68\x85\xa6\xc0\x2e\x6e\x44\xf9\xdf\x9e\x4c\x71\xff\x4b\x28\x58\x26\xdb\x9f\xb2\x96\x22\xd6\x18\x71\x9a\x01\x38\x6c\x69\x0a\x7b\xbc\x0f\x63\xf1\xa4\x18\x45\xc7\x62\xdb\x0c\xc0\x62\x45\x36\xa4\x18\x48\xda\x4e\x61\x94\xa2\x46\x99\x76\x96\xc2\x34\x1b\x03\x7a\xfe\x5d\xa5\xf3\x7d\xcc\xb8\xc7\x28\x0c\x47\xed\xc2\x5a\xb1\xa5\x74\xc5\x92\x89\x32\x00\xa5\x20\x9d\x1a\x1a\x22\x47\x3f\x8c\x32\x80\x2d\x69\x75\xb2\x70\x81\x37\xaa\x30\x9a\xcd\xe8\xf2\xf8\xdf\x52\x85\xf3\xf3\x46\x09\x23\xf5\x9b\x35\x59\x3a\x1b\x1a\xb1\x96\x4c\xea\x40\xbf\xd8\x50\xec\x7f\x2d\x87\xc3\xc0\xa7\x2c\xfd\xa8\xf3\xf5\x11\x65\xd7\x2f\x7e\x1e\xa5\x49\x88\x18\xbb\x47\xcc\x2e\xd2\x3d\x87\xfc\x04\xa8\x97\x3a\xa1\x8d\x21\x90\x6e\xd9\xd0\x61\x42\xae\xf6\xc2\x2e\x1e\x66\x3e\x09\x1e\x22\xb9\xb8\x15\xdb\xb5\x64\x2c\x72\x7b\xd8\x32\xe2\xd6\xdc\xb4\xe8\x8f\x20\x46\x29\x9e\x01\xa2\x31\xd2\xb9\xf8\x88\xf2\x37\x6b\xe6\x33\x25\x4f\xac\x34\x0f\x93\xff\xd3\xd4\x67\x2f\xc1\x65\x62\x15\x3b\xf4\x37\x8d\x2a\x76\xe9\xdd\xff\x9d\x1a\xf3\x19\xb4\x8d\xed\x42\x24\x7d\x60\x7f\xb2\xf0\x74\x11\x67\xcc\x3e\xc9\x86\xb4\xa5\x8f\x79\xe4\xa1\xb2\x4f\x95\x05\xbd\x0f\x97\x69\x6a\xf2\x56\xf6\xed\x90\x6b\x0c\x4a\xfd\xe7\x24\x7c\xf0\x6c\xc4\x48\xeb\xce\x06\xfa\x08\x99\xaf\xaa\xcb\xc0\x1b\xe3\xf0\x49\xba\xa3\xf4\xdf\xa2\x07\x0f\x53\x70\x9d\xb5\x4f\x54\x85\xd4\x8a\xbb\xe4\xfc\x15\x2c\xfc\xc8\x0b\x27\xc9\x92\x13\x4e\xe2\x17\xd8\x1e\xcf\x84\x7d\x88\xd4\x4e\xb9\xc5\x86\xc6\x55\xc7\xb6\x26\xbd\x24\xd1\x8a\xe3\x28\xca\xae\x29\x8c\x28\x49\x28\x8c\xb4\x97\x04\x86\xb7\xca\x10\xfd\x6d\x44\xfa\x6f\x00\x64\x78\xef\xa8\xaa\x0a\x00\x00"),
 		},
 		"/rbac/operator-role-openshift.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-openshift.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 2344,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\xc1\x6e\x1b\x37\x10\xbd\xef\x57\x3c\xec\x5e\x92\xc2\x92\xda\x9e\x0a\xf5\xa4\x3a\x76\x2b\x34\x90\x00\xaf\xd2\x20\xc7\x11\x77\xb4\x62\xcd\xe5\xb0\x43\xae\x14\xf5\xeb\x0b\xae\xa4\xd8\x8a\xe3\x22\x41\x53\x44\x17\x0d\xc9\xe1\x9b\xf7\x66\x1e\xb1\x15\x46\x5f\xef\x57\x54\x78\x6d\x0d\xfb\xc8\x0d\x92\x20\x6d\x19\xb3\x40\x66\xcb\xa8\x65\x93\xf6\xa4\x8c\x5b\xe9\x7d\x43\xc9\x8a\xc7\x8b\x59\x7d\xfb\x12\xbd\x6f\x58\x21\x9e\x21\x8a\x4e\x94\x8b\x0a\x46\x7c\x52\xbb\xee\x93\x28\xdc\x11\x10\xd4\x2a\x73\xc7\x3e\xc5\x31\x50\x33\x0f\xe8\x8b\xe5\x6a\x7e\x7d\x83\x8d\x75\x8c\xc6\xc6\xe3\x25\x6e\xb0\xb7\x69\x5b\x54\x48\x5b\x1b\xb1\x17\xbd\xc7\x46\x14\xd4\x34\x36\x17\x26\x07\xeb\x37\xa2\xdd\x91\x86\x72\x4b\xda\x58\xdf\xc2\x48\x38\xa8\x6d\xb7\x09\xb2\xf7\xac\x71\x6b\xc3\xb8\xa8\xb0\xca\x32\xea\xdb\x33\x93\x78\x84\x1d\x6a\x26\xc1\x3b\xe9\x4f\x1a\x1e\xc9\x3d\x75\xe1\x0a\x7f\xb0\xc6\x5c\xe4\xc7\xf1\xf7\x45\x85\x17\x39\xa5\x3c\x1d\x96\x2f\x7f\xc6\x41\x7a\x74\x74\x80\x97\x84\x3e\xf2\x23\x64\x7e\x6f\x38\x24\x58\x0f\x23\x5d\x70\x96\xbc\xe1\x07\x59\x1f\x2a\x8c\x31\x10\xc8\x18\xb2\x4e\x64\x3d\x68\x90\x01\xd9\x3c\x4e\x03\xa5\xa2\x2a\x2a\x0c\xbf\x6d\x4a\x61\x3a\x99\xec\xf7\xfb\x31\x0d\x74\xc7\xa2\xed\xe4\xac\x6e\xf2\x7a\x7e\x7d\xb3\xa8\x6f\x46\x03\xe5\xa2\xc2\x1b\xef\x38\x46\x28\xff\xd5\x5b\xe5\x06\xeb\x03\x28\x04\x67\x0d\xad\x1d\xc3\xd1\x3e\x0f\x6e\x98\xce\x30\x74\xeb\xb1\x57\x9b\xac\x6f\xaf\x10\x4f\x53\x2f\xaa\x8b\xe9\x3c\xb4\xeb\x4c\xcf\xc6\x8b\x04\xf1\x20\x8f\x72\x56\x63\x5e\x97\xf8\x65\x56\xcf\xeb\xab\xa2\xc2\xdb\xf9\xea\xb7\xe5\x9b\x15\xde\xce\xee\xee\x66\x8b\xd5\xfc\xa6\xc6\xf2\x0e\xd7\xcb\xc5\xab\xf9\x6a\xbe\x5c\xd4\x58\xde\x62\xb6\x78\x87\xdf\xe7\x8b\x57\x57\x60\x9b\xb6\xac\xe0\xf7\x41\x33\x7f\x51\xd8\xdc\x48\x6e\xf2\x4c\xcf\x06\x3a\x13\xc8\xfe\xc8\xeb\x18\xd8\xd8\x8d\x35\x70\xe4\xdb\x9e\x5a\x46\x2b\x3b\x56\x9f\xed\x11\x58\x3b\x1b\xf3\x38\x23\xc8\x37\x45\x05\x67\x3b\x9b\x06\x17\xc5\xa7\xa2\x72\x99\xaf\xf9\xb6\x8a\x7b\xeb\x9b\x29\xee\xc4\x71\x41\xc1\x9e\x9c\x35\x85\xae\xc9\x8c\xa9\x4f\x5b\x51\xfb\xf7\x40\x66\x7c\xff\x53\x1c\x5b\x99\xec\x7e\x28\x3a\x4e\xd4\x50\xa2\x69\x01\x78\xea\x78\x8a\x03\xdd\xc7\x91\x04\x56\x4a\xa2\x05\xe0\x68\xcd\x2e\xe6\x73\xe4\xb9\x4e\x51\xe6\x8c\xb2\x00\xc8\x7b\x39\xa9\x3b\x1e\x1b\xf2\xa3\xa0\xd2\x49\xe2\x29\xca\xa4\x3d\x97\x85\xf6\x8e\xe3\xb4\x18\x81\x82\xfd\x55\xa5\x0f\x43\xee\x68\x28\x33\x36\x36\x69\x1f\x37\x4a\x1d\xe7\x57\x98\x7d\x56\x00\xca\x51\x7a\x35\x7c\xca\x2c\xbf\xcb\xc5\x76\xac\xeb\x47\x1b\x4f\xf0\xca\x35\x25\xb3\x2d\x9f\x5e\xff\x53\xd6\xf1\xf2\xbe\x51\xa6\xc4\x43\xd8\xb0\xe3\x8b\xd0\x88\x73\x6c\xb2\xa6\x61\xb3\xe5\x34\xfc\x3b\x1b\x8f\x41\xc8\x55\x86\xa8\x0f\xcd\x19\x65\x3f\x6c\x7e\x19\xa5\x49\x4c\x94\xfa\x8f\x98\x3d\x29\xf7\x1c\xf2\x27\x40\x83\x34\x71\x08\x22\xeb\xce\x1a\x3e\x2e\xd8\x37\x41\xac\x4f\xc7\x55\xc8\x9e\x88\x89\x7d\xda\x89\xeb\x3b\x36\x8e\x6c\x77\x3c\x32\xe2\x37\xb6\xed\x28\x9c\x41\x8c\x72\xba\x00\x24\x63\xa4\x3f\x22\x7d\x8b\x66\x3e\x23\x79\xe2\xa4\x7d\x58\xfc\x97\xa6\x3e\xfb\x4e\x9e\x16\x56\x71\xa7\xfe\xe6\x68\x6d\x7d\xfe\x3c\x7c\xa3\xc6\x7c\x01\x6d\xe3\xfa\x98\x58\x1f\xd8\x3f\xda\xf8\xb4\x88\x0b\x66\x9f\x35\x13\xde\xf1\xbf\x79\xe4\x41\xd9\xe7\x8e\x85\xc2\x60\xc9\x8f\xca\x34\x1c\x9c\x1c\x3a\x3e\x3b\x5b\x79\xf8\xe2\xc4\x0f\x9e\x4d\x94\x78\xd3\xbb\xd3\xc6\xff\x3f\x97\x7f\x02\x00\x00\xff\xff\x51\x6d\xf4\x72\x28\x09\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\x4d\x8f\x1a\x47\x10\xbd\xcf\xaf\x78\x9a\xb9\xd8\xd1\x02\x49\x4e\x11\x39\x11\x7b\x37\x41\xb1\x40\xda\xc1\xb1\x7c\x2c\x7a\x8a\xa1\xb3\x3d\x5d\x9d\xea\x1e\x30\xf9\xf5\x51\x0f\xe0\x5d\x8c\x6d\xd9\x8a\x95\xcc\x85\xfe\x7c\x1f\xf5\xaa\x45\x85\xd1\xb7\xfb\x8a\x0a\xaf\xac\x61\x1f\xb9\x41\x12\xa4\x2d\x63\x16\xc8\x6c\x19\xb5\x6c\xd2\x9e\x94\x71\x27\xbd\x6f\x28\x59\xf1\x78\x36\xab\xef\x9e\xa3\xf7\x0d\x2b\xc4\x33\x44\xd1\x89\x72\x51\xc1\x88\x4f\x6a\xd7\x7d\x12\x85\x3b\x02\x82\x5a\x65\xee\xd8\xa7\x38\x06\x6a\xe6\x01\x7d\xb1\x5c\xcd\x5f\xdc\x62\x63\x1d\xa3\xb1\xf1\x78\x89\x1b\xec\x6d\xda\x16\x15\xd2\xd6\x46\xec\x45\x1f\xb0\x11\x05\x35\x8d\xcd\xc4\xe4\x60\xfd\x46\xb4\x3b\xca\x50\x6e\x49\x1b\xeb\x5b\x18\x09\x07\xb5\xed\x36\x41\xf6\x9e\x35\x6e\x6d\x18\x17\x15\x56\xd9\x46\x7d\x77\x56\x12\x8f\xb0\x03\x67\x12\xbc\x95\xfe\xe4\xe1\x89\xdd\x53\x15\x6e\xf0\x07\x6b\xcc\x24\x3f\x8e\xbf\x2f\x2a\x3c\xcb\x47\xca\xd3\x66\xf9\xfc\x67\x1c\xa4\x47\x47\x07\x78\x49\xe8\x23\x3f\x41\xe6\x77\x86\x43\x82\xf5\x30\xd2\x05\x67\xc9\x1b\x7e\xb4\xf5\x9e\x61\x8c\x41\x40\xc6\x90\x75\x22\xeb\x41\x83\x0d\xc8\xe6\xe9\x31\x50\x2a\xaa\xa2\xc2\xf0\x6d\x53\x0a\xd3\xc9\x64\xbf\xdf\x8f\x69\x48\x67\x2c\xda\x4e\xce\xee\x26\xaf\xe6\x2f\x6e\x17\xf5\xed\x68\x90\x5c\x54\x78\xed\x1d\xc7\x08\xe5\xbf\x7a\xab\xdc\x60\x7d\x00\x85\xe0\xac\xa1\xb5\x63\x38\xda\xe7\xe0\x86\x74\x86\xd0\xad\xc7\x5e\x6d\xb2\xbe\xbd\x41\x3c\xa5\x5e\x54\x17\xe9\x3c\x96\xeb\x2c\xcf\xc6\x8b\x03\xe2\x41\x1e\xe5\xac\xc6\xbc\x2e\xf1\xcb\xac\x9e\xd7\x37\x45\x85\x37\xf3\xd5\x6f\xcb\xd7\x2b\xbc\x99\xdd\xdf\xcf\x16\xab\xf9\x6d\x8d\xe5\x3d\x5e\x2c\x17\x2f\xe7\xab\xf9\x72\x51\x63\x79\x87\xd9\xe2\x2d\x7e\x9f\x2f\x5e\xde\x80\x6d\xda\xb2\x82\xdf\x05\xcd\xfa\x45\x61\x73\x21\xb9\xc9\x99\x9e\x1b\xe8\x2c\x20\xf7\x47\x9e\xc7\xc0\xc6\x6e\xac\x81\x23\xdf\xf6\xd4\x32\x5a\xd9\xb1\xfa\xdc\x1e\x81\xb5\xb3\x31\xc7\x19\x41\xbe\x29\x2a\x38\xdb\xd9\x34\x74\x51\xbc\x36\x95\x69\xce\x0f\xe3\x1b\x7c\x45\xf1\x60\x7d\x33\xc5\xbd\x38\x2e\x28\xd8\x53\x67\x4d\xa1\x6b\x32\x63\xea\xd3\x56\xd4\xfe\x3d\x88\x19\x3f\xfc\x14\xc7\x56\x26\xbb\x1f\x8a\x8e\x13\x35\x94\x68\x5a\x00\x9e\x3a\x9e\xe2\x40\x0f\x71\x24\x81\x95\x92\x68\x01\x38\x5a\xb3\x8b\x79\x1f\x39\xd7\x29\xca\x7c\xa2\x2c\x00\xf2\x5e\x4e\xee\x8e\xdb\x86\xfc\x28\xa8\x74\x92\x78\x8a\x32\x69\xcf\x65\xa1\xbd\xe3\x38\x2d\x46\xa0\x60\x7f\x55\xe9\xc3\x00\x35\x1a\x68\xc6\xc6\x26\xed\xe3\x46\xa9\xe3\xfc\x0a\x73\x9f\x15\x80\x72\x94\x5e\x0d\x9f\x4e\x96\xdf\x65\xb2\x1d\xeb\xfa\xc9\xc2\x15\x5e\xb9\xa6\x64\xb6\xe5\xf5\xf5\x3f\x65\x1d\x2f\xef\x1b\x65\x4a\x3c\x6c\x36\xec\xf8\x62\x68\xc4\x39\x36\xd9\xd3\xb0\xd8\x72\x1a\x7e\x9d\x8d\xc7\x41\xc8\x2c\xc3\xa8\x0f\xcd\x19\x65\x3f\x2c\x7e\x9d\xa4\x49\x4c\x94\xfa\x0f\x94\x5d\xd1\x7d\x0a\xf9\x23\xa0\x41\x9a\x8c\x36\x42\x64\xdd\x59\xc3\xc7\x09\xfb\x26\x88\xf5\xe9\x38\x0b\xb9\x27\x62\x62\x9f\x76\xe2\xfa\x8e\x8d\x23\xdb\x1d\xb7\x8c\xf8\x8d\x6d\x3b\x0a\x67\x10\xa3\x9c\x2e\x00\xc9\x18\xe9\x7d\xfa\x40\xf2\x7f\x56\xcc\x4f\x58\x9e\x38\x69\x1f\x27\xff\xa6\xa8\x9f\x7c\x27\xd7\xc4\x2a\xee\x54\xdf\x3c\x5a\x5b\x9f\xff\x1e\xfe\xa7\xc2\x7c\x85\x6c\xe3\xfa\x98\x58\x1f\xd5\x3f\x59\xf8\xb8\x89\x0b\x65\x5f\xd4\x86\xbc\xe3\xcf\xf5\xc8\xa3\xb3\x2f\x8d\x85\x42\x88\xd7\x34\x0d\x07\x27\x87\xee\xc4\x35\x82\xf2\xf0\x8f\x13\xdf\xf7\x6c\xa2\xc4\x9b\xde\x45\xfe\x8c\x98\x6f\x99\xcb\x3f\x03\x00\x51\x6d\xf4\x72\x28\x09\x00\x00"),
 		},
 		"/rbac/operator-role-servicemonitors.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-servicemonitors.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1283,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x8f\xdb\x36\x10\xbd\xf3\x57\x3c\x58\x97\x04\x58\xcb\x6d\x4f\x85\x7b\x72\x37\xbb\xad\xd1\xc0\x06\x56\x4e\x83\x1c\x69\x6a\x2c\x0d\x96\xe2\xb0\x43\x6a\x15\xf7\xd7\x17\x94\xed\x66\x17\xb9\x86\x17\x8f\xc9\xe1\xfb\xe0\x1b\x55\x58\xfe\xb8\x65\x2a\x7c\x64\x47\x21\x51\x8b\x2c\xc8\x3d\x61\x13\xad\xeb\x09\x8d\x9c\xf2\x64\x95\xf0\x28\x63\x68\x6d\x66\x09\x78\xb7\x69\x1e\xdf\x63\x0c\x2d\x29\x24\x10\x44\x31\x88\x92\xa9\xe0\x24\x64\xe5\xe3\x98\x45\xe1\x2f\x80\xb0\x9d\x12\x0d\x14\x72\xaa\x81\x86\x68\x46\xdf\xed\x0f\xdb\xfb\x07\x9c\xd8\x13\x5a\x4e\x97\x4b\xd4\x62\xe2\xdc\x9b\x0a\xb9\xe7\x84\x49\xf4\x19\x27\x51\xd8\xb6\xe5\x42\x6c\x3d\x38\x9c\x44\x87\x8b\x0c\xa5\xce\x6a\xcb\xa1\x83\x93\x78\x56\xee\xfa\x0c\x99\x02\x69\xea\x39\xd6\xa6\xc2\xa1\xd8\x68\x1e\x6f\x4a\xd2\x05\x76\xe6\xcc\x82\x2f\x32\x5e\x3d\xbc\xb2\x7b\x7d\x85\x3b\xfc\x4d\x9a\x0a\xc9\x2f\xf5\x4f\xa6\xc2\xbb\xd2\xb2\xb8\x1e\x2e\xde\xff\x86\xb3\x8c\x18\xec\x19\x41\x32\xc6\x44\xaf\x90\xe9\xab\xa3\x98\xc1\x01\x4e\x86\xe8\xd9\x06\x47\xdf\x6c\xfd\xcf\x50\x63\x16\x50\x30\xe4\x98\x2d\x07\xd8\xd9\x06\xe4\xf4\xba\x0d\x36\x9b\xca\x54\x98\x57\x9f\x73\x5c\xaf\x56\xd3\x34\xd5\x76\x96\x5b\x8b\x76\xab\x9b\xbb\xd5\xc7\xed\xfd\xc3\xae\x79\x58\xce\x92\x4d\x85\x4f\xc1\x53\x4a\x50\xfa\x67\x64\xa5\x16\xc7\x33\x6c\x8c\x9e\x9d\x3d\x7a\x82\xb7\x53\x09\x6e\x4e\x67\x0e\x9d\x03\x26\xe5\xcc\xa1\xbb\x43\xba\xa6\x6e\xaa\x37\xe9\x7c\x7b\xae\x9b\x3c\x4e\x6f\x1a\x24\xc0\x06\x2c\x36\x0d\xb6\xcd\x02\xbf\x6f\x9a\x6d\x73\x67\x2a\x7c\xde\x1e\xfe\xdc\x7f\x3a\xe0\xf3\xe6\xe9\x69\xb3\x3b\x6c\x1f\x1a\xec\x9f\x70\xbf\xdf\x7d\xd8\x1e\xb6\xfb\x5d\x83\xfd\x23\x36\xbb\x2f\xf8\x6b\xbb\xfb\x70\x07\xe2\xdc\x93\x82\xbe\x46\x2d\xfa\x45\xc1\xe5\x21\xa9\x2d\x99\xde\x06\xe8\x26\xa0\xcc\x47\xf9\x9f\x22\x39\x3e\xb1\x83\xb7\xa1\x1b\x6d\x47\xe8\xe4\x85\x34\x94\xf1\x88\xa4\x03\xa7\x12\x67\x82\x0d\xad\xa9\xe0\x79\xe0\x3c\x4f\x51\xfa\xde\x54\xa1\xf9\x91\xdf\x96\x79\xe6\xd0\xae\xf1\x24\x9e\x8c\x8d\x7c\x9d\xac\x35\xf4\x68\x5d\x6d\xc7\xdc\x8b\xf2\xbf\xb3\x98\xfa\xf9\xd7\x54\xb3\xac\x5e\x7e\x36\x03\x65\xdb\xda\x6c\xd7\x06\x08\x76\xa0\x35\xce\xf6\x39\x2d\x25\x92\xda\x2c\xba\x4c\xa4\x2f\xec\x68\x90\xc0\x59\x34\x19\xc0\xdb\x23\xf9\x54\xfa\x51\x72\x5e\x63\x51\x6e\x2c\x0c\x60\x43\x90\xab\xdb\xcb\xb1\xb3\x61\x19\x55\x06\xc9\xb4\xc6\x22\xeb\x48\x0b\xa3\xa3\xa7\xb4\x36\x4b\xd8\xc8\x7f\xa8\x8c\x71\xee\x5d\xe2\xca\xc0\xa1\xab\x9d\x28\x49\xaa\x9d\x0c\x06\x50\x4a\x32\xaa\xa3\x6b\xdb\xf7\x7a\x5e\x48\x8f\xd7\x43\xa7\x64\x33\xcd\x65\x4b\x9e\xde\x94\x4e\xbc\x27\x57\xc4\xcd\x9b\x1d\xe5\xf9\xd7\x73\xba\x14\xd1\x66\xd7\xcf\xd5\x18\xdb\x1b\xca\x34\x6f\xfe\x17\x00\x00\xff\xff\xc1\x00\xe9\xb7\x03\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x8f\xdb\x36\x10\xbd\xf3\x57\x3c\x58\x97\x04\x58\xcb\x6d\x4f\x85\x7b\x72\x37\xbb\xad\xd1\xc0\x06\x56\x4e\x83\x1c\xc7\xd4\x58\x1a\xac\xc4\x51\x87\xd4\x2a\xee\xaf\x2f\x28\xdb\xcd\x2e\x72\x0d\x2f\x1a\x89\xc3\xf7\xc1\x37\x2a\xb0\xfc\x71\xcb\x15\xf8\x28\x9e\x43\xe4\x1a\x49\x91\x5a\xc6\x66\x20\xdf\x32\x2a\x3d\xa5\x89\x8c\xf1\xa8\x63\xa8\x29\x89\x06\xbc\xdb\x54\x8f\xef\x31\x86\x9a\x0d\x1a\x18\x6a\xe8\xd5\xd8\x15\xf0\x1a\x92\xc9\x71\x4c\x6a\xe8\x2e\x80\xa0\xc6\x98\x7b\x0e\x29\x96\x40\xc5\x3c\xa3\xef\xf6\x87\xed\xfd\x03\x4e\xd2\x31\x6a\x89\x97\x43\x5c\x63\x92\xd4\xba\x02\xa9\x95\x88\x49\xed\x19\x27\x35\x50\x5d\x4b\x26\xa6\x0e\x12\x4e\x6a\xfd\x45\x86\x71\x43\x56\x4b\x68\xe0\x75\x38\x9b\x34\x6d\x82\x4e\x81\x2d\xb6\x32\x94\xae\xc0\x21\xdb\xa8\x1e\x6f\x4a\xe2\x05\x76\xe6\x4c\x8a\x2f\x3a\x5e\x3d\xbc\xb2\x7b\xbd\x85\x3b\xfc\xcd\x16\x33\xc9\x2f\xe5\x4f\xae\xc0\xbb\xdc\xb2\xb8\x6e\x2e\xde\xff\x86\xb3\x8e\xe8\xe9\x8c\xa0\x09\x63\xe4\x57\xc8\xfc\xd5\xf3\x90\x20\x01\x5e\xfb\xa1\x13\x0a\x9e\xbf\xd9\xfa\x9f\xa1\xc4\x2c\x20\x63\xe8\x31\x91\x04\xd0\x6c\x03\x7a\x7a\xdd\x06\x4a\xae\x70\x05\xe6\xd5\xa6\x34\xac\x57\xab\x69\x9a\x4a\x9a\xd3\x29\xd5\x9a\xd5\xcd\xdd\xea\xe3\xf6\xfe\x61\x57\x3d\x2c\x67\xc9\xae\xc0\xa7\xd0\x71\x8c\x30\xfe\x67\x14\xe3\x1a\xc7\x33\x68\x18\x3a\xf1\x74\xec\x18\x1d\x4d\x39\xb8\x39\x9d\x39\x74\x09\x98\x4c\x92\x84\xe6\x0e\xf1\x9a\xba\x2b\xde\xa4\xf3\xed\xba\x6e\xf2\x24\xbe\x69\xd0\x00\x0a\x58\x6c\x2a\x6c\xab\x05\x7e\xdf\x54\xdb\xea\xce\x15\xf8\xbc\x3d\xfc\xb9\xff\x74\xc0\xe7\xcd\xd3\xd3\x66\x77\xd8\x3e\x54\xd8\x3f\xe1\x7e\xbf\xfb\xb0\x3d\x6c\xf7\xbb\x0a\xfb\x47\x6c\x76\x5f\xf0\xd7\x76\xf7\xe1\x0e\x2c\xa9\x65\x03\x7f\x1d\x2c\xeb\x57\x83\xe4\x8b\xe4\x3a\x67\x7a\x1b\xa0\x9b\x80\x3c\x1f\xf9\x3d\x0e\xec\xe5\x24\x1e\x1d\x85\x66\xa4\x86\xd1\xe8\x0b\x5b\xc8\xe3\x31\xb0\xf5\x12\x73\x9c\x11\x14\x6a\x57\xa0\x93\x5e\xd2\x3c\x45\xf1\x7b\x53\x99\xe6\xf6\x63\xfc\x80\xe5\xdc\xb3\x84\x7a\x8d\x27\xed\xd8\xd1\x20\xd7\xc9\x5a\xc3\x8e\xe4\x4b\x1a\x53\xab\x26\xff\xce\x62\xca\xe7\x5f\x63\x29\xba\x7a\xf9\xd9\xf5\x9c\xa8\xa6\x44\x6b\x07\x04\xea\x79\x8d\x33\x3d\xc7\xa5\x0e\x6c\x94\xd4\x96\x91\xed\x45\x3c\xf7\x1a\x24\xa9\x45\x07\x74\x74\xe4\x2e\xe6\x7e\xe4\x9c\xd7\x58\xe4\x13\x0b\x07\x50\x08\x7a\x75\x7b\xd9\xf6\x14\x96\x83\x69\xaf\x89\xd7\x58\x24\x1b\x79\xe1\x6c\xec\x38\xae\xdd\x12\x34\xc8\x1f\xa6\xe3\x30\x43\x2d\x71\x65\x90\xd0\x94\x5e\x8d\x35\x96\x5e\x7b\x07\x18\x47\x1d\xcd\xf3\xb5\xed\x7b\x3d\x2f\x6c\xc7\xeb\xa6\x37\xa6\xc4\x73\x59\x73\xc7\x6f\x4a\xaf\x5d\xc7\x3e\x8b\x9b\x3f\x36\x9c\xe6\x67\x27\xf1\x52\x0c\x94\x7c\x3b\x57\xe3\x50\xdf\x50\x26\x4a\xbe\x75\xff\x0d\x00\xc1\x00\xe9\xb7\x03\x05\x00\x00"),
 		},
 		"/rbac/operator-role-strimzi.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "operator-role-strimzi.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1286,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xac\x4b\x02\xac\xe5\xb6\xa7\xc2\x3d\xb9\x9b\xdd\xd6\x68\x60\x03\x2b\xa7\x41\x8e\x63\x69\x2c\x0d\x4c\x71\x58\x92\x5a\xc5\xf9\xfa\x82\xb2\x9c\xdd\x6d\xaf\xe1\x89\xf4\x3c\xcf\x7b\x6f\xde\xa8\xc0\xf2\xc7\x1d\x53\xe0\xa3\xd4\xec\x22\x37\x48\x8a\xd4\x31\x36\x9e\xea\x8e\x51\xe9\x29\x8d\x14\x18\x8f\x3a\xb8\x86\x92\xa8\xc3\xbb\x4d\xf5\xf8\x1e\x83\x6b\x38\x40\x1d\x43\x03\x7a\x0d\x6c\x0a\xd4\xea\x52\x90\xe3\x90\x34\xc0\x5e\x1b\x82\xda\xc0\xdc\xb3\x4b\xb1\x04\x2a\xe6\xa9\xfb\x6e\x7f\xd8\xde\x3f\xe0\x24\x96\xd1\x48\xbc\xfe\x89\x1b\x8c\x92\x3a\x53\x20\x75\x12\x31\x6a\x38\xe3\xa4\x01\xd4\x34\x92\x89\xc9\x42\xdc\x49\x43\x7f\x95\x11\xb8\xa5\xd0\x88\x6b\x51\xab\xbf\x04\x69\xbb\x04\x1d\x1d\x87\xd8\x89\x2f\x4d\x81\x43\xb6\x51\x3d\xde\x94\xc4\x6b\xdb\x89\x33\x29\xbe\xe8\x30\x7b\x78\x65\x77\x9e\xc2\x1d\xfe\xe6\x10\x33\xc9\x2f\xe5\x4f\xa6\xc0\xbb\x0c\x59\xcc\xc5\xc5\xfb\xdf\x70\xd1\x01\x3d\x5d\xe0\x34\x61\x88\xfc\xaa\x33\x7f\xad\xd9\x27\x88\x43\xad\xbd\xb7\x42\xae\xe6\x17\x5b\xdf\x19\x4a\x4c\x02\x72\x0f\x3d\x26\x12\x07\x9a\x6c\x40\x4f\xaf\x61\xa0\x64\x0a\x53\x60\x3a\x5d\x4a\x7e\xbd\x5a\x8d\xe3\x58\xd2\x24\xb7\xd4\xd0\xae\x6e\xee\x56\x1f\xb7\xf7\x0f\xbb\xea\x61\x39\x49\x36\x05\x3e\x39\xcb\x31\x22\xf0\x3f\x83\x04\x6e\x70\xbc\x80\xbc\xb7\x52\xd3\xd1\x32\x2c\x8d\x39\xb8\x29\x9d\x29\x74\x71\x18\x83\x24\x71\xed\x1d\xe2\x9c\xba\x29\xde\xa4\xf3\x32\xae\x9b\x3c\x89\x6f\x00\xea\x40\x0e\x8b\x4d\x85\x6d\xb5\xc0\xef\x9b\x6a\x5b\xdd\x99\x02\x9f\xb7\x87\x3f\xf7\x9f\x0e\xf8\xbc\x79\x7a\xda\xec\x0e\xdb\x87\x0a\xfb\x27\xdc\xef\x77\x1f\xb6\x87\xed\x7e\x57\x61\xff\x88\xcd\xee\x0b\xfe\xda\xee\x3e\xdc\x81\x25\x75\x1c\xc0\x5f\x7d\xc8\xfa\x35\x40\xf2\x20\xb9\xc9\x99\xde\x16\xe8\x26\x20\xef\x47\x7e\x47\xcf\xb5\x9c\xa4\x86\x25\xd7\x0e\xd4\x32\x5a\x7d\xe6\xe0\xf2\x7a\x78\x0e\xbd\xc4\x1c\x67\x04\xb9\xc6\x14\xb0\xd2\x4b\x9a\xb6\x28\xfe\xdf\x54\xa6\xf9\x91\xdf\x96\x39\x8b\x6b\xd6\x78\x52\xcb\x86\xbc\xcc\x9b\xb5\x46\x38\x52\x5d\xd2\x90\x3a\x0d\xf2\x6d\x12\x53\x9e\x7f\x8d\xa5\xe8\xea\xf9\x67\xd3\x73\xa2\x86\x12\xad\x0d\xe0\xa8\xe7\x35\x2e\x74\x8e\x4b\xf5\x1c\x28\x69\x58\xe6\x99\xf7\xdf\xc4\x00\x96\x8e\x6c\x63\xc6\x21\xe7\xbb\xc6\x22\x23\x17\x06\x20\xe7\x74\x76\x79\x2d\xd7\xe4\x96\x3e\x68\xaf\x89\xd7\x58\xa4\x30\xf0\xc2\x84\xc1\x72\x5c\x9b\x25\xc8\xcb\x1f\x41\x07\x3f\x63\x97\x38\xd3\xe9\x4c\xe5\x4c\x54\x8a\x1a\x20\x70\xd4\x21\xd4\xfc\x16\x93\xd4\x4b\x1d\x0d\xf0\xcc\xe1\xf8\xbd\x54\x07\xa6\xc4\xf3\xa3\x61\xcb\xff\x79\xd4\x6a\x2d\xd7\x59\xdd\xfc\x73\xcb\x69\xbe\x59\x89\xb7\xab\xa7\x54\x77\xf3\x7d\xf0\xcd\x4b\xc7\x71\x2a\xfc\x1b\x00\x00\xff\xff\x1c\x9d\xe0\x76\x06\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\x4d\x8f\xdb\x36\x10\xbd\xf3\x57\x3c\x58\x97\x04\x58\xcb\x6d\x4f\x85\x7b\x72\x37\xbb\xad\xd1\xc0\x06\x56\x4e\x83\x1c\xc7\xd4\x58\x1a\x58\xe2\xb0\x24\xb5\x8a\xf3\xeb\x0b\xca\x72\x76\xb7\xbd\x86\x27\x52\x1c\xbd\x8f\x79\xc3\x02\xcb\x1f\xb7\x4c\x81\x8f\x62\xd9\x45\xae\x91\x14\xa9\x65\x6c\x3c\xd9\x96\x51\xe9\x29\x8d\x14\x18\x8f\x3a\xb8\x9a\x92\xa8\xc3\xbb\x4d\xf5\xf8\x1e\x83\xab\x39\x40\x1d\x43\x03\x7a\x0d\x6c\x0a\x58\x75\x29\xc8\x71\x48\x1a\xd0\x5d\x01\x41\x4d\x60\xee\xd9\xa5\x58\x02\x15\xf3\x84\xbe\xdb\x1f\xb6\xf7\x0f\x38\x49\xc7\xa8\x25\x5e\x7f\xe2\x1a\xa3\xa4\xd6\x14\x48\xad\x44\x8c\x1a\xce\x38\x69\x00\xd5\xb5\x64\x62\xea\x20\xee\xa4\xa1\xbf\xca\x08\xdc\x50\xa8\xc5\x35\xb0\xea\x2f\x41\x9a\x36\x41\x47\xc7\x21\xb6\xe2\x4b\x53\xe0\x90\x6d\x54\x8f\x37\x25\xf1\x0a\x3b\x71\x26\xc5\x17\x1d\x66\x0f\xaf\xec\xce\x5d\xb8\xc3\xdf\x1c\x62\x26\xf9\xa5\xfc\xc9\x14\x78\x97\x4b\x16\xf3\xe5\xe2\xfd\x6f\xb8\xe8\x80\x9e\x2e\x70\x9a\x30\x44\x7e\x85\xcc\x5f\x2d\xfb\x04\x71\xb0\xda\xfb\x4e\xc8\x59\x7e\xb1\xf5\x9d\xa1\xc4\x24\x20\x63\xe8\x31\x91\x38\xd0\x64\x03\x7a\x7a\x5d\x06\x4a\xa6\x30\x05\xa6\xd5\xa6\xe4\xd7\xab\xd5\x38\x8e\x25\x4d\xe9\x94\x1a\x9a\xd5\xcd\xdd\xea\xe3\xf6\xfe\x61\x57\x3d\x2c\x27\xc9\xa6\xc0\x27\xd7\x71\x8c\x08\xfc\xcf\x20\x81\x6b\x1c\x2f\x20\xef\x3b\xb1\x74\xec\x18\x1d\x8d\x39\xb8\x29\x9d\x29\x74\x71\x18\x83\x24\x71\xcd\x1d\xe2\x9c\xba\x29\xde\xa4\xf3\xd2\xae\x9b\x3c\x89\x6f\x0a\xd4\x81\x1c\x16\x9b\x0a\xdb\x6a\x81\xdf\x37\xd5\xb6\xba\x33\x05\x3e\x6f\x0f\x7f\xee\x3f\x1d\xf0\x79\xf3\xf4\xb4\xd9\x1d\xb6\x0f\x15\xf6\x4f\xb8\xdf\xef\x3e\x6c\x0f\xdb\xfd\xae\xc2\xfe\x11\x9b\xdd\x17\xfc\xb5\xdd\x7d\xb8\x03\x4b\x6a\x39\x80\xbf\xfa\x90\xf5\x6b\x80\xe4\x46\x72\x9d\x33\xbd\x0d\xd0\x4d\x40\x9e\x8f\x7c\x8e\x9e\xad\x9c\xc4\xa2\x23\xd7\x0c\xd4\x30\x1a\x7d\xe6\xe0\xf2\x78\x78\x0e\xbd\xc4\x1c\x67\x04\xb9\xda\x14\xe8\xa4\x97\x34\x4d\x51\xfc\xbf\xa9\x4c\x73\x7b\x18\x3f\x60\x19\x73\x16\x57\xaf\xf1\xa4\x1d\x1b\xf2\x32\x4f\xd6\x1a\xe1\x48\xb6\xa4\x21\xb5\x1a\xe4\xdb\x24\xa6\x3c\xff\x1a\x4b\xd1\xd5\xf3\xcf\xa6\xe7\x44\x35\x25\x5a\x1b\xc0\x51\xcf\x6b\x5c\xe8\x1c\x97\xea\x39\x50\xd2\xb0\xcc\x4f\xa6\xff\x26\x06\xe8\xe8\xc8\x5d\xcc\x75\xc8\xf9\xae\xb1\xc8\x95\x0b\x03\x90\x73\x3a\xbb\xbc\x5e\x5b\x72\x4b\x1f\xb4\xd7\xc4\x6b\x2c\x52\x18\x78\x61\xc2\xd0\x71\x5c\x9b\x25\xc8\xcb\x1f\x41\x07\x3f\x43\x2d\x71\xa6\xd3\x99\xca\x99\xa8\x14\x35\x40\xe0\xa8\x43\xb0\xfc\xb6\x26\xa9\x17\x1b\x0d\xf0\xcc\xe1\xf8\xfd\xca\x06\xa6\xc4\xf3\xa1\xe6\x8e\xff\x73\xb0\xda\x75\x6c\xb3\xba\xf9\x73\xc3\x69\xde\x75\x12\x6f\x5b\x4f\xc9\xb6\xf3\x7e\xf0\xf5\x0b\xe2\x48\xc9\xb6\xe6\xdf\x01\x00\x1c\x9d\xe0\x76\x06\x05\x00\x00"),
 		},
 		"/rbac/user-cluster-role.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "user-cluster-role.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1317,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xa4\x4b\x52\xac\xe5\xb6\xa7\x42\x3d\xb9\x9b\xdd\x56\x68\x60\x03\x2b\xa7\x41\x50\xf4\x30\x16\xc7\xd2\x60\x25\x52\x1d\x52\xab\xb8\x5f\x5f\x50\xb6\x37\xbb\x28\x7a\x08\x10\xde\x28\x0e\xdf\xbc\x37\xef\x51\x39\x56\xdf\x6e\x99\x1c\xef\xa5\x61\x17\xd8\x22\x7a\xc4\x8e\xb1\x19\xa9\xe9\x18\xb5\x3f\xc6\x99\x94\x71\xef\x27\x67\x29\x8a\x77\x78\xb3\xa9\xef\xdf\x62\x72\x96\x15\xde\x31\xbc\x62\xf0\xca\x26\x47\xe3\x5d\x54\x39\x4c\xd1\x2b\xfa\x33\x20\xa8\x55\xe6\x81\x5d\x0c\x05\x50\x33\x2f\xe8\xdb\xdd\xbe\xba\xbd\xc3\x51\x7a\x86\x95\x70\xbe\xc4\x16\xb3\xc4\xce\xe4\x88\x9d\x04\xcc\x5e\x1f\x71\xf4\x0a\xb2\x56\x52\x63\xea\x21\xee\xe8\x75\x38\xd3\x50\x6e\x49\xad\xb8\x16\x8d\x1f\x4f\x2a\x6d\x17\xe1\x67\xc7\x1a\x3a\x19\x0b\x93\x63\x9f\x64\xd4\xf7\x57\x26\xe1\x0c\xbb\xf4\x8c\x1e\x9f\xfc\x74\xd1\xf0\x42\xee\x65\x0a\x37\xf8\x83\x35\xa4\x26\x3f\x16\xdf\x9b\x1c\x6f\x52\x49\x76\x39\xcc\xde\xfe\x8c\x93\x9f\x30\xd0\x09\xce\x47\x4c\x81\x5f\x20\xf3\xe7\x86\xc7\x08\x71\x68\xfc\x30\xf6\x42\xae\xe1\x2f\xb2\x9e\x3b\x14\x58\x08\x24\x0c\x7f\x88\x24\x0e\xb4\xc8\x80\x3f\xbe\x2c\x03\x45\x93\x9b\x1c\xcb\xea\x62\x1c\xcb\xf5\x7a\x9e\xe7\x82\x16\xba\x85\xd7\x76\x7d\x55\xb7\x7e\x5f\xdd\xde\x6d\xeb\xbb\xd5\x42\xd9\xe4\xf8\xe0\x7a\x0e\x01\xca\x7f\x4f\xa2\x6c\x71\x38\x81\xc6\xb1\x97\x86\x0e\x3d\xa3\xa7\x39\x19\xb7\xb8\xb3\x98\x2e\x0e\xb3\x4a\x14\xd7\xde\x20\x5c\x5c\x37\xf9\x2b\x77\xbe\x8c\xeb\x4a\x4f\xc2\xab\x02\xef\x40\x0e\xd9\xa6\x46\x55\x67\xf8\x65\x53\x57\xf5\x8d\xc9\xf1\xb1\xda\xff\xb6\xfb\xb0\xc7\xc7\xcd\xc3\xc3\x66\xbb\xaf\xee\x6a\xec\x1e\x70\xbb\xdb\xbe\xab\xf6\xd5\x6e\x5b\x63\x77\x8f\xcd\xf6\x13\x7e\xaf\xb6\xef\x6e\xc0\x12\x3b\x56\xf0\xe7\x51\x13\x7f\xaf\x90\x34\x48\xb6\xc9\xd3\x6b\x80\xae\x04\x52\x3e\xd2\x3e\x8c\xdc\xc8\x51\x1a\xf4\xe4\xda\x89\x5a\x46\xeb\x9f\x58\x5d\x8a\xc7\xc8\x3a\x48\x48\x76\x06\x90\xb3\x26\x47\x2f\x83\xc4\x25\x45\xe1\xbf\xa2\x52\x9b\x6f\xf9\xb6\xcc\xa3\x38\x5b\xe2\xb6\x9f\x42\x64\x7d\xf0\x3d\x1b\x1a\xe5\x12\xb0\x12\x7a\xa0\xa6\xa0\x29\x76\x5e\xe5\x9f\x85\x53\xf1\xf8\x53\x28\xc4\xaf\x9f\x7e\x30\x03\x47\xb2\x14\xa9\x34\x80\xa3\x81\x4b\x9c\xe8\x31\x94\x6c\x25\x1a\xa0\xa7\x03\xf7\x21\x9d\x21\x59\x5b\x22\x4b\xa7\xd9\xb2\xcf\xb1\xb1\x36\xc9\x0a\xfc\x6a\x00\x97\xe7\x9d\x91\x1d\xc4\x65\x69\x1e\xc8\x12\x5c\x06\xcb\x47\x9a\xfa\x08\xf5\x3d\x87\x62\x01\xf9\x7f\x6e\xd4\xb6\xe9\xfd\x45\x5e\x45\xbf\x5a\xb0\x4a\x64\x51\x27\xce\xbe\xe6\x62\x6a\xfc\x7c\x4f\xa7\x9e\x43\x69\x56\xa0\x51\x7e\x55\x3f\x8d\xa1\xc4\x9f\x8b\xa2\xa2\x91\xa8\x53\x38\x2a\x0d\x9c\xfe\x0a\x29\xf7\xd9\x5f\x06\x50\x0e\x7e\xd2\x86\x97\xca\xef\x96\x4f\x4f\xac\x87\xe7\xed\xbf\x01\x00\x00\xff\xff\xc9\xa9\x19\xcd\x25\x05\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xc1\x8e\xdb\x36\x10\xbd\xf3\x2b\x1e\xa4\x4b\x12\xac\xe5\xb6\xa7\x42\x3d\xb9\x9b\xdd\x56\x68\x60\x03\x2b\xa7\x41\x10\xe4\x30\x16\xc7\xd2\x60\x25\x52\x1d\x52\xab\xb8\x5f\x5f\x50\xb6\x93\x5d\x14\x3d\x14\x08\x6f\x14\x87\x6f\xde\x9b\xf7\xa8\x1c\xab\xef\xb7\x4c\x8e\x77\xd2\xb0\x0b\x6c\x11\x3d\x62\xc7\xd8\x8c\xd4\x74\x8c\xda\x1f\xe3\x4c\xca\xb8\xf7\x93\xb3\x14\xc5\x3b\xbc\xda\xd4\xf7\xaf\x31\x39\xcb\x0a\xef\x18\x5e\x31\x78\x65\x93\xa3\xf1\x2e\xaa\x1c\xa6\xe8\x15\xfd\x19\x10\xd4\x2a\xf3\xc0\x2e\x86\x02\xa8\x99\x17\xf4\xed\x6e\x5f\xdd\xde\xe1\x28\x3d\xc3\x4a\x38\x5f\x62\x8b\x59\x62\x67\x72\xc4\x4e\x02\x66\xaf\x8f\x38\x7a\x05\x59\x2b\xa9\x31\xf5\x10\x77\xf4\x3a\x9c\x69\x28\xb7\xa4\x56\x5c\x8b\xc6\x8f\x27\x95\xb6\x8b\xf0\xb3\x63\x0d\x9d\x8c\x85\xc9\xb1\x4f\x32\xea\xfb\x2b\x93\x70\x86\x5d\x7a\x46\x8f\x8f\x7e\xba\x68\x78\x26\xf7\x32\x85\x1b\xfc\xc9\x1a\x52\x93\x9f\x8a\x1f\x4c\x8e\x57\xa9\x24\xbb\x1c\x66\xaf\x7f\xc1\xc9\x4f\x18\xe8\x04\xe7\x23\xa6\xc0\xcf\x90\xf9\x4b\xc3\x63\x84\x38\x34\x7e\x18\x7b\x21\xd7\xf0\x37\x59\x5f\x3b\x14\x58\x08\x24\x0c\x7f\x88\x24\x0e\xb4\xc8\x80\x3f\x3e\x2f\x03\x45\x93\x9b\x1c\xcb\xea\x62\x1c\xcb\xf5\x7a\x9e\xe7\x82\x16\x77\x0a\xaf\xed\xfa\xaa\x6e\xfd\xae\xba\xbd\xdb\xd6\x77\xab\x85\xb2\xc9\xf1\xde\xf5\x1c\x02\x94\xff\x9a\x44\xd9\xe2\x70\x02\x8d\x63\x2f\x0d\x1d\x7a\x46\x4f\x73\x32\x6e\x71\x67\x31\x5d\x1c\x66\x95\x28\xae\xbd\x41\xb8\xb8\x6e\xf2\x17\xee\x7c\x1b\xd7\x95\x9e\x84\x17\x05\xde\x81\x1c\xb2\x4d\x8d\xaa\xce\xf0\xeb\xa6\xae\xea\x1b\x93\xe3\x43\xb5\xff\x7d\xf7\x7e\x8f\x0f\x9b\x87\x87\xcd\x76\x5f\xdd\xd5\xd8\x3d\xe0\x76\xb7\x7d\x5b\xed\xab\xdd\xb6\xc6\xee\x1e\x9b\xed\x47\xfc\x51\x6d\xdf\xde\x80\x25\x76\xac\xe0\x2f\xa3\x26\xfe\x5e\x21\x69\x90\x6c\x93\xa7\xd7\x00\x5d\x09\xa4\x7c\xa4\x7d\x18\xb9\x91\xa3\x34\xe8\xc9\xb5\x13\xb5\x8c\xd6\x3f\xb1\xba\x14\x8f\x91\x75\x90\x90\xec\x0c\x20\x67\x4d\x8e\x5e\x06\x89\x4b\x8a\xc2\xbf\x45\xa5\x36\xd7\x87\xf1\x1d\x96\x31\x8f\xe2\x6c\x89\xdb\x7e\x0a\x91\xf5\xc1\xf7\x6c\x68\x94\x4b\xc0\x4a\xe8\x81\x9a\x82\xa6\xd8\x79\x95\xbf\x17\x4e\xc5\xe3\xcf\xa1\x10\xbf\x7e\xfa\xd1\x0c\x1c\xc9\x52\xa4\xd2\x00\x8e\x06\x2e\x71\xa2\xc7\x50\xb2\x95\x68\x80\x9e\x0e\xdc\x87\x74\x86\x64\x6d\x89\x2c\x9d\x66\xcb\x3e\xc7\xc6\xda\x24\x2b\xf0\x8b\x01\x5c\x9e\x77\x46\x76\x10\x97\xa5\x79\x20\x4b\x70\x19\x2c\x1f\x69\xea\x23\xd4\xf7\x1c\x8a\x05\xe4\xbf\xb9\x51\xdb\xa6\xf7\x17\x79\x15\xfd\x6a\xc1\x2a\x91\x45\x9d\x38\xfb\x3f\x17\x53\xe3\xaf\xf7\x74\xea\x39\x94\x66\x05\x1a\xe5\x37\xf5\xd3\x18\x4a\x7c\x5a\x14\x15\x8d\x44\x9d\xc2\x51\x69\xe0\xf4\x57\x48\xb9\xcf\x3e\x1b\x40\x39\xf8\x49\x1b\x5e\x2a\xdf\x2c\x9f\x9e\x58\x0f\xa1\xc4\xa7\xec\x4d\xf6\xd9\xfc\x33\x00\xc9\xa9\x19\xcd\x25\x05\x00\x00"),
 		},
 		"/rbac/user-global-instance-viewer-role-binding.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "user-global-instance-viewer-role-binding.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1243,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xcd\x6e\xdb\x3c\x10\xbc\xf3\x29\x06\xd6\x25\x01\x6c\xf9\xfb\x7a\x2a\xd4\x93\x93\xd8\xad\xd0\xc0\x06\x2c\xa7\x41\x8e\x6b\x69\x2d\x6d\x2d\x91\x2a\x49\x45\x71\x9f\xbe\xa0\x6c\xe7\x07\x05\x02\x14\x08\x6f\x02\x97\xf3\xb3\x33\x8a\x30\xf9\xb8\xa3\x22\xdc\x4a\xce\xda\x71\x01\x6f\xe0\x2b\xc6\xac\xa5\xbc\x62\x64\x66\xe7\x7b\xb2\x8c\x85\xe9\x74\x41\x5e\x8c\xc6\xc5\x2c\x5b\x5c\xa2\xd3\x05\x5b\x18\xcd\x30\x16\x8d\xb1\xac\x22\xe4\x46\x7b\x2b\xdb\xce\x1b\x8b\xfa\x08\x08\x2a\x2d\x73\xc3\xda\xbb\x18\xc8\x98\x07\xf4\xe5\x6a\x93\x5e\xcf\xb1\x93\x9a\x51\x88\x3b\x3e\xe2\x02\xbd\xf8\x4a\x45\xf0\x95\x38\xf4\xc6\xee\xb1\x33\x16\x54\x14\x12\x88\xa9\x86\xe8\x9d\xb1\xcd\x51\x86\xe5\x92\x6c\x21\xba\x44\x6e\xda\x83\x95\xb2\xf2\x30\xbd\x66\xeb\x2a\x69\x63\x15\x61\x13\x6c\x64\x8b\xb3\x12\x77\x84\x1d\x38\xbd\xc1\x83\xe9\x4e\x1e\x5e\xd9\x3d\x6d\x61\x8c\x1f\x6c\x5d\x20\xf9\x14\xff\xa7\x22\x5c\x84\x91\xd1\xe9\x72\x74\xf9\x05\x07\xd3\xa1\xa1\x03\xb4\xf1\xe8\x1c\xbf\x42\xe6\xa7\x9c\x5b\x0f\xd1\xc8\x4d\xd3\xd6\x42\x3a\xe7\x17\x5b\xcf\x0c\x31\x06\x01\x01\xc3\x6c\x3d\x89\x06\x0d\x36\x60\x76\xaf\xc7\x40\x5e\x45\x2a\xc2\x70\x2a\xef\xdb\x64\x3a\xed\xfb\x3e\xa6\x41\x6e\x6c\x6c\x39\x3d\xbb\x9b\xde\xa6\xd7\xf3\x65\x36\x9f\x0c\x92\x55\x84\x3b\x5d\xb3\x73\xb0\xfc\xab\x13\xcb\x05\xb6\x07\x50\xdb\xd6\x92\xd3\xb6\x66\xd4\xd4\x87\xe0\x86\x74\x86\xd0\x45\xa3\xb7\xe2\x45\x97\x63\xb8\x53\xea\x2a\x7a\x93\xce\xcb\xba\xce\xf2\xc4\xbd\x19\x30\x1a\xa4\x31\x9a\x65\x48\xb3\x11\xae\x66\x59\x9a\x8d\x55\x84\xfb\x74\xf3\x6d\x75\xb7\xc1\xfd\x6c\xbd\x9e\x2d\x37\xe9\x3c\xc3\x6a\x8d\xeb\xd5\xf2\x26\xdd\xa4\xab\x65\x86\xd5\x02\xb3\xe5\x03\xbe\xa7\xcb\x9b\x31\x58\x7c\xc5\x16\xfc\xd4\xda\xa0\xdf\x58\x48\x58\x24\x17\x21\xd3\x73\x81\xce\x02\x42\x3f\xc2\xb7\x6b\x39\x97\x9d\xe4\xa8\x49\x97\x1d\x95\x8c\xd2\x3c\xb2\xd5\xa1\x1e\x2d\xdb\x46\x5c\x88\xd3\x81\x74\xa1\x22\xd4\xd2\x88\x1f\x5a\xe4\xfe\x36\x15\x68\x3e\xf2\xdf\x52\x7b\xd1\x45\x82\xb5\xa9\xf9\x4a\x74\x28\xac\xa2\x56\x4e\x05\x4b\x60\xb7\x94\xc7\xd4\xf9\xca\x58\xf9\x3d\x68\x8a\xf7\x9f\x5d\x2c\x66\xfa\xf8\xbf\x6a\xd8\x53\x41\x9e\x12\x05\x68\x6a\x38\xc1\x81\xf6\x6e\x22\xda\xf9\x50\xac\xc9\xa3\x70\xcf\x56\x01\x35\x6d\xb9\x76\x61\x0c\x21\xe5\x04\xa3\x30\x38\x52\xd6\xd4\xbc\xe6\x5d\xb8\xa0\x56\xbe\x5a\xd3\xb5\xef\x50\x2a\xe0\x45\xec\xfb\x94\xae\xdb\xfe\xe4\xdc\xbb\x44\x4d\xfe\x09\x79\x18\x7c\x86\x76\x07\xe7\xb9\x49\xc2\x0b\xd6\x5e\x72\xf2\x5c\xa8\x3f\x01\x00\x00\xff\xff\x85\xf2\x5c\x08\xdb\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xcd\x6e\xdb\x3c\x10\xbc\xf3\x29\x06\xd6\x25\x01\x6c\xf9\xfb\x7a\x2a\xd4\x93\x93\xd8\xad\xd0\xc0\x06\x2c\xa7\x41\x8e\x6b\x69\x2d\x6d\x2d\x91\x2a\x49\x45\x71\x9f\xbe\xa0\x6c\xe7\x07\x05\x02\x14\x08\x6f\x02\x97\xf3\xb3\x33\x8a\x30\xf9\xb8\xa3\x22\xdc\x4a\xce\xda\x71\x01\x6f\xe0\x2b\xc6\xac\xa5\xbc\x62\x64\x66\xe7\x7b\xb2\x8c\x85\xe9\x74\x41\x5e\x8c\xc6\xc5\x2c\x5b\x5c\xa2\xd3\x05\x5b\x18\xcd\x30\x16\x8d\xb1\xac\x22\xe4\x46\x7b\x2b\xdb\xce\x1b\x8b\xfa\x08\x08\x2a\x2d\x73\xc3\xda\xbb\x18\xc8\x98\x07\xf4\xe5\x6a\x93\x5e\xcf\xb1\x93\x9a\x51\x88\x3b\x3e\xe2\x02\xbd\xf8\x4a\x45\xf0\x95\x38\xf4\xc6\xee\xb1\x33\x16\x54\x14\x12\x88\xa9\x86\xe8\x9d\xb1\xcd\x51\x86\xe5\x92\x6c\x21\xba\x44\x6e\xda\x83\x95\xb2\xf2\x30\xbd\x66\xeb\x2a\x69\x63\x15\x61\x13\x6c\x64\x8b\xb3\x12\x77\x84\x1d\x38\xbd\xc1\x83\xe9\x4e\x1e\x5e\xd9\x3d\x6d\x61\x8c\x1f\x6c\x5d\x20\xf9\x14\xff\xa7\x22\x5c\x84\x91\xd1\xe9\x72\x74\xf9\x05\x07\xd3\xa1\xa1\x03\xb4\xf1\xe8\x1c\xbf\x42\xe6\xa7\x9c\x5b\x0f\xd1\xc8\x4d\xd3\xd6\x42\x3a\xe7\x17\x5b\xcf\x0c\x31\x06\x01\x01\xc3\x6c\x3d\x89\x06\x0d\x36\x60\x76\xaf\xc7\x40\x5e\x45\x2a\xc2\x70\x2a\xef\xdb\x64\x3a\xed\xfb\x3e\xa6\x21\x9d\xd8\xd8\x72\x7a\x76\x37\xbd\x4d\xaf\xe7\xcb\x6c\x3e\x19\x24\xab\x08\x77\xba\x66\xe7\x60\xf9\x57\x27\x96\x0b\x6c\x0f\xa0\xb6\xad\x25\xa7\x6d\xcd\xa8\xa9\x0f\xc1\x0d\xe9\x0c\xa1\x8b\x46\x6f\xc5\x8b\x2e\xc7\x70\xa7\xd4\x55\xf4\x26\x9d\x97\x75\x9d\xe5\x89\x7b\x33\x60\x34\x48\x63\x34\xcb\x90\x66\x23\x5c\xcd\xb2\x34\x1b\xab\x08\xf7\xe9\xe6\xdb\xea\x6e\x83\xfb\xd9\x7a\x3d\x5b\x6e\xd2\x79\x86\xd5\x1a\xd7\xab\xe5\x4d\xba\x49\x57\xcb\x0c\xab\x05\x66\xcb\x07\x7c\x4f\x97\x37\x63\xb0\xf8\x8a\x2d\xf8\xa9\xb5\x41\xbf\xb1\x90\xb0\x48\x2e\x42\xa6\xe7\x02\x9d\x05\x84\x7e\x84\x6f\xd7\x72\x2e\x3b\xc9\x51\x93\x2e\x3b\x2a\x19\xa5\x79\x64\xab\x43\x3d\x5a\xb6\x8d\xb8\x10\xa7\x03\xe9\x42\x45\xa8\xa5\x11\x3f\xb4\xc8\xfd\x6d\x2a\xd0\x9c\x7f\x8c\x0f\x38\x4a\xed\x45\x17\x09\xd6\xa6\xe6\x2b\xd1\xa1\xb0\x8a\x5a\x39\x15\x2c\x81\xdd\x52\x1e\x53\xe7\x2b\x63\xe5\xf7\xa0\x29\xde\x7f\x76\xb1\x98\xe9\xe3\xff\xaa\x61\x4f\x05\x79\x4a\x14\xa0\xa9\xe1\x04\x07\xda\xbb\x89\x68\xe7\x43\xb1\x26\x8f\xc2\x3d\x5b\x05\xd4\xb4\xe5\xda\x85\x31\x84\x94\x13\x8c\xc2\xe0\x48\x59\x53\xf3\x9a\x77\xe1\x82\x5a\xf9\x6a\x4d\xd7\xbe\x43\xa9\x80\x17\xb1\xef\x53\xba\x6e\xfb\x93\x73\xef\x12\x35\xf9\x27\xe4\x61\xf0\x19\xda\x1d\x9c\xe7\x26\x09\x2f\x58\x7b\xc9\xc9\x73\xa1\xfe\x0c\x00\x85\xf2\x5c\x08\xdb\x04\x00\x00"),
 		},
 		"/rbac/user-global-instance-viewer-role.yaml": &vfsgen۰CompressedFileInfo{
 			name:             "user-global-instance-viewer-role.yaml",
 			modTime:          time.Time{},
 			uncompressedSize: 1168,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xcf\x6f\xf2\x46\x14\xbc\xef\x5f\x31\xc2\x97\xef\x93\xc0\xb4\x3d\x55\xf4\x44\x13\x68\xad\x46\x20\x61\xd2\x28\xc7\x87\xfd\xb0\x9f\xb0\x77\xdd\xb7\x6b\x1c\xfa\xd7\x57\x6b\xa0\x49\xf4\x5d\xb3\x17\xff\x1a\xbf\x99\xd9\x99\x4d\x30\xfb\xba\x65\x12\x3c\x49\xc1\xd6\x73\x89\xe0\x10\x6a\xc6\xb2\xa3\xa2\x66\xe4\xee\x18\x06\x52\xc6\xda\xf5\xb6\xa4\x20\xce\xe2\xdb\x32\x5f\x7f\x47\x6f\x4b\x56\x38\xcb\x70\x8a\xd6\x29\x9b\x04\x85\xb3\x41\xe5\xd0\x07\xa7\x68\xae\x03\x41\x95\x32\xb7\x6c\x83\x4f\x81\x9c\x79\x9c\xbe\xd9\xee\xb3\x87\x15\x8e\xd2\x30\x4a\xf1\xd7\x9f\xb8\xc4\x20\xa1\x36\x09\x42\x2d\x1e\x83\xd3\x13\x8e\x4e\x41\x65\x29\x91\x98\x1a\x88\x3d\x3a\x6d\xaf\x32\x94\x2b\xd2\x52\x6c\x85\xc2\x75\x17\x95\xaa\x0e\x70\x83\x65\xf5\xb5\x74\xa9\x49\xb0\x8f\x36\xf2\xf5\x5d\x89\xbf\x8e\x1d\x39\x83\xc3\xab\xeb\x6f\x1e\x3e\xd8\xbd\xed\xc2\x14\x7f\xb3\xfa\x48\xf2\x4b\xfa\x93\x49\xf0\x2d\x42\x26\xb7\x8f\x93\xef\xbf\xe1\xe2\x7a\xb4\x74\x81\x75\x01\xbd\xe7\x0f\x93\xf9\xad\xe0\x2e\x40\x2c\x0a\xd7\x76\x8d\x90\x2d\xf8\xdd\xd6\xff\x0c\x29\x46\x01\x71\x86\x3b\x04\x12\x0b\x1a\x6d\xc0\x1d\x3f\xc2\x40\xc1\x24\x26\xc1\xb8\xea\x10\xba\xc5\x7c\x3e\x0c\x43\x4a\xa3\xdc\xd4\x69\x35\xbf\xbb\x9b\x3f\x65\x0f\xab\x4d\xbe\x9a\x8d\x92\x4d\x82\x67\xdb\xb0\xf7\x50\xfe\xa7\x17\xe5\x12\x87\x0b\xa8\xeb\x1a\x29\xe8\xd0\x30\x1a\x1a\x62\x70\x63\x3a\x63\xe8\x62\x31\xa8\x04\xb1\xd5\x14\xfe\x96\xba\x49\x3e\xa5\xf3\xbe\x5d\x77\x79\xe2\x3f\x01\x9c\x05\x59\x4c\x96\x39\xb2\x7c\x82\xdf\x97\x79\x96\x4f\x4d\x82\x97\x6c\xff\xe7\xf6\x79\x8f\x97\xe5\x6e\xb7\xdc\xec\xb3\x55\x8e\xed\x0e\x0f\xdb\xcd\x63\xb6\xcf\xb6\x9b\x1c\xdb\x35\x96\x9b\x57\xfc\x95\x6d\x1e\xa7\x60\x09\x35\x2b\xf8\xad\xd3\xa8\xdf\x29\x24\x6e\x24\x97\x31\xd3\x7b\x81\xee\x02\x62\x3f\xe2\xb3\xef\xb8\x90\xa3\x14\x68\xc8\x56\x3d\x55\x8c\xca\x9d\x59\x6d\xac\x47\xc7\xda\x8a\x8f\x71\x7a\x90\x2d\x4d\x82\x46\x5a\x09\x63\x8b\xfc\x8f\xa6\x22\xcd\x57\x9e\x2d\x73\x12\x5b\x2e\xb0\x73\x0d\x1b\xea\xe4\xd6\xac\x05\xf4\x40\x45\x4a\x7d\xa8\x9d\xca\xbf\xa3\x98\xf4\xf4\xab\x4f\xc5\xcd\xcf\x3f\x9b\x96\x03\x95\x14\x68\x61\x00\x4b\x2d\x2f\x70\xa1\x93\x9f\x89\xf5\x21\x36\x6a\x76\x16\x1e\x58\x0d\xd0\xd0\x81\x1b\x1f\x61\x88\xf1\x2e\x30\x89\xc0\x89\xd1\xbe\x61\xbf\x30\x33\x50\x27\x7f\xa8\xeb\xbb\x11\x33\x1b\xc7\xa4\x85\x04\xed\xfd\x51\xa9\xe5\x78\xca\x62\x8f\x0c\xa0\xec\x5d\xaf\x05\xdf\x90\x77\x2e\x6f\x80\x33\xeb\xe1\xf6\xba\xe2\x30\x5e\x1b\xf1\xd7\x9b\x81\x42\x51\x9b\xff\x02\x00\x00\xff\xff\x4d\xcd\x08\x56\x90\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xcf\x6f\xf2\x46\x14\xbc\xef\x5f\x31\xc2\x97\xef\x93\xc0\xb4\x3d\x55\xf4\x44\x13\x68\xad\x46\x20\x61\xd2\x28\xc7\x87\xfd\xb0\x9f\xb0\x77\xdd\xb7\x6b\x1c\xfa\xd7\x57\x6b\xa0\x49\xf4\x5d\xb3\x17\xff\x1a\xbf\x99\xd9\x99\x4d\x30\xfb\xba\x65\x12\x3c\x49\xc1\xd6\x73\x89\xe0\x10\x6a\xc6\xb2\xa3\xa2\x66\xe4\xee\x18\x06\x52\xc6\xda\xf5\xb6\xa4\x20\xce\xe2\xdb\x32\x5f\x7f\x47\x6f\x4b\x56\x38\xcb\x70\x8a\xd6\x29\x9b\x04\x85\xb3\x41\xe5\xd0\x07\xa7\x68\xae\x03\x41\x95\x32\xb7\x6c\x83\x4f\x81\x9c\x79\x9c\xbe\xd9\xee\xb3\x87\x15\x8e\xd2\x30\x4a\xf1\xd7\x9f\xb8\xc4\x20\xa1\x36\x09\x42\x2d\x1e\x83\xd3\x13\x8e\x4e\x41\x65\x29\x91\x98\x1a\x88\x3d\x3a\x6d\xaf\x32\x94\x2b\xd2\x52\x6c\x85\xc2\x75\x17\x95\xaa\x0e\x70\x83\x65\xf5\xb5\x74\xa9\x49\xb0\x8f\x36\xf2\xf5\x5d\x89\xbf\x8e\x1d\x39\x83\xc3\xab\xeb\x6f\x1e\x3e\xd8\xbd\xed\xc2\x14\x7f\xb3\xfa\x48\xf2\x4b\xfa\x93\x49\xf0\x2d\x42\x26\xb7\x8f\x93\xef\xbf\xe1\xe2\x7a\xb4\x74\x81\x75\x01\xbd\xe7\x0f\x93\xf9\xad\xe0\x2e\x40\x2c\x0a\xd7\x76\x8d\x90\x2d\xf8\xdd\xd6\xff\x0c\x29\x46\x01\x71\x86\x3b\x04\x12\x0b\x1a\x6d\xc0\x1d\x3f\xc2\x40\xc1\x24\x26\xc1\xb8\xea\x10\xba\xc5\x7c\x3e\x0c\x43\x4a\x63\x3a\xa9\xd3\x6a\x7e\x77\x37\x7f\xca\x1e\x56\x9b\x7c\x35\x1b\x25\x9b\x04\xcf\xb6\x61\xef\xa1\xfc\x4f\x2f\xca\x25\x0e\x17\x50\xd7\x35\x52\xd0\xa1\x61\x34\x34\xc4\xe0\xc6\x74\xc6\xd0\xc5\x62\x50\x09\x62\xab\x29\xfc\x2d\x75\x93\x7c\x4a\xe7\x7d\xbb\xee\xf2\xc4\x7f\x02\x38\x0b\xb2\x98\x2c\x73\x64\xf9\x04\xbf\x2f\xf3\x2c\x9f\x9a\x04\x2f\xd9\xfe\xcf\xed\xf3\x1e\x2f\xcb\xdd\x6e\xb9\xd9\x67\xab\x1c\xdb\x1d\x1e\xb6\x9b\xc7\x6c\x9f\x6d\x37\x39\xb6\x6b\x2c\x37\xaf\xf8\x2b\xdb\x3c\x4e\xc1\x12\x6a\x56\xf0\x5b\xa7\x51\xbf\x53\x48\xdc\x48\x2e\x63\xa6\xf7\x02\xdd\x05\xc4\x7e\xc4\x67\xdf\x71\x21\x47\x29\xd0\x90\xad\x7a\xaa\x18\x95\x3b\xb3\xda\x58\x8f\x8e\xb5\x15\x1f\xe3\xf4\x20\x5b\x9a\x04\x8d\xb4\x12\xc6\x16\xf9\x1f\x4d\x45\x9a\xfb\xc1\xf8\x82\x65\xcc\x49\x6c\xb9\xc0\xce\x35\x6c\xa8\x93\x5b\xb3\x16\xd0\x03\x15\x29\xf5\xa1\x76\x2a\xff\x8e\x62\xd2\xd3\xaf\x3e\x15\x37\x3f\xff\x6c\x5a\x0e\x54\x52\xa0\x85\x01\x2c\xb5\xbc\xc0\x85\x4e\x7e\x26\xd6\x87\xd8\xa8\xd9\x59\x78\x60\x35\x40\x43\x07\x6e\x7c\x84\x21\xc6\xbb\xc0\x24\x02\x27\x46\xfb\x86\xfd\xc2\xcc\x40\x9d\xfc\xa1\xae\xef\x46\xcc\x6c\x1c\x93\x16\x12\xb4\xf7\x47\xa5\x96\xe3\x29\x8b\x3d\x32\x80\xb2\x77\xbd\x16\x7c\x43\xde\xb9\xbc\x01\xce\xac\x87\xdb\xeb\x8a\xc3\x78\x6d\xc4\x5f\x6f\x06\x0a\x45\x6d\xfe\x1b\x00\x4d\xcd\x08\x56\x90\x04\x00\x00"),
 		},
 		"/samples": &vfsgen۰DirInfo{
 			name:    "samples",
@@ -321,7 +321,7 @@ var assets = func() http.FileSystem {
 			modTime:          time.Time{},
 			uncompressedSize: 1244,
 
-			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x72\xdb\x36\x10\xbd\xe3\x2b\x5e\xcd\x4b\x32\x63\xc9\x4d\x8f\xec\x49\xb1\xad\x96\x93\x8c\x34\x63\x2a\xcd\xf8\xb8\x26\x56\xe4\x8e\x41\x2c\x0b\x80\xa2\x35\xd3\x8f\xef\x80\x92\x12\xa5\xbd\x06\x27\x89\x6f\xb1\xfb\xde\xbe\x87\x02\x8b\x9f\x77\x4c\x81\xcf\xd2\xb0\x8f\x6c\x91\x14\xa9\x63\xac\x06\x6a\x3a\x46\xad\xfb\x34\x51\x60\xac\x75\xf4\x96\x92\xa8\xc7\xbb\x55\xbd\x7e\x8f\xd1\x5b\x0e\x50\xcf\xd0\x80\x5e\x03\x9b\x02\x8d\xfa\x14\xe4\x65\x4c\x1a\xe0\x4e\x0d\x41\x6d\x60\xee\xd9\xa7\xb8\x04\x6a\xe6\xb9\xfb\x66\xbb\xab\xee\x1f\xb1\x17\xc7\xb0\x12\x4f\x97\xd8\x62\x92\xd4\x99\x02\xa9\x93\x88\x49\xc3\x2b\xf6\x1a\x40\xd6\x4a\x1e\x4c\x0e\xe2\xf7\x1a\xfa\x13\x8d\xc0\x2d\x05\x2b\xbe\x45\xa3\xc3\x31\x48\xdb\x25\xe8\xe4\x39\xc4\x4e\x86\xa5\x29\xb0\xcb\x32\xea\xf5\x85\x49\x3c\xb5\x9d\x67\x26\xc5\xb3\x8e\x67\x0d\x57\x72\xcf\x5b\xb8\xc5\x5f\x1c\x62\x1e\xf2\xdb\xf2\x57\x53\xe0\x5d\x2e\xb9\x39\x83\x37\xef\x7f\xc7\x51\x47\xf4\x74\x84\xd7\x84\x31\xf2\x55\x67\x7e\x6b\x78\x48\x10\x8f\x46\xfb\xc1\x09\xf9\x86\xbf\xcb\xfa\x36\x61\x89\x99\x40\xee\xa1\x2f\x89\xc4\x83\x66\x19\xd0\xfd\x75\x19\x28\x99\xc2\x14\x98\x4f\x97\xd2\x50\xde\xdd\x4d\xd3\xb4\xa4\x99\xee\x52\x43\x7b\x77\x51\x77\xf7\xb9\xba\x7f\xdc\xd4\x8f\x8b\x99\xb2\x29\xf0\xc5\x3b\x8e\x11\x81\xff\x1e\x25\xb0\xc5\xcb\x11\x34\x0c\x4e\x1a\x7a\x71\x0c\x47\x53\x36\x6e\x76\x67\x36\x5d\x3c\xa6\x20\x49\x7c\x7b\x8b\x78\x76\xdd\x14\x3f\xb8\xf3\x7d\x5d\x17\x7a\x12\x7f\x28\x50\x0f\xf2\xb8\x59\xd5\xa8\xea\x1b\x7c\x5c\xd5\x55\x7d\x6b\x0a\x7c\xad\x76\x7f\x6e\xbf\xec\xf0\x75\xf5\xf4\xb4\xda\xec\xaa\xc7\x1a\xdb\x27\xdc\x6f\x37\x0f\xd5\xae\xda\x6e\x6a\x6c\xd7\x58\x6d\x9e\xf1\xa9\xda\x3c\xdc\x82\x25\x75\x1c\xc0\x6f\x43\xc8\xfc\x35\x40\xf2\x22\xd9\x66\x4f\x2f\x01\xba\x10\xc8\xf9\xc8\xff\xe3\xc0\x8d\xec\xa5\x81\x23\xdf\x8e\xd4\x32\x5a\x3d\x70\xf0\x39\x1e\x03\x87\x5e\x62\xb6\x33\x82\xbc\x35\x05\x9c\xf4\x92\xe6\x14\xc5\xff\x8b\xca\x63\x7e\xe6\xdb\x32\x34\xc8\x39\x4e\x25\x8e\xf4\x1a\x97\x8d\xa4\x30\xc6\x7d\xa0\x9e\x73\xc8\x67\x1b\x0f\x1f\xc8\x0d\x1d\x7d\x30\xaf\xe2\x6d\x89\x1d\xc7\x64\x7a\x4e\x64\x29\x51\x69\x00\x4f\x3d\x97\xe0\x37\xea\x07\xc7\x8b\x94\xe1\xac\x39\x43\x51\xc7\xd0\x70\xfe\xf5\x9f\xb2\xe5\x9e\x29\x8d\x81\x67\xe4\xb2\x98\x12\xd7\x5f\xf3\x8b\x65\x9f\x4a\xfc\xb3\x30\xa7\x94\xad\x4f\x68\x89\x8e\x9d\xd3\xfc\x0a\x9d\x35\x67\x0c\xa8\x1b\xf6\x14\x44\x4b\x0c\x41\x7c\x42\x74\xda\x92\xff\x06\x03\x7f\xc8\x81\x3d\x9e\x57\x9f\x6a\x58\xe5\x88\x7b\xa7\xa3\x5d\x6c\x28\xc9\x81\xf1\xf1\xe1\x01\x99\xba\xf8\xf6\xea\xca\xae\xbb\xdc\x08\xda\xbc\xc6\x5f\xcc\xbf\x01\x00\x00\xff\xff\xd0\xfe\x5f\xfa\xdc\x04\x00\x00"),
+			compressedContent: []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xc1\x72\xdb\x36\x10\xbd\xe3\x2b\x5e\xcd\x4b\x32\x63\xc9\x4d\x8f\xec\x49\xb1\xad\x96\x93\x8c\x34\x63\x2a\xcd\xf8\xb8\x26\x56\xe4\x8e\x41\x2c\x0b\x80\xa2\x35\xd3\x8f\xef\x80\x92\x12\xa5\xbd\x06\x27\x89\x6f\xb1\xfb\xde\xbe\x87\x02\x8b\x9f\x77\x4c\x81\xcf\xd2\xb0\x8f\x6c\x91\x14\xa9\x63\xac\x06\x6a\x3a\x46\xad\xfb\x34\x51\x60\xac\x75\xf4\x96\x92\xa8\xc7\xbb\x55\xbd\x7e\x8f\xd1\x5b\x0e\x50\xcf\xd0\x80\x5e\x03\x9b\x02\x8d\xfa\x14\xe4\x65\x4c\x1a\xe0\x4e\x0d\x41\x6d\x60\xee\xd9\xa7\xb8\x04\x6a\xe6\xb9\xfb\x66\xbb\xab\xee\x1f\xb1\x17\xc7\xb0\x12\x4f\x97\xd8\x62\x92\xd4\x99\x02\xa9\x93\x88\x49\xc3\x2b\xf6\x1a\x40\xd6\x4a\x1e\x4c\x0e\xe2\xf7\x1a\xfa\x13\x8d\xc0\x2d\x05\x2b\xbe\x45\xa3\xc3\x31\x48\xdb\x25\xe8\xe4\x39\xc4\x4e\x86\xa5\x29\xb0\xcb\x32\xea\xf5\x85\x49\x3c\xb5\x9d\x67\x26\xc5\xb3\x8e\x67\x0d\x57\x72\xcf\x5b\xb8\xc5\x5f\x1c\x62\x1e\xf2\xdb\xf2\x57\x53\xe0\x5d\x2e\xb9\x39\x83\x37\xef\x7f\xc7\x51\x47\xf4\x74\x84\xd7\x84\x31\xf2\x55\x67\x7e\x6b\x78\x48\x10\x8f\x46\xfb\xc1\x09\xf9\x86\xbf\xcb\xfa\x36\x61\x89\x99\x40\xee\xa1\x2f\x89\xc4\x83\x66\x19\xd0\xfd\x75\x19\x28\x99\xc2\x14\x98\x4f\x97\xd2\x50\xde\xdd\x4d\xd3\xb4\xa4\xd9\x9d\xa5\x86\xf6\xee\xa2\xee\xee\x73\x75\xff\xb8\xa9\x1f\x17\x33\x65\x53\xe0\x8b\x77\x1c\x23\x02\xff\x3d\x4a\x60\x8b\x97\x23\x68\x18\x9c\x34\xf4\xe2\x18\x8e\xa6\x6c\xdc\xec\xce\x6c\xba\x78\x4c\x41\x92\xf8\xf6\x16\xf1\xec\xba\x29\x7e\x70\xe7\xfb\xba\x2e\xf4\x24\xfe\x50\xa0\x1e\xe4\x71\xb3\xaa\x51\xd5\x37\xf8\xb8\xaa\xab\xfa\xd6\x14\xf8\x5a\xed\xfe\xdc\x7e\xd9\xe1\xeb\xea\xe9\x69\xb5\xd9\x55\x8f\x35\xb6\x4f\xb8\xdf\x6e\x1e\xaa\x5d\xb5\xdd\xd4\xd8\xae\xb1\xda\x3c\xe3\x53\xb5\x79\xb8\x05\x4b\xea\x38\x80\xdf\x86\x90\xf9\x6b\x80\xe4\x45\xb2\xcd\x9e\x5e\x02\x74\x21\x90\xf3\x91\xff\xc7\x81\x1b\xd9\x4b\x03\x47\xbe\x1d\xa9\x65\xb4\x7a\xe0\xe0\x73\x3c\x06\x0e\xbd\xc4\x6c\x67\x04\x79\x6b\x0a\x38\xe9\x25\xcd\x29\x8a\xff\x17\x95\xc7\x5c\x1e\xc6\x4f\x38\xc6\xd0\x20\xe7\x38\x95\x38\xd2\x6b\x5c\x36\x92\xc2\x18\xf7\x81\x7a\xce\x21\x9f\x6d\x3c\x7c\x20\x37\x74\xf4\xc1\xbc\x8a\xb7\x25\x76\x1c\x93\xe9\x39\x91\xa5\x44\xa5\x01\x3c\xf5\x5c\x82\xdf\xa8\x1f\x1c\x2f\x52\x86\xb3\xe6\x0c\x45\x1d\x43\xc3\xf9\xd7\x7f\xca\x96\x7b\xa6\x34\x06\x9e\x91\xcb\x62\x4a\x5c\x7f\xcd\x2f\x96\x7d\x2a\xf1\xcf\x62\xae\x02\xd6\x27\xb4\x44\xc7\xce\x69\x7e\x85\xce\x9a\x33\x06\xd4\x0d\x7b\x0a\xa2\x25\x86\x20\x3e\x21\x3a\x6d\xc9\x7f\x83\x81\x3f\xe4\xc0\x1e\xcf\xab\x4f\x35\xac\x72\xc4\xbd\xd3\xd1\x2e\x36\x94\xe4\xc0\xf8\xf8\xf0\x80\x4c\x5d\x7c\x7b\x75\x65\xd7\x5d\x6e\x04\x6d\x5e\xe3\x2f\xe6\xdf\x01\x00\xd0\xfe\x5f\xfa\xdc\x04\x00\x00"),
 		},
 		"/scorecard": &vfsgen۰DirInfo{
 			name:    "scorecard",
@@ -0,0 +1,273 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package run executes YAKS tests on a cluster without the need of the command line tool.
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
+	"github.com/citrusframework/yaks/pkg/client"
+	"github.com/citrusframework/yaks/pkg/util/kubernetes"
+	k8slog "github.com/citrusframework/yaks/pkg/util/kubernetes/log"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultTimeout is the time to wait for a test to complete when no timeout is given
+	DefaultTimeout = 30 * time.Minute
+	// DefaultDeleteTimeout is the time to wait for an existing test to be deleted when the test is recreated
+	DefaultDeleteTimeout = 2 * time.Minute
+)
+
+// Options configures the execution of a test
+type Options struct {
+	// Test is the test custom resource to run, an existing test with the same name gets updated
+	Test *v1alpha1.Test
+	// Recreate deletes an existing test with the same name and creates a fresh one instead of updating the test
+	Recreate bool
+	// DeleteTimeout is the time to wait for an existing test to be deleted when the test is recreated
+	DeleteTimeout time.Duration
+	// Wait for the test to complete, otherwise the test is only submitted to the cluster
+	Wait bool
+	// Timeout is the time to wait for the test to complete
+	Timeout time.Duration
+	// Logs receives the logs of the test pod while waiting for the test to complete
+	Logs io.Writer
+	// LogsSince only prints the logs newer than given duration
+	LogsSince time.Duration
+	// OnSubmit is called once the test has been created or updated on the cluster
+	OnSubmit func(test *v1alpha1.Test, updated bool) error
+	// OnStatus is called on each status update of the test while waiting for the test to complete
+	OnStatus func(test *v1alpha1.Test)
+}
+
+// Execute runs the test on the cluster and returns the test results
+func Execute(ctx context.Context, c client.Client, options Options) (*v1alpha1.TestResults, error) {
+	startTime := metav1.Now()
+	results := v1alpha1.TestResults{
+		StartTime: &startTime,
+	}
+
+	test, err := Test(ctx, c, options)
+	if test != nil {
+		suite := v1alpha1.TestSuite{
+			StartTime: &startTime,
+		}
+		suite.AddResults(test.Status.Results)
+		if err != nil {
+			suite.Errors = append(suite.Errors, err.Error())
+		}
+
+		results.Suites = append(results.Suites, suite)
+		results.Summary.Add(&suite.Summary)
+	}
+
+	return &results, err
+}
+
+// Test runs the test on the cluster and returns the test holding the final status. When the context gets cancelled
+// before the test has completed the context error is returned.
+func Test(ctx context.Context, c client.Client, options Options) (*v1alpha1.Test, error) {
+	test := options.Test
+
+	var updated bool
+	var err error
+	if options.Recreate {
+		updated, err = Recreate(ctx, c, test, options.DeleteTimeout)
+	} else {
+		updated, err = Submit(ctx, c, test)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if options.OnSubmit != nil {
+		if err := options.OnSubmit(test, updated); err != nil {
+			return nil, err
+		}
+	}
+
+	if !options.Wait {
+		return test, nil
+	}
+
+	timeout := options.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	// the wait updates the test concurrently
+	namespace, name := test.Namespace, test.Name
+
+	waitCtx, cancel := context.WithCancel(ctx)
+	waitDone := make(chan struct{})
+	var status = v1alpha1.TestPhaseNew
+
+	// the wait is cancelled and joined on every return, so the status is safe to read once the wait is done
+	defer func() {
+		cancel()
+		<-waitDone
+	}()
+
+	go func() {
+		defer close(waitDone)
+		defer cancel()
+
+		_ = kubernetes.WaitConditionWithBackoff(waitCtx, c, test, func(obj interface{}) (bool, error) {
+			if val, ok := obj.(*v1alpha1.Test); ok {
+				if val.Status.Phase != v1alpha1.TestPhaseNone {
+					status = val.Status.Phase
+				}
+
+				if options.OnStatus != nil {
+					options.OnStatus(val)
+				}
+
+				if val.Status.Phase == v1alpha1.TestPhaseDeleting ||
+					val.Status.Phase == v1alpha1.TestPhaseError ||
+					val.Status.Phase == v1alpha1.TestPhasePassed ||
+					val.Status.Phase == v1alpha1.TestPhaseFailed {
+					return true, nil
+				}
+			}
+			return false, nil
+		}, timeout, kubernetes.DefaultBackoff)
+	}()
+
+	if options.Logs != nil {
+		if err := k8slog.PrintSince(waitCtx, c, namespace, name, options.LogsSince, options.Logs); err != nil {
+			return nil, err
+		}
+	}
+
+	<-waitDone
+	if ctx.Err() != nil {
+		return test, ctx.Err()
+	}
+
+	if test.Status.Phase == v1alpha1.TestPhaseNone {
+		test.Status.Phase = status
+	}
+	return test, status.AsError(test.Name)
+}
+
+// Submit creates the test on the cluster, an existing test with the same name gets updated and its status is reset
+func Submit(ctx context.Context, c client.Client, test *v1alpha1.Test) (bool, error) {
+	err := c.Create(ctx, test)
+	if err == nil {
+		return false, nil
+	} else if !k8serrors.IsAlreadyExists(err) {
+		return false, err
+	}
+
+	// the operator may update the test concurrently, so retry the update sequence on conflicts
+	key := ctrl.ObjectKeyFromObject(test)
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		clone := test.DeepCopy()
+		if err := c.Get(ctx, key, clone); err != nil {
+			return err
+		}
+
+		// Hold the resource from the operator controller
+		clone.Status.Phase = v1alpha1.TestPhaseUpdating
+		if err := c.Status().Update(ctx, clone); err != nil {
+			return err
+		}
+
+		// Update the spec
+		test.ResourceVersion = clone.ResourceVersion
+		return c.Update(ctx, test)
+	})
+	if err != nil {
+		return true, err
+	}
+
+	// Reset status
+	return true, retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current := test.DeepCopy()
+		if err := c.Get(ctx, key, current); err != nil {
+			return err
+		}
+
+		current.Status = v1alpha1.TestStatus{}
+		if err := c.Status().Update(ctx, current); err != nil {
+			return err
+		}
+
+		current.DeepCopyInto(test)
+		return nil
+	})
+}
+
+// Recreate creates the test on the cluster, an existing test with the same name is deleted first. The deletion may be
+// delayed by finalizers, so the function waits up to given timeout for the existing test to disappear.
+func Recreate(ctx context.Context, c client.Client, test *v1alpha1.Test, timeout time.Duration) (bool, error) {
+	err := c.Create(ctx, test)
+	if err == nil {
+		return false, nil
+	} else if !k8serrors.IsAlreadyExists(err) {
+		return false, err
+	}
+
+	if timeout <= 0 {
+		timeout = DefaultDeleteTimeout
+	}
+
+	existing := v1alpha1.Test{}
+	key := ctrl.ObjectKeyFromObject(test)
+	if err := c.Get(ctx, key, &existing); err != nil && !k8serrors.IsNotFound(err) {
+		return true, err
+	} else if err == nil {
+		if err := c.Delete(ctx, &existing); err != nil && !k8serrors.IsNotFound(err) {
+			return true, err
+		}
+
+		deleteCtx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+
+		err = wait.PollImmediateUntil(500*time.Millisecond, func() (bool, error) {
+			if err := c.Get(ctx, key, &existing); err != nil {
+				if k8serrors.IsNotFound(err) {
+					return true, nil
+				}
+				return false, err
+			}
+			return false, nil
+		}, deleteCtx.Done())
+
+		if errors.Is(err, wait.ErrWaitTimeout) && ctx.Err() == nil {
+			return true, fmt.Errorf("existing test '%s' has not been deleted within %s - pending finalizers: [%s]",
+				test.Name, timeout, strings.Join(existing.Finalizers, ", "))
+		} else if err != nil {
+			return true, err
+		}
+	}
+
+	test.ResourceVersion = ""
+	return true, c.Create(ctx, test)
+}
@@ -0,0 +1,239 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package run
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeClient serves the custom resources and the core resources from in memory fakes
+type fakeClient struct {
+	ctrl.Client
+	kubernetes.Interface
+	scheme *runtime.Scheme
+}
+
+func (c *fakeClient) GetScheme() *runtime.Scheme {
+	return c.scheme
+}
+
+func (c *fakeClient) GetConfig() *rest.Config {
+	return nil
+}
+
+func (c *fakeClient) GetCurrentNamespace(string) (string, error) {
+	return "default", nil
+}
+
+func newFakeClient(t *testing.T, objects ...ctrl.Object) *fakeClient {
+	scheme := runtime.NewScheme()
+	assert.NoError(t, v1alpha1.AddToScheme(scheme))
+
+	return &fakeClient{
+		Client:    fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+		Interface: kubefake.NewSimpleClientset(),
+		scheme:    scheme,
+	}
+}
+
+func newTest(name string) *v1alpha1.Test {
+	return &v1alpha1.Test{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       v1alpha1.TestKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+		},
+	}
+}
+
+// completeTest simulates the operator finishing the test with given phase and results
+func completeTest(c *fakeClient, phase v1alpha1.TestPhase, results v1alpha1.TestSuite) func(*v1alpha1.Test, bool) error {
+	return func(test *v1alpha1.Test, _ bool) error {
+		completed := test.DeepCopy()
+		completed.Status.Phase = phase
+		completed.Status.Results = results
+		return c.Status().Update(context.Background(), completed)
+	}
+}
+
+func TestExecutePassed(t *testing.T) {
+	c := newFakeClient(t)
+	results := v1alpha1.TestSuite{
+		Name:    "hello",
+		Summary: v1alpha1.TestSummary{Total: 2, Passed: 2},
+		Tests:   []v1alpha1.TestResult{{Name: "first"}, {Name: "second"}},
+	}
+
+	var phases []v1alpha1.TestPhase
+	options := Options{
+		Test:     newTest("hello"),
+		Wait:     true,
+		Timeout:  time.Minute,
+		OnSubmit: completeTest(c, v1alpha1.TestPhasePassed, results),
+		OnStatus: func(test *v1alpha1.Test) {
+			phases = append(phases, test.Status.Phase)
+		},
+	}
+
+	testResults, err := Execute(context.Background(), c, options)
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.TestPhase{v1alpha1.TestPhasePassed}, phases)
+	assert.Equal(t, 2, testResults.Summary.Total)
+	assert.Equal(t, 2, testResults.Summary.Passed)
+	assert.Len(t, testResults.Suites, 1)
+	assert.Equal(t, "hello", testResults.Suites[0].Name)
+	assert.Len(t, testResults.Suites[0].Tests, 2)
+	assert.Empty(t, testResults.Suites[0].Errors)
+}
+
+func TestExecuteFailed(t *testing.T) {
+	c := newFakeClient(t)
+	results := v1alpha1.TestSuite{
+		Name:    "hello",
+		Summary: v1alpha1.TestSummary{Total: 1, Failed: 1},
+	}
+
+	options := Options{
+		Test:     newTest("hello"),
+		Wait:     true,
+		Timeout:  time.Minute,
+		OnSubmit: completeTest(c, v1alpha1.TestPhaseFailed, results),
+	}
+
+	testResults, err := Execute(context.Background(), c, options)
+	assert.EqualError(t, err, "test hello finished with status: Failed")
+	assert.Equal(t, 1, testResults.Summary.Failed)
+	assert.Equal(t, []string{"test hello finished with status: Failed"}, testResults.Suites[0].Errors)
+}
+
+func TestWaitWithLogs(t *testing.T) {
+	c := newFakeClient(t)
+
+	var logs bytes.Buffer
+	test, err := Test(context.Background(), c, Options{
+		Test:     newTest("hello"),
+		Wait:     true,
+		Timeout:  time.Minute,
+		Logs:     &logs,
+		OnSubmit: completeTest(c, v1alpha1.TestPhasePassed, v1alpha1.TestSuite{}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.TestPhasePassed, test.Status.Phase)
+}
+
+func TestWaitTimeout(t *testing.T) {
+	c := newFakeClient(t)
+
+	test, err := Test(context.Background(), c, Options{
+		Test:    newTest("hello"),
+		Wait:    true,
+		Timeout: 100 * time.Millisecond,
+	})
+	assert.EqualError(t, err, "test hello timed out with status: New")
+	assert.Equal(t, v1alpha1.TestPhaseNew, test.Status.Phase)
+}
+
+func TestWaitCancelled(t *testing.T) {
+	c := newFakeClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	test, err := Test(ctx, c, Options{
+		Test:    newTest("hello"),
+		Wait:    true,
+		Timeout: time.Minute,
+		OnSubmit: func(*v1alpha1.Test, bool) error {
+			time.AfterFunc(100*time.Millisecond, cancel)
+			return nil
+		},
+	})
+	assert.Equal(t, context.Canceled, err)
+	assert.NotNil(t, test)
+}
+
+func TestSubmitUpdatesExistingTest(t *testing.T) {
+	existing := newTest("hello")
+	existing.Status.Phase = v1alpha1.TestPhasePassed
+	c := newFakeClient(t, existing)
+
+	var submitted bool
+	test := newTest("hello")
+	test.Spec.Source.Content = "Feature: Hello"
+	_, err := Test(context.Background(), c, Options{
+		Test: test,
+		OnSubmit: func(_ *v1alpha1.Test, updated bool) error {
+			submitted = updated
+			return nil
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, submitted)
+
+	current := v1alpha1.Test{}
+	assert.NoError(t, c.Get(context.Background(), ctrl.ObjectKeyFromObject(test), &current))
+	assert.Equal(t, "Feature: Hello", current.Spec.Source.Content)
+	assert.Equal(t, v1alpha1.TestPhaseNone, current.Status.Phase)
+}
+
+func TestRecreateExistingTest(t *testing.T) {
+	existing := newTest("hello")
+	existing.Status.Phase = v1alpha1.TestPhasePassed
+	c := newFakeClient(t, existing)
+
+	test := newTest("hello")
+	updated, err := Recreate(context.Background(), c, test, time.Second)
+	assert.NoError(t, err)
+	assert.True(t, updated)
+
+	current := v1alpha1.Test{}
+	assert.NoError(t, c.Get(context.Background(), ctrl.ObjectKeyFromObject(test), &current))
+	assert.Equal(t, v1alpha1.TestPhaseNone, current.Status.Phase)
+}
+
+// pendingDeleteClient keeps deleted objects as if they were held by a finalizer
+type pendingDeleteClient struct {
+	*fakeClient
+}
+
+func (c *pendingDeleteClient) Delete(context.Context, ctrl.Object, ...ctrl.DeleteOption) error {
+	return nil
+}
+
+func TestRecreateTimeout(t *testing.T) {
+	existing := newTest("hello")
+	existing.Finalizers = []string{"yaks.citrusframework.org/finalizer"}
+	c := &pendingDeleteClient{newFakeClient(t, existing)}
+
+	_, err := Recreate(context.Background(), c, newTest("hello"), 100*time.Millisecond)
+	assert.EqualError(t, err, "existing test 'hello' has not been deleted within 100ms - pending finalizers: [yaks.citrusframework.org/finalizer]")
+}
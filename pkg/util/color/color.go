@@ -0,0 +1,106 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package color
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// Mode --
+type Mode string
+
+const (
+	Auto   Mode = "auto"
+	Always Mode = "always"
+	Never  Mode = "never"
+
+	// NoColorEnv disables colored output in auto mode when set, see https://no-color.org
+	NoColorEnv = "NO_COLOR"
+)
+
+const (
+	reset = "\033[0m"
+	bold  = "\033[1m"
+	red   = "\033[31m"
+	green = "\033[32m"
+)
+
+var enabled = false
+
+// Setup enables colored output according to given mode. In auto mode colors are only used
+// when the given output is a terminal and the NO_COLOR environment variable is not set.
+func Setup(mode Mode, out io.Writer) error {
+	switch mode {
+	case Always:
+		enabled = true
+	case Never:
+		enabled = false
+	case Auto, "":
+		_, noColor := os.LookupEnv(NoColorEnv)
+		enabled = !noColor && IsTerminal(out)
+	default:
+		return fmt.Errorf("unsupported color mode '%s', should be one of: auto|always|never", mode)
+	}
+
+	return nil
+}
+
+// Enabled --
+func Enabled() bool {
+	return enabled
+}
+
+// IsTerminal checks if the given writer is a file attached to a terminal
+func IsTerminal(out io.Writer) bool {
+	f, ok := out.(*os.File)
+	if !ok || f == nil {
+		return false
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// Green --
+func Green(s string) string {
+	return colorize(green, s)
+}
+
+// Red --
+func Red(s string) string {
+	return colorize(red, s)
+}
+
+// Bold --
+func Bold(s string) string {
+	return colorize(bold, s)
+}
+
+func colorize(code string, s string) string {
+	if !enabled || s == "" {
+		return s
+	}
+
+	return code + s + reset
+}
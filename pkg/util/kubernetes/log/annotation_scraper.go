@@ -43,7 +43,9 @@ type SelectorScraper struct {
 	labelSelector        string
 	podScrapers          sync.Map
 	counter              uint64
-	L                    log.Logger
+	// SinceSeconds limits the logs to the given number of recent seconds, nil prints all logs
+	SinceSeconds *int64
+	L            log.Logger
 }
 
 // NewSelectorScraper creates a new SelectorScraper
@@ -133,6 +135,7 @@ func (s *SelectorScraper) synchronize(ctx context.Context, out *bufio.Writer) er
 
 func (s *SelectorScraper) addPodScraper(ctx context.Context, podName string, out *bufio.Writer) {
 	podScraper := NewPodScraper(s.client, s.namespace, podName, s.defaultContainerName)
+	podScraper.SinceSeconds = s.SinceSeconds
 	podCtx, podCancel := context.WithCancel(ctx)
 	id := atomic.AddUint64(&s.counter, 1)
 	prefix := fmt.Sprintf("[%s %s-%s] ", podName, s.defaultContainerName, strconv.FormatUint(id, 10))
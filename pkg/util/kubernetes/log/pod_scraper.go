@@ -47,6 +47,8 @@ type PodScraper struct {
 	defaultContainerName string
 	client               kubernetes.Interface
 	L                    log.Logger
+	// SinceSeconds limits the logs to the given number of recent seconds, nil prints all logs
+	SinceSeconds *int64
 }
 
 // NewPodScraper creates a new pod scraper
@@ -74,14 +76,16 @@ func (s *PodScraper) Start(ctx context.Context) *bufio.Reader {
 }
 
 func (s *PodScraper) doScrape(ctx context.Context, out *bufio.Writer, clientCloser func() error) {
-	containerName, err := s.waitForPodRunning(ctx, s.namespace, s.podName, s.defaultContainerName)
+	containerName, terminated, err := s.waitForPodRunning(ctx, s.namespace, s.podName, s.defaultContainerName)
 	if err != nil {
 		s.handleAndRestart(ctx, err, 5*time.Second, out, clientCloser)
 		return
 	}
 	logOptions := corev1.PodLogOptions{
-		Follow:    true,
-		Container: containerName,
+		// the logs of a terminated container are complete, e.g. the output of a test pod that failed on startup
+		Follow:       !terminated,
+		Container:    containerName,
+		SinceSeconds: s.SinceSeconds,
 	}
 	byteReader, err := s.client.CoreV1().Pods(s.namespace).GetLogs(s.podName, &logOptions).Stream(ctx)
 	if err != nil {
@@ -135,9 +139,9 @@ func (s *PodScraper) handleAndRestart(ctx context.Context, err error, wait time.
 	s.doScrape(ctx, out, clientCloser)
 }
 
-// waitForPodRunning waits for a given pod to reach the running state.
-// It may return the internal container to watch if present
-func (s *PodScraper) waitForPodRunning(ctx context.Context, namespace string, podName string, defaultContainerName string) (string, error) {
+// waitForPodRunning waits for a given pod to reach the running state or to terminate, the returned flag tells whether
+// the pod has terminated. It may return the internal container to watch if present
+func (s *PodScraper) waitForPodRunning(ctx context.Context, namespace string, podName string, defaultContainerName string) (string, bool, error) {
 	pod := corev1.Pod{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Pod",
@@ -153,16 +157,16 @@ func (s *PodScraper) waitForPodRunning(ctx context.Context, namespace string, po
 		FieldSelector: "metadata.name=" + pod.Name,
 	})
 	if err != nil {
-		return "", err
+		return "", false, err
 	}
 	events := watcher.ResultChan()
 	for {
 		select {
 		case <-ctx.Done():
-			return "", ctx.Err()
+			return "", false, ctx.Err()
 		case e, ok := <-events:
 			if !ok {
-				return "", errors.New("event channel closed")
+				return "", false, errors.New("event channel closed")
 			}
 
 			if e.Object != nil {
@@ -173,24 +177,29 @@ func (s *PodScraper) waitForPodRunning(ctx context.Context, namespace string, po
 					}
 					jsondata, err := unstr.MarshalJSON()
 					if err != nil {
-						return "", err
+						return "", false, err
 					}
 					recvPod := pod.DeepCopy()
 					if err := json.Unmarshal(jsondata, recvPod); err != nil {
-						return "", err
+						return "", false, err
 					}
 				} else if gotPod, ok := e.Object.(*corev1.Pod); ok {
 					recvPod = gotPod
 				}
 
-				if recvPod != nil && recvPod.Status.Phase == corev1.PodRunning {
-					return s.chooseContainer(recvPod, defaultContainerName), nil
+				if recvPod != nil {
+					switch recvPod.Status.Phase {
+					case corev1.PodRunning:
+						return s.chooseContainer(recvPod, defaultContainerName), false, nil
+					case corev1.PodFailed, corev1.PodSucceeded:
+						return s.chooseContainer(recvPod, defaultContainerName), true, nil
+					}
 				}
 			} else if e.Type == watch.Deleted || e.Type == watch.Error {
-				return "", errors.New("unable to watch pod " + s.podName)
+				return "", false, errors.New("unable to watch pod " + s.podName)
 			}
 		case <-time.After(30 * time.Second):
-			return "", errors.New("no state change after 30 seconds for pod " + s.podName)
+			return "", false, errors.New("no state change after 30 seconds for pod " + s.podName)
 		}
 	}
 }
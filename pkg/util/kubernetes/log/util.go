@@ -23,18 +23,26 @@ import (
 	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
 	"io"
 	"io/ioutil"
+	"time"
 
 	"k8s.io/client-go/kubernetes"
 )
 
 // Print prints test logs to the given writer
 func Print(ctx context.Context, client kubernetes.Interface, namespace string, name string, out io.Writer) error {
-	return PrintUsingSelector(ctx, client, namespace, "test", v1alpha1.TestLabel+"="+name, out)
+	return PrintSince(ctx, client, namespace, name, 0, out)
+}
+
+// PrintSince prints test logs to the given writer starting with the logs of the given recent duration,
+// a zero duration prints all logs
+func PrintSince(ctx context.Context, client kubernetes.Interface, namespace string, name string, since time.Duration, out io.Writer) error {
+	return PrintUsingSelector(ctx, client, namespace, "test", v1alpha1.TestLabel+"="+name, since, out)
 }
 
 // PrintUsingSelector prints pod logs using a selector
-func PrintUsingSelector(ctx context.Context, client kubernetes.Interface, namespace, defaultContainerName, selector string, out io.Writer) error {
+func PrintUsingSelector(ctx context.Context, client kubernetes.Interface, namespace, defaultContainerName, selector string, since time.Duration, out io.Writer) error {
 	scraper := NewSelectorScraper(client, namespace, defaultContainerName, selector)
+	scraper.SinceSeconds = SinceSeconds(since)
 	reader := scraper.Start(ctx)
 
 	if _, err := io.Copy(out, ioutil.NopCloser(reader)); err != nil {
@@ -43,3 +51,16 @@ func PrintUsingSelector(ctx context.Context, client kubernetes.Interface, namesp
 
 	return nil
 }
+
+// SinceSeconds converts the given duration to the pod log option, zero or negative durations select all logs
+func SinceSeconds(since time.Duration) *int64 {
+	if since <= 0 {
+		return nil
+	}
+
+	seconds := int64(since / time.Second)
+	if since%time.Second != 0 {
+		seconds++
+	}
+	return &seconds
+}
@@ -60,6 +60,40 @@ func JSONToYAML(src []byte) ([]byte, error) {
 	return yamldata, nil
 }
 
+// YAMLToJSON --
+func YAMLToJSON(src []byte) ([]byte, error) {
+	var yamldata interface{}
+	if err := yaml2.Unmarshal(src, &yamldata); err != nil {
+		return nil, fmt.Errorf("error unmarshalling yaml: %v", err)
+	}
+
+	jsondata, err := json.Marshal(toJSONValue(yamldata))
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling to json: %v", err)
+	}
+
+	return jsondata, nil
+}
+
+// toJSONValue converts the generic maps created by the YAML decoder to string keyed maps supported by JSON
+func toJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			m[fmt.Sprintf("%v", key)] = toJSONValue(val)
+		}
+		return m
+	case []interface{}:
+		for i, val := range v {
+			v[i] = toJSONValue(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
 // GetConfigMap --
 func GetConfigMap(context context.Context, client k8sclient.Reader, name string, namespace string) (*corev1.ConfigMap, error) {
 	key := k8sclient.ObjectKey{
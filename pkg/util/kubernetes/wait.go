@@ -19,6 +19,7 @@ package kubernetes
 
 import (
 	"context"
+	"math/rand"
 	"time"
 
 	"github.com/citrusframework/yaks/pkg/client"
@@ -37,31 +38,89 @@ const (
 	sleepTime = 400 * time.Millisecond
 )
 
+// Backoff defines the interval between two condition checks. The interval starts with the initial duration and grows
+// by the factor on each attempt up to the max interval, a random jitter spreads the checks of concurrent callers.
+type Backoff struct {
+	Initial time.Duration
+	Max     time.Duration
+	Factor  float64
+	// Jitter is the maximum fraction of the interval added randomly
+	Jitter float64
+}
+
+// FixedInterval checks the condition at a fixed cadence
+var FixedInterval = Backoff{Initial: sleepTime, Max: sleepTime, Factor: 1}
+
+// DefaultBackoff is suitable for long waits, it reduces the load on the API server
+var DefaultBackoff = Backoff{Initial: sleepTime, Max: 5 * time.Second, Factor: 1.5, Jitter: 0.2}
+
+// next calculates the interval following the given interval
+func (b Backoff) next(interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return b.Initial
+	}
+
+	next := time.Duration(float64(interval) * b.Factor)
+	if next > b.Max {
+		next = b.Max
+	}
+	if next < b.Initial {
+		next = b.Initial
+	}
+
+	return next
+}
+
+// jitter adds a random fraction to given interval
+func (b Backoff) jitter(interval time.Duration) time.Duration {
+	if b.Jitter <= 0 {
+		return interval
+	}
+
+	/* #nosec */
+	return interval + time.Duration(rand.Float64()*b.Jitter*float64(interval))
+}
+
 // WaitCondition --
 func WaitCondition(ctx context.Context, c client.Client, obj ctrl.Object, condition ResourceCheckFunction, maxDuration time.Duration) error {
-	start := time.Now()
+	return WaitConditionWithBackoff(ctx, c, obj, condition, maxDuration, FixedInterval)
+}
+
+// WaitConditionWithBackoff waits for the condition to be satisfied checking the object with the intervals of the backoff.
+// The wait stops as soon as the context is cancelled.
+func WaitConditionWithBackoff(ctx context.Context, c client.Client, obj ctrl.Object, condition ResourceCheckFunction, maxDuration time.Duration, backoff Backoff) error {
+	deadline := time.Now().Add(maxDuration)
 	key := ctrl.ObjectKeyFromObject(obj)
-	for start.Add(maxDuration).After(time.Now()) {
+	var interval time.Duration
+	for deadline.After(time.Now()) {
 		err := c.Get(ctx, key, obj)
-		if err != nil {
-			if k8serrors.IsNotFound(err) {
-				time.Sleep(sleepTime)
-				continue
-			}
-
+		if err != nil && !k8serrors.IsNotFound(err) {
 			return err
 		}
 
-		satisfied, err := condition(obj)
-		if err != nil {
-			return errors.Wrap(err, "error while evaluating condition")
+		if err == nil {
+			satisfied, err := condition(obj)
+			if err != nil {
+				return errors.Wrap(err, "error while evaluating condition")
+			}
+			if satisfied {
+				return nil
+			}
 		}
-		if !satisfied {
-			time.Sleep(sleepTime)
-			continue
+
+		interval = backoff.next(interval)
+		wait := backoff.jitter(interval)
+		if remaining := time.Until(deadline); wait > remaining {
+			wait = remaining
 		}
 
-		return nil
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
 	}
 	return errors.New("timeout while waiting condition")
 }
@@ -0,0 +1,48 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff(t *testing.T) {
+	backoff := Backoff{Initial: time.Second, Max: 3 * time.Second, Factor: 2}
+
+	assert.Equal(t, time.Second, backoff.next(0))
+	assert.Equal(t, 2*time.Second, backoff.next(time.Second))
+	assert.Equal(t, 3*time.Second, backoff.next(2*time.Second))
+	assert.Equal(t, 3*time.Second, backoff.next(3*time.Second))
+
+	assert.Equal(t, sleepTime, FixedInterval.next(FixedInterval.next(0)))
+}
+
+func TestBackoffJitter(t *testing.T) {
+	backoff := Backoff{Initial: time.Second, Max: time.Second, Factor: 1, Jitter: 0.5}
+
+	for i := 0; i < 100; i++ {
+		interval := backoff.jitter(time.Second)
+		assert.True(t, interval >= time.Second)
+		assert.True(t, interval <= 1500*time.Millisecond)
+	}
+
+	assert.Equal(t, time.Second, FixedInterval.jitter(time.Second))
+}
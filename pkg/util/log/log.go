@@ -19,21 +19,69 @@ package log
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
 	"github.com/go-logr/logr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
+const (
+	// LevelEnv is the environment variable used to set the log level
+	LevelEnv = "YAKS_LOG_LEVEL"
+
+	// DebugLevel enables debug messages logged at verbosity V(1)
+	DebugLevel = "debug"
+	// InfoLevel suppresses debug messages
+	InfoLevel = "info"
+)
+
 // Log --
 var Log Logger
 
+// Exit is called by Fatal and Fatalf after logging, tests may replace it with a hook
+var Exit = os.Exit
+
+// ExitCode is the exit code passed to Exit by Fatal and Fatalf
+var ExitCode = 1
+
+// level is shared by all zap loggers that use Level() as their level enabler
+var level = zap.NewAtomicLevelAt(zapcore.InfoLevel)
+
 func init() {
+	if lvl, ok := os.LookupEnv(LevelEnv); ok {
+		if err := SetLevel(lvl); err != nil {
+			fmt.Fprintf(os.Stderr, "WARN: %s\n", err.Error())
+		}
+	}
+
 	Log = Logger{
 		delegate: logf.Log.WithName("yaks"),
 	}
 }
 
+// SetLevel sets the minimum log level, one of debug|info
+func SetLevel(lvl string) error {
+	switch strings.ToLower(strings.TrimSpace(lvl)) {
+	case DebugLevel:
+		level.SetLevel(zapcore.DebugLevel)
+	case InfoLevel:
+		level.SetLevel(zapcore.InfoLevel)
+	default:
+		return fmt.Errorf("unsupported log level '%s', should be one of: %s|%s", lvl, DebugLevel, InfoLevel)
+	}
+
+	return nil
+}
+
+// Level returns the level enabler to use when creating the controller-runtime zap logger
+func Level() zapcore.LevelEnabler {
+	return level
+}
+
 // Injectable identifies objects that can receive a Logger
 type Injectable interface {
 	InjectLogger(Logger)
@@ -42,60 +90,118 @@ type Injectable interface {
 // Logger --
 type Logger struct {
 	delegate logr.Logger
+	redact   *redaction
 }
 
 // Debugf --
 func (l Logger) Debugf(format string, args ...interface{}) {
-	l.delegate.V(1).Info(fmt.Sprintf(format, args...))
+	l.delegate.V(1).Info(l.redact.message(fmt.Sprintf(format, args...)))
 }
 
 // Infof --
 func (l Logger) Infof(format string, args ...interface{}) {
-	l.delegate.Info(fmt.Sprintf(format, args...))
+	l.delegate.Info(l.redact.message(fmt.Sprintf(format, args...)))
 }
 
 // Errorf --
 func (l Logger) Errorf(err error, format string, args ...interface{}) {
-	l.delegate.Error(err, fmt.Sprintf(format, args...))
+	l.delegate.Error(err, l.redact.message(fmt.Sprintf(format, args...)))
 }
 
 // Debug --
 func (l Logger) Debug(msg string, keysAndValues ...interface{}) {
-	l.delegate.V(1).Info(msg, keysAndValues...)
+	l.delegate.V(1).Info(l.redact.message(msg), l.redact.values(keysAndValues)...)
 }
 
 // Info --
 func (l Logger) Info(msg string, keysAndValues ...interface{}) {
-	l.delegate.Info(msg, keysAndValues...)
+	l.delegate.Info(l.redact.message(msg), l.redact.values(keysAndValues)...)
 }
 
 // Error --
 func (l Logger) Error(err error, msg string, keysAndValues ...interface{}) {
-	l.delegate.Error(err, msg, keysAndValues...)
+	l.delegate.Error(err, l.redact.message(msg), l.redact.values(keysAndValues)...)
+}
+
+// Fatalf logs the error message and exits
+func (l Logger) Fatalf(format string, args ...interface{}) {
+	l.delegate.Error(nil, l.redact.message(fmt.Sprintf(format, args...)))
+	Exit(ExitCode)
+}
+
+// Fatal logs the error and exits
+func (l Logger) Fatal(err error, msg string, keysAndValues ...interface{}) {
+	l.delegate.Error(err, l.redact.message(msg), l.redact.values(keysAndValues)...)
+	Exit(ExitCode)
 }
 
 // WithName --
 func (l Logger) WithName(name string) Logger {
 	return Logger{
 		delegate: l.delegate.WithName(name),
+		redact:   l.redact,
 	}
 }
 
 // WithValues --
 func (l Logger) WithValues(keysAndValues ...interface{}) Logger {
 	return Logger{
-		delegate: l.delegate.WithValues(keysAndValues...),
+		delegate: l.delegate.WithValues(l.redact.values(keysAndValues)...),
+		redact:   l.redact,
+	}
+}
+
+// WithError returns a logger with the given error attached as value
+func (l Logger) WithError(err error) Logger {
+	return l.WithValues("error", err)
+}
+
+// WithRedaction returns a logger that masks the values of all keys containing one of the given names
+func (l Logger) WithRedaction(keys ...string) Logger {
+	return Logger{
+		delegate: l.delegate,
+		redact:   l.redact.with(keys...),
 	}
 }
 
 // ForTest --
 func (l Logger) ForTest(target *v1alpha1.Test) Logger {
-	return l.WithValues(
+	logger := l.WithRedaction(DefaultRedactedKeys...).WithValues(
 		"api-version", target.APIVersion,
 		"kind", target.Kind,
 		"ns", target.Namespace,
 		"name", target.Name,
 	)
+
+	if runID, ok := target.Labels[v1alpha1.TestRunIdLabel]; ok {
+		logger = logger.WithValues("run-id", runID)
+	}
+
+	return logger
+}
+
+// ForSuite --
+func (l Logger) ForSuite(suite *v1alpha1.TestSuite) Logger {
+	return l.WithValues(
+		"suite", suite.Name,
+		"total", suite.Summary.Total,
+		"passed", suite.Summary.Passed,
+		"failed", suite.Summary.Failed,
+		"skipped", suite.Summary.Skipped,
+		"errors", suite.Summary.Errors,
+	)
+}
+
+// ForResults --
+func (l Logger) ForResults(results *v1alpha1.TestResults) Logger {
+	return l.WithValues(
+		"suites", len(results.Suites),
+		"total", results.Summary.Total,
+		"passed", results.Summary.Passed,
+		"failed", results.Summary.Failed,
+		"skipped", results.Summary.Skipped,
+		"errors", results.Summary.Errors,
+	)
 }
 
 // ***********************************
@@ -104,6 +210,13 @@ func (l Logger) ForTest(target *v1alpha1.Test) Logger {
 //
 // ***********************************
 
+// FromLogr wraps given logr logger, e.g. a named controller-runtime logger, so it keeps its name
+func FromLogr(delegate logr.Logger) Logger {
+	return Logger{
+		delegate: delegate,
+	}
+}
+
 // WithName --
 func WithName(name string) Logger {
 	return Log.WithName(name)
@@ -114,11 +227,31 @@ func WithValues(keysAndValues ...interface{}) Logger {
 	return Log.WithValues(keysAndValues...)
 }
 
+// WithError --
+func WithError(err error) Logger {
+	return Log.WithError(err)
+}
+
+// WithRedaction --
+func WithRedaction(keys ...string) Logger {
+	return Log.WithRedaction(keys...)
+}
+
 // ForTest --
 func ForTest(target *v1alpha1.Test) Logger {
 	return Log.ForTest(target)
 }
 
+// ForSuite --
+func ForSuite(suite *v1alpha1.TestSuite) Logger {
+	return Log.ForSuite(suite)
+}
+
+// ForResults --
+func ForResults(results *v1alpha1.TestResults) Logger {
+	return Log.ForResults(results)
+}
+
 // ***********************************
 //
 //
@@ -154,3 +287,13 @@ func Info(msg string, keysAndValues ...interface{}) {
 func Error(err error, msg string, keysAndValues ...interface{}) {
 	Log.Error(err, msg, keysAndValues...)
 }
+
+// Fatalf --
+func Fatalf(format string, args ...interface{}) {
+	Log.Fatalf(format, args...)
+}
+
+// Fatal --
+func Fatal(err error, msg string, keysAndValues ...interface{}) {
+	Log.Fatal(err, msg, keysAndValues...)
+}
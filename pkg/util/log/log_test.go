@@ -0,0 +1,160 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/citrusframework/yaks/pkg/apis/yaks/v1alpha1"
+	"github.com/stretchr/testify/assert"
+
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+)
+
+func newTestLogger(out *bytes.Buffer) Logger {
+	return Logger{
+		delegate: zap.New(zap.WriteTo(out), zap.Level(Level())),
+	}
+}
+
+func TestDebugSuppressedAtInfoLevel(t *testing.T) {
+	defer SetLevel(InfoLevel)
+
+	var out bytes.Buffer
+	logger := newTestLogger(&out)
+
+	assert.Nil(t, SetLevel(InfoLevel))
+	logger.Debug("debug message")
+	logger.Debugf("debug %s", "message")
+	assert.Empty(t, out.String())
+
+	logger.Info("info message")
+	assert.Contains(t, out.String(), "info message")
+}
+
+func TestDebugEnabledAtDebugLevel(t *testing.T) {
+	defer SetLevel(InfoLevel)
+
+	var out bytes.Buffer
+	logger := newTestLogger(&out)
+
+	assert.Nil(t, SetLevel("DEBUG"))
+	logger.Debug("debug message")
+	assert.Contains(t, out.String(), "debug message")
+}
+
+func TestSetUnsupportedLevel(t *testing.T) {
+	assert.Error(t, SetLevel("trace"))
+}
+
+func TestFatalExits(t *testing.T) {
+	defer func() {
+		Exit = os.Exit
+	}()
+
+	exitCode := 0
+	Exit = func(code int) {
+		exitCode = code
+		panic("exit")
+	}
+
+	var out bytes.Buffer
+	logger := newTestLogger(&out)
+
+	assert.PanicsWithValue(t, "exit", func() {
+		logger.Fatal(errors.New("boom"), "fatal message")
+	})
+	assert.Equal(t, ExitCode, exitCode)
+	assert.Contains(t, out.String(), "fatal message")
+	assert.Contains(t, out.String(), "boom")
+
+	exitCode = 0
+	assert.PanicsWithValue(t, "exit", func() {
+		logger.Fatalf("fatal %s", "format")
+	})
+	assert.Equal(t, ExitCode, exitCode)
+	assert.Contains(t, out.String(), "fatal format")
+}
+
+func TestRedaction(t *testing.T) {
+	var out bytes.Buffer
+	logger := newTestLogger(&out).WithRedaction(DefaultRedactedKeys...)
+
+	logger.Info("connecting with password=foo",
+		"api-token", "bar",
+		"env", []string{"MY_SECRET=baz", "USER=yaks"})
+
+	assert.NotContains(t, out.String(), "foo")
+	assert.NotContains(t, out.String(), "bar")
+	assert.NotContains(t, out.String(), "baz")
+	assert.Contains(t, out.String(), "password="+RedactedValue)
+	assert.Contains(t, out.String(), "MY_SECRET="+RedactedValue)
+	assert.Contains(t, out.String(), "USER=yaks")
+}
+
+func TestRedactionForTest(t *testing.T) {
+	var out bytes.Buffer
+	logger := newTestLogger(&out).ForTest(&v1alpha1.Test{})
+
+	logger.Info("test env", "env", []string{"DB_PASSWORD=foo"})
+	assert.NotContains(t, out.String(), "foo")
+}
+
+func TestWithError(t *testing.T) {
+	var out bytes.Buffer
+	logger := newTestLogger(&out)
+
+	logger.WithError(errors.New("boom")).Info("reconcile failed")
+	assert.Contains(t, out.String(), "reconcile failed")
+	assert.Contains(t, out.String(), `"error":"boom"`)
+}
+
+func TestForSuite(t *testing.T) {
+	var out bytes.Buffer
+	logger := newTestLogger(&out)
+
+	logger.ForSuite(&v1alpha1.TestSuite{
+		Name: "hello",
+		Summary: v1alpha1.TestSummary{
+			Total:  2,
+			Passed: 1,
+			Failed: 1,
+		},
+	}).Info("suite finished")
+
+	assert.Contains(t, out.String(), `"suite":"hello"`)
+	assert.Contains(t, out.String(), `"total":2`)
+	assert.Contains(t, out.String(), `"failed":1`)
+}
+
+func TestFromLogrKeepsName(t *testing.T) {
+	defer func() {
+		Exit = os.Exit
+	}()
+	Exit = func(int) {}
+
+	var out bytes.Buffer
+	logger := FromLogr(zap.New(zap.WriteTo(&out)).WithName("cmd"))
+
+	logger.Fatal(errors.New("boom"), "fatal message")
+	assert.Contains(t, out.String(), "\"logger\":\"cmd\"")
+	assert.Contains(t, out.String(), "fatal message")
+}
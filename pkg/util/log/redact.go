@@ -0,0 +1,118 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package log
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// RedactedValue replaces the value of sensitive keys in log output
+const RedactedValue = "***"
+
+// DefaultRedactedKeys holds the key names that are masked when logging tests
+var DefaultRedactedKeys = []string{"password", "token", "secret"}
+
+// redaction masks values of keys that contain one of the configured names
+type redaction struct {
+	keys    []string
+	pattern *regexp.Regexp
+}
+
+func newRedaction(keys []string) *redaction {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	quoted := make([]string, 0, len(keys))
+	for _, key := range keys {
+		quoted = append(quoted, regexp.QuoteMeta(key))
+	}
+
+	return &redaction{
+		keys: keys,
+		// matches key=value and key: value assignments where the key contains a sensitive name
+		pattern: regexp.MustCompile(fmt.Sprintf(`(?i)([\w.-]*(?:%s)[\w.-]*)(\s*[=:]\s*)("[^"]*"|[^\s,;"]+)`,
+			strings.Join(quoted, "|"))),
+	}
+}
+
+func (r *redaction) with(keys ...string) *redaction {
+	combined := make([]string, 0)
+	if r != nil {
+		combined = append(combined, r.keys...)
+	}
+
+	for _, key := range keys {
+		key = strings.ToLower(strings.TrimSpace(key))
+		if key != "" {
+			combined = append(combined, key)
+		}
+	}
+
+	return newRedaction(combined)
+}
+
+func (r *redaction) isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, k := range r.keys {
+		if strings.Contains(key, k) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *redaction) message(msg string) string {
+	if r == nil {
+		return msg
+	}
+
+	return r.pattern.ReplaceAllString(msg, "${1}${2}"+RedactedValue)
+}
+
+func (r *redaction) values(keysAndValues []interface{}) []interface{} {
+	if r == nil || len(keysAndValues) == 0 {
+		return keysAndValues
+	}
+
+	redacted := make([]interface{}, len(keysAndValues))
+	copy(redacted, keysAndValues)
+
+	for i := 0; i+1 < len(redacted); i += 2 {
+		if key, ok := redacted[i].(string); ok && r.isSensitive(key) {
+			redacted[i+1] = RedactedValue
+			continue
+		}
+
+		switch value := redacted[i+1].(type) {
+		case string:
+			redacted[i+1] = r.message(value)
+		case []string:
+			entries := make([]string, 0, len(value))
+			for _, entry := range value {
+				entries = append(entries, r.message(entry))
+			}
+			redacted[i+1] = entries
+		}
+	}
+
+	return redacted
+}